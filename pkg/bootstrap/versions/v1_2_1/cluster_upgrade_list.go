@@ -27,6 +27,12 @@ var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_system_rawlog_comment,
 	upg_system_statementInto_comment,
 	upg_systemMetric_metric_comment,
+	upg_mo_account_add_parent_account_id,
+	upg_mo_account_add_max_databases,
+	upg_mo_account_add_max_tables,
+	upg_mo_account_add_max_connections,
+	upg_mo_account_add_suspended_by,
+	upg_mo_account_add_suspend_reason,
 }
 
 // viewSystemLogInfoDDL113 = "CREATE VIEW IF NOT EXISTS `system`.`log_info` as select `trace_id`, `span_id`, `span_kind`, `node_uuid`, `node_type`, `timestamp`, `logger_name`, `level`, `caller`, `message`, `extra`, `stack` from `system`.`rawlog` where `raw_item` = \"log_info\""
@@ -111,3 +117,87 @@ var upg_systemMetric_metric_comment = versions.UpgradeEntry{
 		return false, nil
 	},
 }
+
+var upg_mo_account_add_parent_account_id = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MOAccountTable,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    "alter table mo_account add column parent_account_id int signed default NULL after create_version",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MOAccountTable, "parent_account_id")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_account_add_max_databases = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MOAccountTable,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    "alter table mo_account add column max_databases int signed default NULL after parent_account_id",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MOAccountTable, "max_databases")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_account_add_max_tables = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MOAccountTable,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    "alter table mo_account add column max_tables int signed default NULL after max_databases",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MOAccountTable, "max_tables")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_account_add_max_connections = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MOAccountTable,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    "alter table mo_account add column max_connections int signed default NULL after max_tables",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MOAccountTable, "max_connections")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_account_add_suspended_by = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MOAccountTable,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    "alter table mo_account add column suspended_by int signed default NULL after max_connections",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MOAccountTable, "suspended_by")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_account_add_suspend_reason = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MOAccountTable,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    "alter table mo_account add column suspend_reason varchar(256) default NULL after suspended_by",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MOAccountTable, "suspend_reason")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
@@ -15,8 +15,11 @@
 package v1_2_1
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/sysview"
 )
@@ -24,6 +27,19 @@ import (
 var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_mo_mysql_compatibility_mode1,
 	upg_information_schema_files,
+	upg_mo_role_add_tags,
+	upg_mo_user_add_login_attempts,
+	upg_mo_user_add_lock_time,
+	upg_mo_password_history,
+	upg_mo_user_add_comment,
+	upg_mo_user_add_attribute,
+	upg_mo_user_add_password_lifetime,
+	upg_mo_column_privs,
+	upg_mo_user_grant_add_expiry_time,
+	upg_mo_role_grant_add_expiry_time,
+	upg_mo_stored_procedure_drop_name_unique,
+	upg_mo_user_variables,
+	upg_mo_variable_audit,
 }
 
 var upg_mo_mysql_compatibility_mode1 = versions.UpgradeEntry{
@@ -46,3 +62,202 @@ var upg_information_schema_files = versions.UpgradeEntry{
 		return versions.CheckTableDefinition(txn, accountId, sysview.InformationDBConst, "files")
 	},
 }
+
+var upg_mo_role_add_tags = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_role",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_role add column tags varchar(1024) after comments;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_role", "tags")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_user_add_login_attempts = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_user",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_user add column login_attempts int unsigned default 0 after default_role;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_user", "login_attempts")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_user_add_lock_time = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_user",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_user add column lock_time timestamp after login_attempts;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_user", "lock_time")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_password_history = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_password_history",
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoPasswordHistoryDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, "mo_password_history")
+	},
+}
+
+var upg_mo_user_add_comment = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_user",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_user add column comment text after lock_time;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_user", "comment")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_user_add_attribute = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_user",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_user add column attribute text after comment;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_user", "attribute")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_user_add_password_lifetime = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_user",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_user add column password_lifetime int signed default NULL after attribute;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_user", "password_lifetime")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_column_privs = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_column_privs",
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoColumnPrivsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, "mo_column_privs")
+	},
+}
+
+var upg_mo_user_grant_add_expiry_time = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_user_grant",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_user_grant add column expiry_time timestamp after with_grant_option;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_user_grant", "expiry_time")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_role_grant_add_expiry_time = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_role_grant",
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_role_grant add column expiry_time timestamp after with_grant_option;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, "mo_role_grant", "expiry_time")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+// the procedures with the same name and the different arguments are allowed.
+var upg_mo_stored_procedure_drop_name_unique = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_stored_procedure",
+	UpgType:   versions.DROP_INDEX,
+	UpgSql:    fmt.Sprintf(`alter table %s.mo_stored_procedure drop index name;`, catalog.MO_CATALOG),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, err := versions.CheckIndexDefinition(txn, accountId, catalog.MO_CATALOG, "mo_stored_procedure", "name")
+		if err != nil {
+			return false, err
+		}
+		return !exists, nil
+	},
+}
+
+var upg_mo_user_variables = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_user_variables",
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoUserVariablesDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, "mo_user_variables")
+	},
+}
+
+var upg_mo_variable_audit = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_variable_audit",
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoVariableAuditDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, "mo_variable_audit")
+	},
+}
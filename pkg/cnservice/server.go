@@ -865,6 +865,11 @@ func (s *service) bootstrap() error {
 		panic(err)
 	}
 
+	// the delimiters of the user input can not break the login of the existing users and roles
+	if err := frontend.CheckNamesWithUserInputDelimiters(ctx, s.sqlExecutor, s.pu.SV.UserInputDelimiters); err != nil {
+		return err
+	}
+
 	trace.GetService().EnableFlush()
 
 	if s.cfg.AutomaticUpgrade {
@@ -147,6 +147,19 @@ var (
 	//defaultCleanKillQueueInterval default: 60 minutes
 	defaultCleanKillQueueInterval = 60
 
+	// defaultValidatePasswordCount is the default minimum number of the characters of
+	// every class in the password. default: 1
+	defaultValidatePasswordCount uint64 = 1
+
+	// defaultDropUserCleanupPolicy default: none
+	defaultDropUserCleanupPolicy = "none"
+
+	// defaultDroppedDefaultRolePolicy default: public
+	defaultDroppedDefaultRolePolicy = "public"
+
+	// defaultUserInputDelimiters default: ":#"
+	defaultUserInputDelimiters = ":#"
+
 	// defaultLongSpanTime default: 10 s
 	defaultLongSpanTime = 10 * time.Second
 
@@ -264,6 +277,35 @@ type FrontendParameters struct {
 	// default 100 (MB)
 	QueryResultMaxsize uint64 `toml:"queryResultMaxsize" user_setting:"advanced"`
 
+	// FailedLoginAttempts is the number of the consecutive failed logins that locks the user.
+	// default 0 (the lockout is disabled)
+	FailedLoginAttempts uint64 `toml:"failedLoginAttempts" user_setting:"advanced"`
+
+	// PasswordLockTime is how long the user is locked after the failed logins.
+	// default 300 (s)
+	PasswordLockTime uint64 `toml:"passwordLockTime" user_setting:"advanced"`
+
+	// ValidatePassword enables the password policy of the CREATE/ALTER USER. default false
+	ValidatePassword bool `toml:"validatePassword" user_setting:"advanced"`
+
+	// ValidatePasswordLength is the minimum length of the password. default 8
+	ValidatePasswordLength uint64 `toml:"validatePasswordLength" user_setting:"advanced"`
+
+	// ValidatePasswordMixedCaseCount is the minimum number of the lowercase and the uppercase
+	// characters of the password. 0 means no requirement. default 1 if it is not set
+	ValidatePasswordMixedCaseCount *uint64 `toml:"validatePasswordMixedCaseCount" user_setting:"advanced"`
+
+	// ValidatePasswordNumberCount is the minimum number of the digits of the password.
+	// 0 means no requirement. default 1 if it is not set
+	ValidatePasswordNumberCount *uint64 `toml:"validatePasswordNumberCount" user_setting:"advanced"`
+
+	// ValidatePasswordSpecialCharCount is the minimum number of the nonalphanumeric characters
+	// of the password. 0 means no requirement. default 1 if it is not set
+	ValidatePasswordSpecialCharCount *uint64 `toml:"validatePasswordSpecialCharCount" user_setting:"advanced"`
+
+	// ValidatePasswordBlocklist is the comma separated passwords that can not be used. default empty
+	ValidatePasswordBlocklist string `toml:"validatePasswordBlocklist" user_setting:"advanced"`
+
 	AutoIncrCacheSize uint64 `toml:"autoIncrCacheSize"`
 
 	PrintDebug bool `toml:"printDebug"`
@@ -275,6 +317,26 @@ type FrontendParameters struct {
 
 	CleanKillQueueInterval int `toml:"cleanKillQueueInterval"`
 
+	// SuspendAccountGracePeriod is the seconds that the connections of a suspended
+	// or dropped account are kept alive to finish their in-flight statements.
+	// The connections are killed immediately if it is 0.
+	SuspendAccountGracePeriod int `toml:"suspendAccountGracePeriod"`
+
+	// NameCaseFold lowercases the names of the accounts, the users and the roles
+	// when they are normalized. The names keep their cases if it is false.
+	NameCaseFold bool `toml:"nameCaseFold"`
+
+	// NameUnicodeNormalization normalizes the names of the accounts, the users and the roles
+	// into the Unicode NFC form when they are normalized.
+	NameUnicodeNormalization bool `toml:"nameUnicodeNormalization"`
+
+	// UserInputDelimiters lists the delimiters between the account, the user and the role
+	// in the user name of the login (e.g. account:user:role). Each delimiter is one ascii character.
+	// The first one in the list that appears in the user name is used. The names of the users
+	// and the roles can not contain any of them, and the CN does not start if any existing name
+	// contains them. The proxy should be configured with the same ones. default: ":#"
+	UserInputDelimiters string `toml:"userInputDelimiters"`
+
 	// ProxyEnabled indicates that proxy module is enabled and something extra
 	// is needed, such as update the salt.
 	ProxyEnabled bool `toml:"proxy-enabled"`
@@ -287,6 +349,24 @@ type FrontendParameters struct {
 
 	// disable select into
 	DisableSelectInto bool `toml:"disable-select-into"`
+
+	// DropUserCleanupPolicy decides how to handle the objects created by the dropped user.
+	// none: keep them as they are. reassign: reassign them to the admin user of the account
+	// and annotate the audit rows made by the user as the user deleted. default: none
+	DropUserCleanupPolicy string `toml:"dropUserCleanupPolicy"`
+
+	// DroppedDefaultRolePolicy decides how to handle the session whose default role
+	// has been dropped by another session. It is applied at the next statement.
+	// public: switch the default role to the public role. terminate: close the session. default: public
+	DroppedDefaultRolePolicy string `toml:"droppedDefaultRolePolicy"`
+
+	// AdminPasswordBlocklist lists the passwords that can not be the password of the admin
+	// when the account is created. The comparison is case-insensitive. default: empty
+	AdminPasswordBlocklist []string `toml:"adminPasswordBlocklist"`
+
+	// RejectAdminPasswordSameAsName rejects the password of the admin which is same as
+	// the name of the admin when the account is created. default: false
+	RejectAdminPasswordSameAsName bool `toml:"rejectAdminPasswordSameAsName"`
 }
 
 func (fp *FrontendParameters) SetDefaultValues() {
@@ -383,6 +463,30 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.QueryResultMaxsize = 100
 	}
 
+	if fp.PasswordLockTime == 0 {
+		fp.PasswordLockTime = 300
+	}
+
+	if fp.ValidatePasswordLength == 0 {
+		fp.ValidatePasswordLength = 8
+	}
+
+	// the explicit 0 means no requirement. only the unset ones take the default.
+	if fp.ValidatePasswordMixedCaseCount == nil {
+		count := defaultValidatePasswordCount
+		fp.ValidatePasswordMixedCaseCount = &count
+	}
+
+	if fp.ValidatePasswordNumberCount == nil {
+		count := defaultValidatePasswordCount
+		fp.ValidatePasswordNumberCount = &count
+	}
+
+	if fp.ValidatePasswordSpecialCharCount == nil {
+		count := defaultValidatePasswordCount
+		fp.ValidatePasswordSpecialCharCount = &count
+	}
+
 	if fp.AutoIncrCacheSize == 0 {
 		fp.AutoIncrCacheSize = 3000000
 	}
@@ -398,6 +502,18 @@ func (fp *FrontendParameters) SetDefaultValues() {
 	if fp.CleanKillQueueInterval == 0 {
 		fp.CleanKillQueueInterval = defaultCleanKillQueueInterval
 	}
+
+	if fp.DropUserCleanupPolicy == "" {
+		fp.DropUserCleanupPolicy = defaultDropUserCleanupPolicy
+	}
+
+	if fp.DroppedDefaultRolePolicy == "" {
+		fp.DroppedDefaultRolePolicy = defaultDroppedDefaultRolePolicy
+	}
+
+	if fp.UserInputDelimiters == "" {
+		fp.UserInputDelimiters = defaultUserInputDelimiters
+	}
 }
 
 func (fp *FrontendParameters) SetMaxMessageSize(size uint64) {
@@ -133,3 +133,15 @@ func TestObservabilityParameters_SetDefaultValues1(t *testing.T) {
 		})
 	}
 }
+
+func TestFrontendParameters_SetDefaultValuesOfPasswordPolicy(t *testing.T) {
+	var zero uint64
+	fp := &FrontendParameters{ValidatePasswordNumberCount: &zero}
+	fp.SetDefaultValues()
+
+	//the explicit 0 means no requirement
+	require.Equal(t, uint64(0), *fp.ValidatePasswordNumberCount)
+	//the unset ones take the default
+	require.Equal(t, uint64(1), *fp.ValidatePasswordMixedCaseCount)
+	require.Equal(t, uint64(1), *fp.ValidatePasswordSpecialCharCount)
+}
@@ -16,8 +16,17 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/tidwall/btree"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
@@ -208,6 +217,873 @@ func checkPrivilegeInCache(ctx context.Context, ses *Session, priv *privilege, e
 	return false, nil
 }
 
+// checkPrivilegeDeniedInCache checks the privilege set is denied recently.
+// It is true only if every entry is the general one and it has been denied
+// on all the privilege levels.
+func checkPrivilegeDeniedInCache(ctx context.Context, ses *Session, priv *privilege) (bool, error) {
+	cache := ses.GetPrivilegeCache()
+	if cache == nil || len(priv.entries) == 0 {
+		return false, nil
+	}
+	dbName := ses.GetDatabaseName()
+	for _, entry := range priv.entries {
+		if entry.privilegeEntryTyp != privilegeEntryTypeGeneral {
+			return false, nil
+		}
+		pls, err := getPrivilegeLevelsOfObjectType(ctx, entry.objType)
+		if err != nil {
+			return false, err
+		}
+		entryDbName := entry.databaseName
+		if len(entryDbName) == 0 {
+			entryDbName = dbName
+		}
+		for _, pl := range pls {
+			if !cache.isDenied(entry.objType, pl, entryDbName, entry.tableName, entry.privilegeId) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// cacheDeniedPrivilege puts the general entries of the privilege set into the
+// denied cache after all the roles of the user do not have them.
+func cacheDeniedPrivilege(ctx context.Context, ses *Session, priv *privilege) error {
+	cache := ses.GetPrivilegeCache()
+	if cache == nil {
+		return nil
+	}
+	dbName := ses.GetDatabaseName()
+	for _, entry := range priv.entries {
+		if entry.privilegeEntryTyp != privilegeEntryTypeGeneral {
+			continue
+		}
+		//the entry that is not checked with the roles is not denied by them
+		if !verifyLightPrivilege(ses,
+			entry.databaseName,
+			priv.writeDatabaseAndTableDirectly,
+			priv.isClusterTable,
+			priv.clusterTableOperation) {
+			continue
+		}
+		pls, err := getPrivilegeLevelsOfObjectType(ctx, entry.objType)
+		if err != nil {
+			return err
+		}
+		entryDbName := entry.databaseName
+		if len(entryDbName) == 0 {
+			entryDbName = dbName
+		}
+		for _, pl := range pls {
+			cache.deny(entry.objType, pl, entryDbName, entry.tableName, entry.privilegeId)
+		}
+	}
+	return nil
+}
+
+// warmUpPrivilegeLevels are the privilege levels that do not depend on the names of the objects.
+// The privileges on them are loaded into the cache in the warm-up.
+var warmUpPrivilegeLevels = []struct {
+	objType objectType
+	pl      privilegeLevelType
+}{
+	{objectTypeAccount, privilegeLevelStar},
+	{objectTypeDatabase, privilegeLevelStar},
+	{objectTypeDatabase, privilegeLevelStarStar},
+	{objectTypeTable, privilegeLevelStarStar},
+}
+
+// getEffectiveRolesOfSession gets the roles of the session and the roles inherited by them.
+func getEffectiveRolesOfSession(ctx context.Context, bh BackgroundExec, ses *Session) ([]int64, error) {
+	return getEffectiveRolesOfTenant(ctx, bh, ses.GetTenantInfo())
+}
+
+// getEffectiveRolesOfTenant gets the roles of the user in the tenant and the roles inherited by them.
+// All the roles granted to the user are taken if the secondary role is used.
+// Otherwise, only the default role.
+func getEffectiveRolesOfTenant(ctx context.Context, bh BackgroundExec, tenant *TenantInfo) ([]int64, error) {
+	return getEffectiveRolesOfTenantExcept(ctx, bh, tenant, nil)
+}
+
+// getEffectiveRolesOfTenantExcept is the getEffectiveRolesOfTenant as if the excluded roles were dropped.
+// The excluded roles and the roles only inherited through them are not taken.
+func getEffectiveRolesOfTenantExcept(ctx context.Context, bh BackgroundExec, tenant *TenantInfo, excluded map[int64]bool) ([]int64, error) {
+	var err error
+	var erArray []ExecResult
+	var roleId int64
+	visited := &btree.Set[int64]{}
+	queue := make([]int64, 0)
+
+	visit := func(sql string) error {
+		bh.ClearExecResultSet()
+		err = execRoleGrantSql(ctx, bh, sql)
+		if err != nil {
+			return err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		if err != nil {
+			return err
+		}
+		if execResultArrayHasData(erArray) {
+			for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+				roleId, err = erArray[0].GetInt64(ctx, i, 0)
+				if err != nil {
+					return err
+				}
+				if !visited.Contains(roleId) && !excluded[roleId] {
+					visited.Insert(roleId)
+					queue = append(queue, roleId)
+				}
+			}
+		}
+		return nil
+	}
+
+	if tenant.GetUseSecondaryRole() {
+		err = visit(getSqlForGetRolesOfCurrentUser(int64(tenant.GetUserID())))
+		if err != nil {
+			return nil, err
+		}
+	} else if !excluded[int64(tenant.GetDefaultRoleID())] {
+		visited.Insert(int64(tenant.GetDefaultRoleID()))
+		queue = append(queue, int64(tenant.GetDefaultRoleID()))
+	}
+
+	for len(queue) != 0 {
+		roleId = queue[0]
+		queue = queue[1:]
+		err = visit(getSqlForInheritedRoleIdOfRoleId(roleId))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return visited.Keys(), nil
+}
+
+// roleHierarchyEdge is a grant in the role hierarchy of the user
+type roleHierarchyEdge struct {
+	grantedId int64
+	granted   string
+	//the grantee is the user for the roles of the user themselves
+	granteeId       int64
+	grantee         string
+	toUser          bool
+	withGrantOption bool
+	//the depth of the granted role from the roles of the user
+	depth int
+}
+
+// getRoleHierarchyOfUser lists the grants among the roles that the user has.
+// The roles of the user are the default role and all the roles granted to the user
+// if the secondary role is used. They are granted to the user in the depth 0.
+// The roles inherited by them are ordered by the depth.
+// It is the current user if the userName is empty.
+// Only the moadmin or the accountadmin can list the one of other users.
+func getRoleHierarchyOfUser(ctx context.Context, ses *Session, userName string) (ret []*roleHierarchyEdge, err error) {
+	var erArray []ExecResult
+	var sql string
+	var userId, defaultRoleId, wgo int64
+	var names map[int64]string
+	tenant := ses.GetTenantInfo()
+	target := tenant
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	query := func(sql string) error {
+		bh.ClearExecResultSet()
+		err := bh.Exec(ctx, sql)
+		if err != nil {
+			return err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		return err
+	}
+
+	if len(userName) != 0 && userName != tenant.GetUser() {
+		if !tenant.IsAdminRole() {
+			return nil, moerr.NewInternalError(ctx, "only the moadmin or the accountadmin can show the role hierarchy of other users")
+		}
+		sql, err = getSqlForPasswordOfUser(ctx, userName)
+		if err != nil {
+			return nil, err
+		}
+		if err = query(sql); err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			return nil, moerr.NewInternalError(ctx, "there is no user %s", userName)
+		}
+		if userId, err = erArray[0].GetInt64(ctx, 0, 0); err != nil {
+			return nil, err
+		}
+		if defaultRoleId, err = erArray[0].GetInt64(ctx, 0, 2); err != nil {
+			return nil, err
+		}
+		//the secondary roles of other users are unknown, only the default role is taken
+		target = &TenantInfo{
+			Tenant:        tenant.GetTenant(),
+			User:          userName,
+			TenantID:      tenant.GetTenantID(),
+			UserID:        uint32(userId),
+			DefaultRoleID: uint32(defaultRoleId),
+			delimiter:     ':',
+		}
+	}
+
+	roots := &btree.Set[int64]{}
+	roots.Insert(int64(target.GetDefaultRoleID()))
+	err = loadAllSecondaryRoles(ctx, bh, target, roots)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIds := roots.Keys()
+	for _, roleId := range roots.Keys() {
+		if err = query(getSqlForCheckUserGrant(roleId, int64(target.GetUserID()))); err != nil {
+			return nil, err
+		}
+		wgo = 0
+		if execResultArrayHasData(erArray) {
+			if wgo, err = erArray[0].GetInt64(ctx, 0, 2); err != nil {
+				return nil, err
+			}
+		}
+		ret = append(ret, &roleHierarchyEdge{
+			grantedId:       roleId,
+			granteeId:       int64(target.GetUserID()),
+			grantee:         target.GetUser(),
+			toUser:          true,
+			withGrantOption: wgo != 0,
+		})
+	}
+
+	_, err = walkInheritedRoles(ctx, bh, nil, roots, func(depth int, roles *btree.Set[int64], edges []roleGrantEdge) (bool, error) {
+		for _, e := range edges {
+			ret = append(ret, &roleHierarchyEdge{
+				grantedId:       e.grantedId,
+				granteeId:       e.granteeId,
+				withGrantOption: e.withGrantOption,
+				depth:           depth,
+			})
+		}
+		roleIds = append(roleIds, roles.Keys()...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names, err = getRoleNamesOfRoleIds(ctx, bh, roleIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range ret {
+		e.granted = names[e.grantedId]
+		if !e.toUser {
+			e.grantee = names[e.granteeId]
+		}
+	}
+	return ret, err
+}
+
+// privilegeUsage is a record of the audit trail of the privilege checks.
+// The grant is the one that satisfies the allowed privilege check of the user.
+type privilegeUsage struct {
+	user  string
+	grant *privilegeGrant
+	at    time.Time
+}
+
+// usedPrivilegeKey is the privilege of the role on the object that satisfies the privilege checks
+type usedPrivilegeKey struct {
+	roleId      int64
+	privilegeId PrivilegeType
+	objType     string
+	object      string
+}
+
+// objectName is the name of the object of the grant in the form of the privilegeObjectName.
+func (pg *privilegeGrant) objectName() string {
+	pl := pg.privilegeLevel.String()
+	switch pg.privilegeLevel {
+	case privilegeLevelDatabaseTable, privilegeLevelTable:
+		return privilegeObjectName(pl, "", pg.databaseName, pg.tableName)
+	case privilegeLevelDatabase, privilegeLevelDatabaseStar:
+		return privilegeObjectName(pl, pg.databaseName, "", "")
+	case privilegeLevelStar:
+		//the table privilege on the * is on the current database
+		if pg.objType == objectTypeTable {
+			return privilegeObjectName(pl, pg.databaseName, "", "")
+		}
+	}
+	return pl
+}
+
+// unusedPrivilege is a privilege granted to the role of the user
+// that does not satisfy any privilege check of the user in the time window.
+type unusedPrivilege struct {
+	roleId         int64
+	role           string
+	privilegeId    PrivilegeType
+	privilegeLevel string
+	objType        string
+	object         string
+}
+
+func (up *unusedPrivilege) String() string {
+	return fmt.Sprintf("%s %s on %s %s", up.role, up.privilegeId, up.objType, up.object)
+}
+
+// getUnusedPrivilegesOfUser gets the privileges granted to the user minus the ones used
+// by the user in the time window [from, to) for the least-privilege review.
+// The granted privileges are the ones of all the roles granted to the user and the roles inherited by them.
+// The used privileges are the grants that satisfy the privilege checks in the usages.
+// The usage without the satisfying grant, from the privilege cache or in the trusted context
+// does not tell the role and the privilege row, it is not taken.
+// Only the moadmin or the accountadmin can do it for other users.
+func getUnusedPrivilegesOfUser(ctx context.Context, ses *Session, userName string, usages []*privilegeUsage, from, to time.Time) (ret []*unusedPrivilege, err error) {
+	var erArray []ExecResult
+	var sql, objType, privilegeLevel, dbName, reldatabase, relname string
+	var userId, defaultRoleId, privId int64
+	var names map[int64]string
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return nil, moerr.NewInternalError(ctx, "the user of the session is unknown")
+	}
+	if len(userName) == 0 {
+		userName = tenant.GetUser()
+	}
+	if userName != tenant.GetUser() && !tenant.IsAdminRole() {
+		return nil, moerr.NewInternalError(ctx, "only the moadmin or the accountadmin can get the unused privileges of other users")
+	}
+
+	used := make(map[usedPrivilegeKey]bool)
+	for _, usage := range usages {
+		if usage == nil || usage.user != userName || usage.at.Before(from) || !usage.at.Before(to) {
+			continue
+		}
+		g := usage.grant
+		if g == nil || g.trusted || g.fromCache {
+			continue
+		}
+		used[usedPrivilegeKey{g.roleId, g.privilegeId, g.objType.String(), g.objectName()}] = true
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	query := func(sql string) error {
+		bh.ClearExecResultSet()
+		err := bh.Exec(ctx, sql)
+		if err != nil {
+			return err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		return err
+	}
+
+	sql, err = getSqlForPasswordOfUser(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	if err = query(sql); err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewInternalError(ctx, "there is no user %s", userName)
+	}
+	if userId, err = erArray[0].GetInt64(ctx, 0, 0); err != nil {
+		return nil, err
+	}
+	if defaultRoleId, err = erArray[0].GetInt64(ctx, 0, 2); err != nil {
+		return nil, err
+	}
+
+	//all the roles granted to the user can be used by the secondary roles
+	target := &TenantInfo{
+		Tenant:        tenant.GetTenant(),
+		User:          userName,
+		TenantID:      tenant.GetTenantID(),
+		UserID:        uint32(userId),
+		DefaultRoleID: uint32(defaultRoleId),
+		delimiter:     ':',
+	}
+	target.SetUseSecondaryRole(true)
+
+	roots := &btree.Set[int64]{}
+	roots.Insert(defaultRoleId)
+	err = loadAllSecondaryRoles(ctx, bh, target, roots)
+	if err != nil {
+		return nil, err
+	}
+	roleIds := roots.Keys()
+	_, err = walkInheritedRoles(ctx, bh, nil, roots, func(_ int, roles *btree.Set[int64], _ []roleGrantEdge) (bool, error) {
+		roleIds = append(roleIds, roles.Keys()...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, roleId := range roleIds {
+		if err = query(getSqlForPrivilegesOfRoleWithObject(roleId)); err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			continue
+		}
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			if objType, err = erArray[0].GetString(ctx, i, 0); err != nil {
+				return nil, err
+			}
+			if privId, err = erArray[0].GetInt64(ctx, i, 1); err != nil {
+				return nil, err
+			}
+			if privilegeLevel, err = erArray[0].GetString(ctx, i, 2); err != nil {
+				return nil, err
+			}
+			if dbName, err = erArray[0].GetString(ctx, i, 3); err != nil {
+				return nil, err
+			}
+			if reldatabase, err = erArray[0].GetString(ctx, i, 4); err != nil {
+				return nil, err
+			}
+			if relname, err = erArray[0].GetString(ctx, i, 5); err != nil {
+				return nil, err
+			}
+			up := &unusedPrivilege{
+				roleId:         roleId,
+				privilegeId:    PrivilegeType(privId),
+				privilegeLevel: privilegeLevel,
+				objType:        objType,
+				object:         privilegeObjectName(privilegeLevel, dbName, reldatabase, relname),
+			}
+			if used[usedPrivilegeKey{up.roleId, up.privilegeId, up.objType, up.object}] {
+				continue
+			}
+			ret = append(ret, up)
+		}
+	}
+
+	names, err = getRoleNamesOfRoleIds(ctx, bh, roleIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, up := range ret {
+		up.role = names[up.roleId]
+	}
+	return ret, err
+}
+
+// compoundItemCheck is the result of checking one item of the compound entry
+type compoundItemCheck struct {
+	privilegeTyp PrivilegeType
+	dbName       string
+	tableName    string
+	allowed      bool
+	//the role that satisfies the item. It is meaningful only when the item is allowed.
+	roleId int64
+	//the privilege that the role has. It may be the table all or the ownership.
+	grantedTyp PrivilegeType
+}
+
+func (c *compoundItemCheck) String() string {
+	s := fmt.Sprintf("%s on %s.%s ", c.privilegeTyp, c.dbName, c.tableName)
+	if !c.allowed {
+		return s + fmt.Sprintf("denied (%s)", c.privilegeTyp.Description())
+	}
+	return s + fmt.Sprintf("allowed by role %d with %s", c.roleId, c.grantedTyp)
+}
+
+// explainCompoundPrivilege checks the items of the compound entry one by one
+// with the effective roles of the session.
+// The item is allowed if any role has the privilege of the item,
+// the table all or the ownership on the table of the item.
+// It tells which table in the multi-table statement the roles can not access.
+func explainCompoundPrivilege(ctx context.Context, ses *Session, priv *privilege) (ret []*compoundItemCheck, err error) {
+	var compound *compoundEntry
+	var roleIds []int64
+	var pls []privilegeLevelType
+	var matched *btree.Set[int64]
+	for _, entry := range priv.entries {
+		if entry.privilegeEntryTyp == privilegeEntryTypeCompound && entry.compound != nil {
+			compound = entry.compound
+			break
+		}
+	}
+	if compound == nil {
+		return nil, nil
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	roleIds, err = getEffectiveRolesOfSession(ctx, bh, ses)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []PrivilegeType{PrivilegeTypeTableAll, PrivilegeTypeTableOwnership}
+	for _, mi := range compound.items {
+		if mi.privilegeTyp == PrivilegeTypeCanGrantRoleToOthersInCreateUser {
+			continue
+		}
+		check := &compoundItemCheck{
+			privilegeTyp: mi.privilegeTyp,
+			dbName:       mi.dbName,
+			tableName:    mi.tableName,
+		}
+		if len(check.dbName) == 0 {
+			check.dbName = ses.GetDatabaseName()
+		}
+		ret = append(ret, check)
+
+		if !verifyLightPrivilege(ses,
+			mi.dbName,
+			priv.writeDatabaseAndTableDirectly,
+			mi.isClusterTable,
+			mi.clusterTableOperation) {
+			continue
+		}
+
+	outer:
+		for _, roleId := range roleIds {
+			for _, typ := range append([]PrivilegeType{mi.privilegeTyp}, candidates...) {
+				tempEntry := privilegeEntriesMap[typ]
+				tempEntry.databaseName = mi.dbName
+				tempEntry.tableName = mi.tableName
+				pls, err = getPrivilegeLevelsOfObjectType(ctx, tempEntry.objType)
+				if err != nil {
+					return nil, err
+				}
+				matched, err = verifyPrivilegeEntryInMultiPrivilegeLevels(ctx, bh, ses, nil, []int64{roleId}, tempEntry, pls, false, false, nil)
+				if err != nil {
+					return nil, err
+				}
+				if matched.Len() != 0 {
+					check.allowed = true
+					check.roleId = roleId
+					check.grantedTyp = typ
+					break outer
+				}
+			}
+		}
+	}
+	return ret, err
+}
+
+// getMinimalRolesOfPrivileges returns the names of the fewest existing roles in the current account
+// that grant all the privilege entries to a user together.
+// A role grants the privilege if it has the privilege directly or inherits it from the roles granted to it.
+// It returns nil if no role set can grant all of them.
+func getMinimalRolesOfPrivileges(ctx context.Context, ses *Session, entries []privilegeEntry) (ret []string, err error) {
+	var erArray []ExecResult
+	var roleId, grantedId, granteeId int64
+	var roleName string
+	var pls []privilegeLevelType
+	var matched *btree.Set[int64]
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	//step 1: the roles of the account
+	var roleIds []int64
+	roleNames := make(map[int64]string)
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getRolesOfAccountSql)
+	if err != nil {
+		return nil, err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			if roleId, err = erArray[0].GetInt64(ctx, i, 0); err != nil {
+				return nil, err
+			}
+			if roleName, err = erArray[0].GetString(ctx, i, 1); err != nil {
+				return nil, err
+			}
+			roleIds = append(roleIds, roleId)
+			roleNames[roleId] = roleName
+		}
+	}
+
+	//step 2: the entries that the roles have directly
+	direct := make(map[int64][]bool, len(roleIds))
+	for _, roleId = range roleIds {
+		direct[roleId] = make([]bool, len(entries))
+	}
+	for i, entry := range entries {
+		pls, err = getPrivilegeLevelsOfObjectType(ctx, entry.objType)
+		if err != nil {
+			return nil, err
+		}
+		matched, err = verifyPrivilegeEntryInMultiPrivilegeLevels(ctx, bh, ses, nil, roleIds, entry, pls, false, true, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, roleId = range matched.Keys() {
+			if _, ok := direct[roleId]; ok {
+				direct[roleId][i] = true
+			}
+		}
+	}
+
+	//step 3: the entries that the roles inherit
+	inherited := make(map[int64][]int64)
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getSqlForGetAllStuffRoleGrantFormat())
+	if err != nil {
+		return nil, err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			if grantedId, err = erArray[0].GetInt64(ctx, i, 0); err != nil {
+				return nil, err
+			}
+			if granteeId, err = erArray[0].GetInt64(ctx, i, 1); err != nil {
+				return nil, err
+			}
+			inherited[granteeId] = append(inherited[granteeId], grantedId)
+		}
+	}
+
+	covers := make(map[int64][]bool, len(roleIds))
+	for _, roleId = range roleIds {
+		cover := make([]bool, len(entries))
+		visited := map[int64]bool{roleId: true}
+		queue := []int64{roleId}
+		for len(queue) != 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for i, has := range direct[id] {
+				cover[i] = cover[i] || has
+			}
+			for _, granted := range inherited[id] {
+				if !visited[granted] {
+					visited[granted] = true
+					queue = append(queue, granted)
+				}
+			}
+		}
+		covers[roleId] = cover
+	}
+
+	//step 4: the smallest covering set
+	for _, id := range minimalCoveringRoles(roleIds, covers, len(entries)) {
+		ret = append(ret, roleNames[id])
+	}
+	return ret, err
+}
+
+// minimalCoveringRoles finds the fewest roles whose covers contain all the n entries together.
+// The roles are tried in the order, so that the first smallest set in the order is returned.
+// It returns nil if the roles can not cover all the entries.
+func minimalCoveringRoles(roleIds []int64, covers map[int64][]bool, n int) []int64 {
+	var best []int64
+	chosen := make([]int64, 0, n)
+	covered := make([]int, n)
+
+	var search func()
+	search = func() {
+		//the set can not be smaller than the best one
+		if best != nil && len(chosen) >= len(best) {
+			return
+		}
+		//the first entry that is not covered yet
+		uncovered := -1
+		for i := range covered {
+			if covered[i] == 0 {
+				uncovered = i
+				break
+			}
+		}
+		if uncovered == -1 {
+			best = append([]int64{}, chosen...)
+			return
+		}
+		//one of the roles covering it must be in the set
+		for _, roleId := range roleIds {
+			cover := covers[roleId]
+			if !cover[uncovered] {
+				continue
+			}
+			for i, has := range cover {
+				if has {
+					covered[i]++
+				}
+			}
+			chosen = append(chosen, roleId)
+			search()
+			chosen = chosen[:len(chosen)-1]
+			for i, has := range cover {
+				if has {
+					covered[i]--
+				}
+			}
+		}
+	}
+
+	if n == 0 {
+		return nil
+	}
+	search()
+	return best
+}
+
+// explainPrivilegeDenial describes the items of the denied multi-table statement
+// when the explain_privilege_denial is on. Otherwise, it returns the empty string.
+func explainPrivilegeDenial(ctx context.Context, ses *Session, stmt tree.Statement, p *plan2.Plan) (string, error) {
+	value, err := ses.GetSessionSysVar(ExplainPrivilegeDenial)
+	if err != nil {
+		return "", err
+	}
+	on, err := valueIsBoolTrue(value)
+	if err != nil || !on {
+		return "", err
+	}
+
+	priv := determinePrivilegeSetOfStatement(stmt)
+	if priv.objectType() != objectTypeTable {
+		return "", nil
+	}
+	convertPrivilegeTipsToPrivilege(priv, extractPrivilegeTipsFromPlan(p))
+	checks, err := explainCompoundPrivilege(ctx, ses, priv)
+	if err != nil {
+		return "", err
+	}
+	items := make([]string, 0, len(checks))
+	for _, check := range checks {
+		items = append(items, check.String())
+	}
+	return strings.Join(items, "; "), nil
+}
+
+// warmUpPrivilegeCacheIfEnabled warms up the privilege cache again after it is invalidated
+// when the session variable warm_up_privilege_cache is on.
+func warmUpPrivilegeCacheIfEnabled(ctx context.Context, ses *Session) error {
+	warmUp, err := ses.GetSessionSysVar("warm_up_privilege_cache")
+	if err != nil {
+		return err
+	}
+	ok, err := valueIsBoolTrue(warmUp)
+	if err != nil || !ok {
+		return err
+	}
+	return warmUpPrivilegeCache(ctx, ses)
+}
+
+// warmUpPrivilegeCache loads the common privileges of the effective roles of the session
+// into the privilege cache in one query. The later checks on them are served from the cache.
+func warmUpPrivilegeCache(ctx context.Context, ses *Session) (err error) {
+	var erArray []ExecResult
+	var roleIds []int64
+	var objType, pl string
+	var privId int64
+	cache := ses.GetPrivilegeCache()
+	if cache == nil || ses.GetTenantInfo() == nil {
+		return nil
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	roleIds, err = getEffectiveRolesOfSession(ctx, bh, ses)
+	if err != nil {
+		return err
+	}
+	if len(roleIds) == 0 {
+		return nil
+	}
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getSqlForCommonPrivilegesOfRoles(roleIds))
+	if err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil
+	}
+
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		objType, err = erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return err
+		}
+		pl, err = erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return err
+		}
+		privId, err = erArray[0].GetInt64(ctx, i, 2)
+		if err != nil {
+			return err
+		}
+		for _, wpl := range warmUpPrivilegeLevels {
+			if wpl.objType.String() == objType && wpl.pl.String() == pl {
+				cache.add(wpl.objType, wpl.pl, "", "", PrivilegeType(privId))
+				break
+			}
+		}
+	}
+	return err
+}
+
 // privilegeCacheIsEnabled checks if the privilege cache is enabled.
 func privilegeCacheIsEnabled(ctx context.Context, ses *Session) (bool, error) {
 	var err error
@@ -226,6 +1102,25 @@ func privilegeCacheIsEnabled(ctx context.Context, ses *Session) (bool, error) {
 	return newValue, err
 }
 
+// privilegeCacheTTL gets the seconds that a cached privilege keeps valid.
+// 0 means no expiry.
+func privilegeCacheTTL(ses *Session) (time.Duration, error) {
+	value, err := ses.GetSessionSysVar("privilege_cache_ttl")
+	if err != nil {
+		return 0, err
+	}
+	var seconds uint64
+	switch v := value.(type) {
+	case uint64:
+		seconds = v
+	case int64:
+		seconds = uint64(v)
+	case float64:
+		seconds = uint64(v)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
 // hasMoCtrl checks whether the plan has mo_ctrl
 func hasMoCtrl(p *plan2.Plan) bool {
 	if p != nil && p.GetQuery() != nil { //select,insert select, update, delete
@@ -252,3 +1147,286 @@ func hasMoCtrl(p *plan2.Plan) bool {
 func verifyAccountCanExecMoCtrl(account *TenantInfo) bool {
 	return account.IsSysTenant() && account.IsMoAdminRole()
 }
+
+// effectivePrivilege is a privilege that the user has through the effective roles
+type effectivePrivilege struct {
+	ObjectType      string `json:"object_type"`
+	Object          string `json:"object"`
+	Privilege       string `json:"privilege"`
+	Description     string `json:"description"`
+	PrivilegeLevel  string `json:"privilege_level"`
+	WithGrantOption bool   `json:"with_grant_option"`
+	//the roles that have the privilege
+	Roles []string `json:"roles"`
+}
+
+// effectivePrivileges is the document of the effective privileges of the user
+type effectivePrivileges struct {
+	Account    string                `json:"account"`
+	User       string                `json:"user"`
+	Roles      []string              `json:"roles"`
+	Privileges []*effectivePrivilege `json:"privileges"`
+}
+
+// getEffectivePrivilegesJSON gets all the effective privileges of the user of the session
+// as a json document. The privileges come from the roles of the session and the roles
+// inherited by them. The privilege that the multiple roles have is merged by the mergeGrantOption.
+func getEffectivePrivilegesJSON(ctx context.Context, ses *Session) (ret []byte, err error) {
+	var erArray []ExecResult
+	var roleIds []int64
+	var roleName, objType, dbName, reldatabase, relname string
+	var wgo, privId int64
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return nil, moerr.NewInternalError(ctx, "the user of the session is unknown")
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	query := func(sql string) error {
+		bh.ClearExecResultSet()
+		err := bh.Exec(ctx, sql)
+		if err != nil {
+			return err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		return err
+	}
+
+	roleIds, err = getEffectiveRolesOfSession(ctx, bh, ses)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &effectivePrivileges{
+		Account:    tenant.GetTenant(),
+		User:       tenant.GetUser(),
+		Roles:      make([]string, 0, len(roleIds)),
+		Privileges: make([]*effectivePrivilege, 0),
+	}
+	type privKey struct {
+		objType        string
+		object         string
+		privilege      string
+		privilegeLevel string
+	}
+	merged := make(map[privKey]*effectivePrivilege)
+	for _, roleId := range roleIds {
+		if err = query(getSqlForRoleNameOfRoleId(roleId)); err != nil {
+			return nil, err
+		}
+		//the role has been dropped
+		if !execResultArrayHasData(erArray) {
+			continue
+		}
+		if roleName, err = erArray[0].GetString(ctx, 0, 0); err != nil {
+			return nil, err
+		}
+		doc.Roles = append(doc.Roles, roleName)
+
+		if err = query(getSqlForNamedPrivilegesOfRole(roleId)); err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			continue
+		}
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			ep := &effectivePrivilege{}
+			if objType, err = erArray[0].GetString(ctx, i, 0); err != nil {
+				return nil, err
+			}
+			if ep.Privilege, err = erArray[0].GetString(ctx, i, 1); err != nil {
+				return nil, err
+			}
+			if ep.PrivilegeLevel, err = erArray[0].GetString(ctx, i, 2); err != nil {
+				return nil, err
+			}
+			if wgo, err = erArray[0].GetInt64(ctx, i, 3); err != nil {
+				return nil, err
+			}
+			if dbName, err = erArray[0].GetString(ctx, i, 4); err != nil {
+				return nil, err
+			}
+			if reldatabase, err = erArray[0].GetString(ctx, i, 5); err != nil {
+				return nil, err
+			}
+			if relname, err = erArray[0].GetString(ctx, i, 6); err != nil {
+				return nil, err
+			}
+			if privId, err = erArray[0].GetInt64(ctx, i, 7); err != nil {
+				return nil, err
+			}
+			ep.Description = PrivilegeType(privId).Description()
+			ep.ObjectType = objType
+			ep.Object = privilegeObjectName(ep.PrivilegeLevel, dbName, reldatabase, relname)
+			ep.WithGrantOption = wgo != 0
+
+			key := privKey{ep.ObjectType, ep.Object, ep.Privilege, ep.PrivilegeLevel}
+			if old, ok := merged[key]; ok {
+				old.WithGrantOption = mergeGrantOption(ep.WithGrantOption, old.WithGrantOption)
+				old.Roles = append(old.Roles, roleName)
+				continue
+			}
+			ep.Roles = []string{roleName}
+			merged[key] = ep
+			doc.Privileges = append(doc.Privileges, ep)
+		}
+	}
+
+	sort.Slice(doc.Privileges, func(i, j int) bool {
+		a, b := doc.Privileges[i], doc.Privileges[j]
+		if a.ObjectType != b.ObjectType {
+			return a.ObjectType < b.ObjectType
+		}
+		if a.Object != b.Object {
+			return a.Object < b.Object
+		}
+		if a.PrivilegeLevel != b.PrivilegeLevel {
+			return a.PrivilegeLevel < b.PrivilegeLevel
+		}
+		return a.Privilege < b.Privilege
+	})
+
+	return json.Marshal(doc)
+}
+
+// roleGraphNode is a role in the role grant graph
+type roleGraphNode struct {
+	RoleId   int64  `json:"role_id"`
+	RoleName string `json:"role_name"`
+	//the count of the privileges granted to the role directly
+	PrivilegeCount int64 `json:"privilege_count"`
+}
+
+// roleGraphEdge is the grant of the role From to the role To
+type roleGraphEdge struct {
+	From            int64 `json:"from"`
+	To              int64 `json:"to"`
+	WithGrantOption bool  `json:"with_grant_option"`
+}
+
+// roleGraph is the role grant graph of the account for rendering
+type roleGraph struct {
+	Account string           `json:"account"`
+	Nodes   []*roleGraphNode `json:"nodes"`
+	Edges   []*roleGraphEdge `json:"edges"`
+}
+
+// getRoleGraphOfAccount gets the role grant graph of the current account.
+// The nodes are all the roles of the account. The edges are the grants among them
+// in the mo_role_grant. The grants of the dropped roles are not taken.
+// Only the moadmin or the accountadmin can get it.
+func getRoleGraphOfAccount(ctx context.Context, ses *Session) (ret *roleGraph, err error) {
+	var erArray []ExecResult
+	var roleId, cnt int64
+	var roleName string
+	tenant := ses.GetTenantInfo()
+	if tenant == nil || !tenant.IsAdminRole() {
+		return nil, moerr.NewInternalError(ctx, "only admin can get the role graph")
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	query := func(sql string) error {
+		bh.ClearExecResultSet()
+		err := bh.Exec(ctx, sql)
+		if err != nil {
+			return err
+		}
+		erArray, err = getResultSet(ctx, bh)
+		return err
+	}
+
+	ret = &roleGraph{
+		Account: tenant.GetTenant(),
+		Nodes:   make([]*roleGraphNode, 0),
+		Edges:   make([]*roleGraphEdge, 0),
+	}
+
+	//step 1: the roles of the account
+	nodes := make(map[int64]*roleGraphNode)
+	if err = query(getRolesOfAccountSql); err != nil {
+		return nil, err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			if roleId, err = erArray[0].GetInt64(ctx, i, 0); err != nil {
+				return nil, err
+			}
+			if roleName, err = erArray[0].GetString(ctx, i, 1); err != nil {
+				return nil, err
+			}
+			node := &roleGraphNode{RoleId: roleId, RoleName: roleName}
+			nodes[roleId] = node
+			ret.Nodes = append(ret.Nodes, node)
+		}
+	}
+
+	//step 2: the count of the privileges of the roles
+	if err = query(getPrivilegeCountOfRolesSql); err != nil {
+		return nil, err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			if roleId, err = erArray[0].GetInt64(ctx, i, 0); err != nil {
+				return nil, err
+			}
+			if cnt, err = erArray[0].GetInt64(ctx, i, 1); err != nil {
+				return nil, err
+			}
+			if node, ok := nodes[roleId]; ok {
+				node.PrivilegeCount = cnt
+			}
+		}
+	}
+
+	//step 3: the grants among the roles
+	grants, err := getAllRoleGrants(ctx, bh, ses.GetRoleGrantCache())
+	if err != nil {
+		return nil, err
+	}
+	g := NewGraph()
+	wgo := make(map[int]bool, len(grants))
+	for _, grant := range grants {
+		if nodes[grant.grantedId] == nil || nodes[grant.granteeId] == nil {
+			continue
+		}
+		wgo[g.addEdge(grant.grantedId, grant.granteeId)] = grant.withGrantOption
+	}
+	for eid, e := range g.edges {
+		if e.isInvalid() {
+			continue
+		}
+		ret.Edges = append(ret.Edges, &roleGraphEdge{
+			From:            e.from,
+			To:              e.to,
+			WithGrantOption: wgo[eid],
+		})
+	}
+	sort.Slice(ret.Edges, func(i, j int) bool {
+		if ret.Edges[i].From != ret.Edges[j].From {
+			return ret.Edges[i].From < ret.Edges[j].From
+		}
+		return ret.Edges[i].To < ret.Edges[j].To
+	})
+
+	return ret, nil
+}
@@ -16,12 +16,21 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	plan3 "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
@@ -231,3 +240,546 @@ func newTestExecCtx(ctx context.Context, ctrl *gomock.Controller) *ExecCtx {
 	}
 	return ret
 }
+
+func newMrsForCommonPrivilegesOfRoles(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("obj_type")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("privilege_level")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("privilege_id")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func Test_warmUpPrivilegeCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	priv := determinePrivilegeSetOfStatement(&tree.CreateDatabase{})
+	ses := newSes(priv, ctrl)
+
+	sql2result := make(map[string]ExecResult)
+	//role 1 is granted to the role 0
+	sql2result[getSqlForInheritedRoleIdOfRoleId(0)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+		{1, true},
+	})
+	sql2result[getSqlForInheritedRoleIdOfRoleId(1)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+	sql2result[getSqlForCommonPrivilegesOfRoles([]int64{0, 1})] = newMrsForCommonPrivilegesOfRoles([][]interface{}{
+		{objectTypeAccount.String(), privilegeLevelStar.String(), int64(PrivilegeTypeCreateDatabase)},
+		//the privilege on the tables of the current database is not loaded
+		{objectTypeTable.String(), privilegeLevelStar.String(), int64(PrivilegeTypeSelect)},
+	})
+
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	err := warmUpPrivilegeCache(context.TODO(), ses)
+	assert.NoError(t, err)
+	assert.Contains(t, executed, getSqlForCommonPrivilegesOfRoles([]int64{0, 1}))
+
+	cache := ses.GetPrivilegeCache()
+	assert.False(t, cache.has(objectTypeTable, privilegeLevelStar, "", "", PrivilegeTypeSelect))
+
+	//the check is served from the cache without new sql
+	executedCnt := len(executed)
+	hit := cache.hit.Load()
+	ok, err := checkPrivilegeInCache(context.TODO(), ses, priv, true)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Greater(t, cache.hit.Load(), hit)
+	assert.Equal(t, executedCnt, len(executed))
+}
+
+func Test_warmUpPrivilegeCacheIfEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	priv := determinePrivilegeSetOfStatement(&tree.CreateDatabase{})
+	ses := newSes(priv, ctrl)
+
+	sql2result := make(map[string]ExecResult)
+	sql2result[getSqlForInheritedRoleIdOfRoleId(0)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+	sql2result[getSqlForCommonPrivilegesOfRoles([]int64{0})] = newMrsForCommonPrivilegesOfRoles([][]interface{}{
+		{objectTypeAccount.String(), privilegeLevelStar.String(), int64(PrivilegeTypeCreateDatabase)},
+	})
+
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	//off by default
+	err := warmUpPrivilegeCacheIfEnabled(context.TODO(), ses)
+	assert.NoError(t, err)
+	assert.Empty(t, executed)
+
+	//the cache is loaded again after the invalidation by the grant
+	err = ses.SetSessionSysVar(context.TODO(), "warm_up_privilege_cache", "on")
+	assert.NoError(t, err)
+	ses.InvalidatePrivilegeCache()
+	err = warmUpPrivilegeCacheIfEnabled(context.TODO(), ses)
+	assert.NoError(t, err)
+	assert.Contains(t, executed, getSqlForCommonPrivilegesOfRoles([]int64{0}))
+	assert.True(t, ses.GetPrivilegeCache().has(objectTypeAccount, privilegeLevelStar, "", "", PrivilegeTypeCreateDatabase))
+}
+
+func Test_getMinimalRolesOfPrivileges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+	required := []PrivilegeType{PrivilegeTypeCreateUser, PrivilegeTypeDropUser, PrivilegeTypeCreateRole}
+	entries := make([]privilegeEntry, 0, len(required))
+	for _, typ := range required {
+		entries = append(entries, privilegeEntriesMap[typ])
+	}
+
+	//the privileges that the roles have directly. they overlap.
+	//the role r5 has nothing directly.
+	direct := map[int64][]PrivilegeType{
+		1: {PrivilegeTypeCreateUser, PrivilegeTypeDropUser},
+		2: {PrivilegeTypeCreateUser},
+		3: {PrivilegeTypeDropUser, PrivilegeTypeCreateRole},
+		4: {PrivilegeTypeCreateRole},
+		5: {},
+	}
+	makeSql2Result := func(grants [][]interface{}) map[string]ExecResult {
+		sql2result := make(map[string]ExecResult)
+		sql2result[getRolesOfAccountSql] = newMrsForStrings([]string{"role_id", "role_name", "comments"}, [][]interface{}{
+			{1, "r1", ""},
+			{2, "r2", ""},
+			{3, "r3", ""},
+			{4, "r4", ""},
+			{5, "r5", ""},
+		})
+		//the roles are checked together for every privilege
+		for _, typ := range required {
+			var rows [][]interface{}
+			for roleId := int64(1); roleId <= 5; roleId++ {
+				for _, has := range direct[roleId] {
+					if has == typ {
+						rows = append(rows, []interface{}{roleId, typ, true})
+					}
+				}
+			}
+			sql2result[getSqlForCheckRoleHasAccountLevelForStar([]int64{1, 2, 3, 4, 5}, typ)] = newMrsForStrings([]string{"role_id", "privilege_id", "with_grant_option"}, rows)
+		}
+		sql2result[getSqlForGetAllStuffRoleGrantFormat()] = newMrsForStrings([]string{"granted_id", "grantee_id", "with_grant_option"}, grants)
+		return sql2result
+	}
+
+	//no inheritance. the r2 and the r4 are redundant
+	bh := newBh(ctrl, makeSql2Result(nil))
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	roles, err := getMinimalRolesOfPrivileges(context.TODO(), ses, entries)
+	bhStub.Reset()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r1", "r3"}, roles)
+
+	//the r5 inherits the r1 and the r4. it covers all alone
+	bh = newBh(ctrl, makeSql2Result([][]interface{}{
+		{1, 5, false},
+		{4, 5, false},
+	}))
+	bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
+	roles, err = getMinimalRolesOfPrivileges(context.TODO(), ses, entries)
+	bhStub.Reset()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r5"}, roles)
+}
+
+func Test_minimalCoveringRoles(t *testing.T) {
+	covers := map[int64][]bool{
+		1: {true, false, false, false},
+		2: {false, true, false, false},
+		3: {false, false, true, true},
+		4: {true, true, true, false},
+		5: {false, false, false, true},
+	}
+	//the roles 4 and 3 are fewer than the roles 1, 2 and 3
+	assert.Equal(t, []int64{4, 3}, minimalCoveringRoles([]int64{1, 2, 3, 4, 5}, covers, 4))
+
+	//the entry 3 can not be covered
+	assert.Nil(t, minimalCoveringRoles([]int64{1, 2, 4}, covers, 4))
+	assert.Nil(t, minimalCoveringRoles(nil, nil, 0))
+}
+
+func Test_verifyPrivilegeEntryOfRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+	roleIds := []int64{1, 2, 3}
+	entry := privilegeEntriesMap[PrivilegeTypeShowTables]
+	entry.databaseName = "db"
+	pls, err := getPrivilegeLevelsOfObjectType(context.TODO(), entry.objType)
+	assert.NoError(t, err)
+
+	//the role 2 has it on the database db. the role 3 has it on the *.
+	sql2result := make(map[string]ExecResult)
+	matchedRoles := map[privilegeLevelType][]int64{
+		privilegeLevelDatabase: {2},
+		privilegeLevelStar:     {3},
+	}
+	for _, pl := range pls {
+		sql, err := getSqlForPrivilegeOfRoles(context.TODO(), roleIds, entry, pl)
+		assert.NoError(t, err)
+		rows := [][]interface{}{}
+		for _, roleId := range matchedRoles[pl] {
+			rows = append(rows, []interface{}{roleId, entry.privilegeId, false})
+		}
+		sql2result[sql] = newMrsForStrings([]string{"role_id", "privilege_id", "with_grant_option"}, rows)
+	}
+
+	//stop at the first privilege level that some roles have it
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+	grant := &privilegeGrant{}
+	matched, err := verifyPrivilegeEntryInMultiPrivilegeLevels(context.TODO(), bh, ses, nil, roleIds, entry, pls, false, false, grant)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2}, matched.Keys())
+	assert.Equal(t, 1, len(executed))
+	assert.Equal(t, int64(2), grant.roleId)
+	assert.Equal(t, privilegeLevelDatabase, grant.privilegeLevel)
+
+	//all the roles that have it on any privilege level. one sql per level.
+	executed = nil
+	matched, err = verifyPrivilegeEntryInMultiPrivilegeLevels(context.TODO(), bh, ses, nil, roleIds, entry, pls, false, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, matched.Keys())
+	assert.Equal(t, len(pls), len(executed))
+
+	//no role
+	executed = nil
+	matched, err = verifyPrivilegeEntryInMultiPrivilegeLevels(context.TODO(), bh, ses, nil, nil, entry, pls, false, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, matched.Len())
+	assert.Equal(t, 0, len(executed))
+}
+
+func Test_getEffectivePrivilegesJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+
+	sql2result := make(map[string]ExecResult)
+	//role 1 is granted to the role 0
+	sql2result[getSqlForInheritedRoleIdOfRoleId(0)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+		{1, true},
+	})
+	sql2result[getSqlForInheritedRoleIdOfRoleId(1)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+	sql2result[getSqlForRoleNameOfRoleId(0)] = newMrsForStrings([]string{"role_name"}, [][]interface{}{{moAdminRoleName}})
+	sql2result[getSqlForRoleNameOfRoleId(1)] = newMrsForStrings([]string{"role_name"}, [][]interface{}{{"r1"}})
+	names := []string{"obj_type", "privilege_name", "privilege_level", "with_grant_option", "datname", "reldatabase", "relname", "privilege_id"}
+	sql2result[getSqlForNamedPrivilegesOfRole(0)] = newMrsForStrings(names, [][]interface{}{
+		{"account", "create database", "*", int64(0), "", "", "", int64(PrivilegeTypeCreateDatabase)},
+		{"table", "select", "d.t", int64(0), "", "db1", "t1", int64(PrivilegeTypeSelect)},
+	})
+	sql2result[getSqlForNamedPrivilegesOfRole(1)] = newMrsForStrings(names, [][]interface{}{
+		{"table", "select", "d.t", int64(1), "", "db1", "t1", int64(PrivilegeTypeSelect)},
+		{"database", "show tables", "d", int64(0), "db1", "", "", int64(PrivilegeTypeShowTables)},
+	})
+
+	bh := newBh(ctrl, sql2result)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	data, err := getEffectivePrivilegesJSON(context.TODO(), ses)
+	assert.NoError(t, err)
+
+	keysOf := func(m map[string]interface{}) []string {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		return keys
+	}
+
+	//the schema
+	var doc map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &doc))
+	assert.ElementsMatch(t, []string{"account", "user", "roles", "privileges"}, keysOf(doc))
+	privs, ok := doc["privileges"].([]interface{})
+	assert.True(t, ok)
+	for _, p := range privs {
+		assert.ElementsMatch(t,
+			[]string{"object_type", "object", "privilege", "description", "privilege_level", "with_grant_option", "roles"},
+			keysOf(p.(map[string]interface{})))
+	}
+
+	//the content
+	var got effectivePrivileges
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, sysAccountName, got.Account)
+	assert.Equal(t, rootName, got.User)
+	assert.Equal(t, []string{moAdminRoleName, "r1"}, got.Roles)
+	assert.Equal(t, []*effectivePrivilege{
+		{ObjectType: "account", Object: "*", Privilege: "create database", Description: PrivilegeTypeCreateDatabase.Description(), PrivilegeLevel: "*", Roles: []string{moAdminRoleName}},
+		{ObjectType: "database", Object: "db1", Privilege: "show tables", Description: PrivilegeTypeShowTables.Description(), PrivilegeLevel: "d", Roles: []string{"r1"}},
+		//the privilege of both roles is merged with the grant option
+		{ObjectType: "table", Object: "db1.t1", Privilege: "select", Description: PrivilegeTypeSelect.Description(), PrivilegeLevel: "d.t", WithGrantOption: true, Roles: []string{moAdminRoleName, "r1"}},
+	}, got.Privileges)
+}
+
+func Test_getRoleHierarchyOfUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+
+	sql2result := make(map[string]ExecResult)
+	sql2result[getSqlForCheckUserGrant(moAdminRoleID, rootID)] = newMrsForCheckUserGrant([][]interface{}{
+		{moAdminRoleID, rootID, true},
+	})
+	//the role 1 and 2 are granted to the role 0. the role 2 is granted to the role 1 too.
+	sql2result[getSqlForInheritedRoleIdOfRoleId(0)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+		{1, true},
+		{2, false},
+	})
+	sql2result[getSqlForInheritedRoleIdOfRoleId(1)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+		{2, true},
+	})
+	sql2result[getSqlForInheritedRoleIdOfRoleId(2)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+	for i, name := range []string{moAdminRoleName, "r1", "r2"} {
+		sql2result[getSqlForRoleNameOfRoleId(int64(i))] = newMrsForStrings([]string{"role_name"}, [][]interface{}{
+			{name},
+		})
+	}
+
+	bh := newBh(ctrl, sql2result)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	edges, err := getRoleHierarchyOfUser(context.TODO(), ses, "")
+	assert.NoError(t, err)
+	got := make([]string, 0, len(edges))
+	for _, e := range edges {
+		got = append(got, fmt.Sprintf("%s -> %s %v %d", e.granted, e.grantee, e.withGrantOption, e.depth))
+	}
+	//ordered by the depth
+	assert.Equal(t, []string{
+		moAdminRoleName + " -> " + rootName + " true 0",
+		"r1 -> " + moAdminRoleName + " true 1",
+		"r2 -> " + moAdminRoleName + " false 1",
+		"r2 -> r1 true 2",
+	}, got)
+
+	//the user does not exist
+	sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+	sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
+	_, err = getRoleHierarchyOfUser(context.TODO(), ses, "u1")
+	assert.Error(t, err)
+
+	//only the admin can show the one of other users
+	ses.GetTenantInfo().DefaultRoleID = 5
+	ses.GetTenantInfo().DefaultRole = "r5"
+	_, err = getRoleHierarchyOfUser(context.TODO(), ses, "u1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only the moadmin or the accountadmin")
+}
+
+func Test_getUnusedPrivilegesOfUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+
+	sql2result := make(map[string]ExecResult)
+	sql, _ := getSqlForPasswordOfUser(context.TODO(), rootName)
+	sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+		{rootID, "", moAdminRoleID},
+	})
+	//the role 0 and 1 are granted to the user. the role 2 is granted to the role 0.
+	sql2result[getSqlForRoleIdOfUserId(rootID)] = newMrsForRoleIdOfUserId([][]interface{}{
+		{0, true},
+		{1, false},
+	})
+	sql2result[getSqlForInheritedRoleIdOfRoleId(0)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+		{2, false},
+	})
+	sql2result[getSqlForInheritedRoleIdOfRoleId(1)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+	sql2result[getSqlForInheritedRoleIdOfRoleId(2)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+	for i, name := range []string{moAdminRoleName, "r1", "r2"} {
+		sql2result[getSqlForRoleNameOfRoleId(int64(i))] = newMrsForStrings([]string{"role_name"}, [][]interface{}{
+			{name},
+		})
+	}
+	names := []string{"obj_type", "privilege_id", "privilege_level", "datname", "reldatabase", "relname"}
+	sql2result[getSqlForPrivilegesOfRoleWithObject(0)] = newMrsForStrings(names, [][]interface{}{
+		{"account", int64(PrivilegeTypeCreateUser), "*", "", "", ""},
+		{"database", int64(PrivilegeTypeShowTables), "d", "db1", "", ""},
+		{"table", int64(PrivilegeTypeSelect), "d.t", "", "db1", "t1"},
+	})
+	sql2result[getSqlForPrivilegesOfRoleWithObject(1)] = newMrsForStrings(names, [][]interface{}{
+		{"table", int64(PrivilegeTypeInsert), "d.*", "db1", "", ""},
+	})
+	sql2result[getSqlForPrivilegesOfRoleWithObject(2)] = newMrsForStrings(names, [][]interface{}{
+		{"table", int64(PrivilegeTypeSelect), "*.*", "", "", ""},
+	})
+
+	bh := newBh(ctrl, sql2result)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	usages := []*privilegeUsage{
+		{user: rootName, at: from, grant: &privilegeGrant{roleId: 0, privilegeId: PrivilegeTypeSelect,
+			privilegeLevel: privilegeLevelDatabaseTable, objType: objectTypeTable, databaseName: "db1", tableName: "t1"}},
+		{user: rootName, at: from.Add(time.Hour), grant: &privilegeGrant{roleId: 1, privilegeId: PrivilegeTypeInsert,
+			privilegeLevel: privilegeLevelDatabaseStar, objType: objectTypeTable, databaseName: "db1"}},
+		//out of the time window
+		{user: rootName, at: to, grant: &privilegeGrant{roleId: 0, privilegeId: PrivilegeTypeCreateUser,
+			privilegeLevel: privilegeLevelStar, objType: objectTypeAccount}},
+		//other user
+		{user: "u1", at: from, grant: &privilegeGrant{roleId: 2, privilegeId: PrivilegeTypeSelect,
+			privilegeLevel: privilegeLevelStarStar, objType: objectTypeTable}},
+		//the role of the cached grant is unknown
+		{user: rootName, at: from, grant: &privilegeGrant{roleId: 2, privilegeId: PrivilegeTypeSelect,
+			privilegeLevel: privilegeLevelStarStar, objType: objectTypeTable, fromCache: true}},
+		//the denied privilege check
+		{user: rootName, at: from},
+	}
+
+	unused, err := getUnusedPrivilegesOfUser(context.TODO(), ses, "", usages, from, to)
+	assert.NoError(t, err)
+	got := make([]string, 0, len(unused))
+	for _, up := range unused {
+		got = append(got, up.String())
+	}
+	assert.Equal(t, []string{
+		moAdminRoleName + " create user on account *",
+		moAdminRoleName + " show tables on database db1",
+		"r2 select on table *.*",
+	}, got)
+
+	//the user does not exist
+	sql, _ = getSqlForPasswordOfUser(context.TODO(), "u1")
+	sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
+	_, err = getUnusedPrivilegesOfUser(context.TODO(), ses, "u1", usages, from, to)
+	assert.Error(t, err)
+
+	//only the admin can get the one of other users
+	ses.GetTenantInfo().DefaultRoleID = 5
+	ses.GetTenantInfo().DefaultRole = "r5"
+	_, err = getUnusedPrivilegesOfUser(context.TODO(), ses, "u1", usages, from, to)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only the moadmin or the accountadmin")
+}
+
+func Test_getRoleGraphOfAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+	sql2result := make(map[string]ExecResult)
+	sql2result[getRolesOfAccountSql] = newMrsForStrings([]string{"role_id", "role_name", "comments"}, [][]interface{}{
+		{1, "r1", ""},
+		{2, "r2", ""},
+		{3, "r3", ""},
+		{4, "r4", ""},
+	})
+	sql2result[getPrivilegeCountOfRolesSql] = newMrsForStrings([]string{"role_id", "count(*)"}, [][]interface{}{
+		{1, 5},
+		{3, 2},
+		{99, 1},
+	})
+	//the role 99 has been dropped
+	sql2result[getSqlForGetAllStuffRoleGrantFormat()] = newMrsForStrings([]string{"granted_id", "grantee_id", "with_grant_option"}, [][]interface{}{
+		{2, 3, false},
+		{1, 2, true},
+		{1, 4, false},
+		{99, 3, false},
+	})
+	bh := newBh(ctrl, sql2result)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	g, err := getRoleGraphOfAccount(context.TODO(), ses)
+	assert.NoError(t, err)
+	assert.Len(t, g.Nodes, 4)
+	assert.Len(t, g.Edges, 3)
+	counts := make(map[string]int64)
+	for _, node := range g.Nodes {
+		counts[node.RoleName] = node.PrivilegeCount
+	}
+	assert.Equal(t, map[string]int64{"r1": 5, "r2": 0, "r3": 2, "r4": 0}, counts)
+	assert.Equal(t, []*roleGraphEdge{
+		{From: 1, To: 2, WithGrantOption: true},
+		{From: 1, To: 4},
+		{From: 2, To: 3},
+	}, g.Edges)
+
+	//not the admin
+	ses.GetTenantInfo().SetDefaultRole("r1")
+	_, err = getRoleGraphOfAccount(context.TODO(), ses)
+	assert.Error(t, err)
+}
+
+func Test_getEffectiveRolesOfTenantWithoutExpiryTime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	sql2result := map[string]ExecResult{
+		strings.Replace(getSqlForGetRolesOfCurrentUser(3), notExpiredGrantFilter, "", 1): newMrsForRoleIdOfUserId([][]interface{}{
+			{int64(5), false},
+		}),
+		strings.Replace(getSqlForInheritedRoleIdOfRoleId(5), notExpiredGrantFilter, "", 1): newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+			{int64(6), false},
+		}),
+		strings.Replace(getSqlForInheritedRoleIdOfRoleId(6), notExpiredGrantFilter, "", 1): newMrsForInheritedRoleIdOfRoleId([][]interface{}{}),
+	}
+
+	//the account has not been upgraded to have the expiry_time
+	var currentSql string
+	bh := mock_frontend.NewMockBackgroundExec(ctrl)
+	bh.EXPECT().ClearExecResultSet().AnyTimes()
+	bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, sql string) error {
+		currentSql = sql
+		if strings.Contains(sql, "expiry_time") {
+			return moerr.NewInvalidInput(ctx, "column expiry_time does not exist")
+		}
+		return nil
+	}).AnyTimes()
+	bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+		return []interface{}{sql2result[currentSql]}
+	}).AnyTimes()
+
+	tenant := &TenantInfo{
+		Tenant:              "acc1",
+		UserID:              3,
+		useAllSecondaryRole: true,
+	}
+	roles, err := getEffectiveRolesOfTenant(ctx, bh, tenant)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{5, 6}, roles)
+
+	//the other errors are not hidden
+	bh2 := mock_frontend.NewMockBackgroundExec(ctrl)
+	bh2.EXPECT().ClearExecResultSet().AnyTimes()
+	bh2.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(moerr.NewInternalError(ctx, "txn aborted")).AnyTimes()
+	_, err = getEffectiveRolesOfTenant(ctx, bh2, tenant)
+	assert.Error(t, err)
+}
@@ -19,8 +19,12 @@ import (
 	"context"
 	"fmt"
 	"go/constant"
+	"math"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -32,8 +36,11 @@ import (
 	"github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/btree"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -44,9 +51,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/queryservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -76,6 +85,17 @@ func TestGetTenantInfo(t *testing.T) {
 			{"tenant1#    #r1", "{account tenant1#    #r1 -- 0#0#0}", false},
 			{"     # #r1", "{account      # #r1 -- 0#0#0}", false},
 			{"   tenant1   #   u1   #   r1    ", "{account    tenant1   #   u1   #   r1     -- 0#0#0}", false},
+			{"tenant1%3Au1%3Ar1", "{account tenant1:u1:r1 -- 0:0:0}", false},
+			{"tenant1%3au1", "{account tenant1:u1: -- 0:0:0}", false},
+			{"tenant1%23u1%23r1", "{account tenant1#u1#r1 -- 0#0#0}", false},
+			{"tenant1%3Au1:r1", "{account tenant1:u1:r1 -- 0:0:0}", false},
+			{"tenant1%23u1%3Ar1", "{account tenant1#u1:r1: -- 0:0:0}", false},
+			{"tenant1%3Au%2B1%3Ar1?k1=v1", "{account tenant1:u%2B1:r1 -- 0:0:0}", false},
+			{"a%41b", "{account sys:a%41b: -- 0:0:0}", false},
+			{"tenant1%3Aa%41b", "{account tenant1:a%41b: -- 0:0:0}", false},
+			{"tenant1%3Au1%3", "{account tenant1:u1%3: -- 0:0:0}", false},
+			{"%3Au1%3Ar1", "", true},
+			{"u1%", "{account sys:u1%: -- 0:0:0}", false},
 		}
 
 		for _, arg := range args {
@@ -90,6 +110,95 @@ func TestGetTenantInfo(t *testing.T) {
 		}
 	})
 
+	convey.Convey("tenant with the configured delimiters", t, func() {
+		setDelimiters := func(delimiters string) {
+			pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+			pu.SV.UserInputDelimiters = delimiters
+			pu.SV.SetDefaultValues()
+			setGlobalPu(pu)
+		}
+		defer setDelimiters("")
+
+		setDelimiters("|")
+		ti, err := GetTenantInfo(context.TODO(), "tenant1|u:1|r1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.String(), convey.ShouldEqual, "{account tenant1|u:1|r1 -- 0|0|0}")
+		ti, err = GetTenantInfo(context.TODO(), "tenant1%7Cu1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.String(), convey.ShouldEqual, "{account tenant1|u1| -- 0|0|0}")
+		//the escape of the delimiter not configured is kept
+		ti, err = GetTenantInfo(context.TODO(), "tenant1%3Au1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.GetUser(), convey.ShouldEqual, "tenant1%3Au1")
+		ti, err = GetTenantInfo(context.TODO(), "u:1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.GetTenant(), convey.ShouldEqual, sysAccountName)
+		convey.So(ti.GetUser(), convey.ShouldEqual, "u:1")
+		convey.So(nameIsInvalid("u:1"), convey.ShouldBeFalse)
+		convey.So(nameIsInvalid("u|1"), convey.ShouldBeTrue)
+
+		//the precedence follows the configuration
+		setDelimiters("#:")
+		ti, err = GetTenantInfo(context.TODO(), "tenant1#u:1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.String(), convey.ShouldEqual, "{account tenant1#u:1# -- 0#0#0}")
+
+		setDelimiters("")
+		convey.So(nameIsInvalid("u:1"), convey.ShouldBeTrue)
+		convey.So(nameIsInvalid("u#1"), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("tenant with the delimiters passed in", t, func() {
+		ti, err := GetTenantInfoWithDelimiters(context.TODO(), "tenant1|u:1|r1", "|")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.String(), convey.ShouldEqual, "{account tenant1|u:1|r1 -- 0|0|0}")
+
+		//the default ones
+		ti, err = GetTenantInfoWithDelimiters(context.TODO(), "tenant1#u1", "")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.GetTenant(), convey.ShouldEqual, "tenant1")
+		convey.So(ti.GetUser(), convey.ShouldEqual, "u1")
+
+		convey.So(ValidateUserInputDelimiters(":#|"), convey.ShouldBeNil)
+		for _, delimiters := range []string{"?", "%", "a", "'", "::", "\u00b7"} {
+			convey.So(ValidateUserInputDelimiters(delimiters), convey.ShouldNotBeNil)
+		}
+	})
+
+	convey.Convey("check the names with the delimiters", t, func() {
+		var namesOfAccount []string
+		exec := executor.NewMemExecutor(func(sql string) (executor.Result, error) {
+			var memRes *executor.MemResult
+			if strings.Contains(sql, "from mo_catalog.mo_account") {
+				memRes = executor.NewMemResult(
+					[]types.Type{types.T_int32.ToType(), types.New(types.T_varchar, 300, 0)},
+					mpool.MustNewZero())
+				memRes.NewBatch()
+				convey.So(executor.AppendFixedRows(memRes, 0, []int32{0}), convey.ShouldBeNil)
+				convey.So(executor.AppendStringRows(memRes, 1, []string{sysAccountName}), convey.ShouldBeNil)
+			} else {
+				convey.So(sql, convey.ShouldEqual, genSQLForNamesWithDelimiters(":|"))
+				memRes = executor.NewMemResult(
+					[]types.Type{types.New(types.T_varchar, 300, 0)},
+					mpool.MustNewZero())
+				memRes.NewBatch()
+				convey.So(executor.AppendStringRows(memRes, 0, namesOfAccount), convey.ShouldBeNil)
+			}
+			return memRes.GetResult(), nil
+		})
+
+		err := CheckNamesWithUserInputDelimiters(context.TODO(), exec, ":|")
+		convey.So(err, convey.ShouldBeNil)
+
+		//the user u:1 can not login with the delimiter ':'
+		namesOfAccount = []string{"u:1"}
+		err = CheckNamesWithUserInputDelimiters(context.TODO(), exec, ":|")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		err = CheckNamesWithUserInputDelimiters(context.TODO(), exec, "?")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
 	convey.Convey("tenant op", t, func() {
 		ti := &TenantInfo{}
 		convey.So(ti.GetTenant(), convey.ShouldBeEmpty)
@@ -155,6 +264,57 @@ func TestPrivilegeType(t *testing.T) {
 	})
 }
 
+func TestPrivilegeType_Description(t *testing.T) {
+	convey.Convey("every privilege type has the description", t, func() {
+		for i := PrivilegeTypeCreateAccount; i <= PrivilegeTypeUpgradeAccount; i++ {
+			if i == PrivilegeTypeCanGrantRoleToOthersInCreateUser {
+				//it is not a real privilege. both of them panic.
+				convey.So(func() { _ = i.String() }, convey.ShouldPanic)
+				convey.So(func() { _ = i.Description() }, convey.ShouldPanic)
+				continue
+			}
+			convey.So(i.String(), convey.ShouldNotBeEmpty)
+			convey.So(i.Description(), convey.ShouldNotBeEmpty)
+			convey.So(i.Description(), convey.ShouldNotEqual, i.String())
+		}
+
+		//out of the range
+		convey.So(func() { _ = (PrivilegeTypeUpgradeAccount + 1).String() }, convey.ShouldPanic)
+		convey.So(func() { _ = (PrivilegeTypeUpgradeAccount + 1).Description() }, convey.ShouldPanic)
+	})
+}
+
+func Test_validatePredefinedRolePrivileges(t *testing.T) {
+	convey.Convey("the predefined roles are valid", t, func() {
+		convey.So(validatePredefinedRolePrivileges(), convey.ShouldBeNil)
+	})
+
+	convey.Convey("the sys privilege in the accountadmin", t, func() {
+		privs := append([]PrivilegeType{}, entriesOfAccountAdminForMoRolePrivsFor...)
+		stub := gostub.Stub(&entriesOfAccountAdminForMoRolePrivsFor, append(privs, PrivilegeTypeCreateAccount))
+		defer stub.Reset()
+
+		err := validatePredefinedRolePrivileges()
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the privilege create account of the role accountadmin")
+	})
+
+	convey.Convey("the sys privilege in the public", t, func() {
+		stub := gostub.Stub(&entriesOfPublicForMoRolePrivsFor, []PrivilegeType{PrivilegeTypeConnect, PrivilegeTypeDropAccount})
+		defer stub.Reset()
+
+		err := validatePredefinedRolePrivileges()
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the privilege drop account of the role public")
+	})
+
+	convey.Convey("the privilege without the entry", t, func() {
+		err := validateRolePrivilegeScopes(moAdminRoleName, []PrivilegeType{PrivilegeTypeCanGrantRoleToOthersInCreateUser}, PrivilegeScopeSys|accountScopes)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "has no entry")
+	})
+}
+
 func TestFormSql(t *testing.T) {
 	convey.Convey("form sql", t, func() {
 		sql, _ := getSqlForCheckTenant(context.TODO(), "a")
@@ -221,6 +381,113 @@ func Test_checkTenantExistsOrNot(t *testing.T) {
 	})
 }
 
+func Test_InitGeneralTenants(t *testing.T) {
+	convey.Convey("create the accounts in batch", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, "acc1", "open")
+		makeRowsOfCheckTenant(sql2result, "acc2", "open")
+
+		var currentSql string
+		var executed []string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			currentSql = sql
+			executed = append(executed, sql)
+			//the account acc3 exists after it is inserted
+			if strings.HasPrefix(sql, "insert into mo_catalog.mo_account(") && strings.Contains(sql, `"acc3"`) {
+				sql2result[fmt.Sprintf(checkTenantFormat, "acc3")] = newMrsForCheckTenant([][]interface{}{
+					{3, "acc3", "open", 0},
+				})
+			}
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		newStmt := func(name string, ifNotExists bool, password string) *tree.CreateAccount {
+			return &tree.CreateAccount{
+				IfNotExists: ifNotExists,
+				Name:        boxExprStr(name),
+				AuthOption: tree.AccountAuthOption{
+					AdminName: boxExprStr("admin"),
+					IdentifiedType: tree.AccountIdentified{
+						Typ: tree.AccountIdentifiedByPassword,
+						Str: boxExprStr(password),
+					},
+				},
+			}
+		}
+
+		results, err := InitGeneralTenants(ctx, ses, []*tree.CreateAccount{
+			//exists without IF NOT EXISTS
+			newStmt("acc2", false, "111"),
+			//exists with IF NOT EXISTS
+			newStmt(" acc1 ", true, "111"),
+			newStmt("acc3", false, "111"),
+			//the empty password
+			newStmt("acc4", false, ""),
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(results, convey.ShouldHaveLength, 4)
+
+		convey.So(results[0].Name, convey.ShouldEqual, "acc2")
+		convey.So(results[0].Status, convey.ShouldEqual, createAccountFailed)
+		convey.So(results[0].Err, convey.ShouldNotBeNil)
+
+		convey.So(results[1].Name, convey.ShouldEqual, "acc1")
+		convey.So(results[1].Status, convey.ShouldEqual, createAccountSkipped)
+		convey.So(results[1].Err, convey.ShouldBeNil)
+
+		//the failure of the acc2 does not affect the acc3
+		convey.So(results[2].Name, convey.ShouldEqual, "acc3")
+		convey.So(results[2].Status, convey.ShouldEqual, createAccountSucceeded)
+		convey.So(results[2].Err, convey.ShouldBeNil)
+
+		convey.So(results[3].Name, convey.ShouldEqual, "acc4")
+		convey.So(results[3].Status, convey.ShouldEqual, createAccountFailed)
+		convey.So(results[3].Err, convey.ShouldNotBeNil)
+
+		//every account is in its own transaction
+		var begins, commits, rollbacks int
+		for _, sql := range executed {
+			switch sql {
+			case "begin;":
+				begins++
+			case "commit;":
+				commits++
+			case "rollback;":
+				rollbacks++
+			}
+		}
+		convey.So(begins, convey.ShouldEqual, 3)
+		convey.So(commits, convey.ShouldEqual, 2)
+		convey.So(rollbacks, convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("only the moadmin of the sys account can create the accounts", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(&TenantInfo{Tenant: "acc1", User: "u1", DefaultRole: accountAdminRoleName, TenantID: 1, UserID: 2, DefaultRoleID: accountAdminRoleID})
+
+		results, err := InitGeneralTenants(ses.GetTxnHandler().GetTxnCtx(), ses, []*tree.CreateAccount{{Name: boxExprStr("acc2")}})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(results, convey.ShouldBeNil)
+	})
+}
+
 func Test_checkDatabaseExistsOrNot(t *testing.T) {
 	convey.Convey("check databse exists or not", t, func() {
 		ctrl := gomock.NewController(t)
@@ -296,6 +563,137 @@ func Test_createTablesInMoCatalogOfGeneralTenant(t *testing.T) {
 		err = createTablesInInformationSchemaOfGeneralTenant(ctx, bh)
 		convey.So(err, convey.ShouldBeNil)
 	})
+
+	convey.Convey("createTablesInMoCatalog reject the default password of the admin", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		pu.SV.AdminPasswordBlocklist = []string{"111", "password"}
+		pu.SV.RejectAdminPasswordSameAsName = true
+
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		bh.EXPECT().ClearExecResultSet().Return().AnyTimes()
+
+		newTenant := &TenantInfo{
+			Tenant:        "test",
+			User:          "test_root",
+			DefaultRole:   accountAdminRoleName,
+			TenantID:      1,
+			UserID:        GetAdminUserId(),
+			DefaultRoleID: accountAdminRoleID,
+		}
+
+		ca := &createAccount{
+			Name:      "test",
+			AdminName: "test_root",
+			IdentTyp:  tree.AccountIdentifiedByPassword,
+		}
+
+		//in the blocklist
+		ca.IdentStr = "PassWord"
+		err := createTablesInMoCatalogOfGeneralTenant2(bh, ca, ctx, newTenant, pu)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//same as the name of the admin
+		ca.IdentStr = "test_root"
+		err = createTablesInMoCatalogOfGeneralTenant2(bh, ca, ctx, newTenant, pu)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//strong password
+		ca.IdentStr = "S7r0ng&Pa55"
+		err = createTablesInMoCatalogOfGeneralTenant2(bh, ca, ctx, newTenant, pu)
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	convey.Convey("createTablesInMoCatalog with the settings of the account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		setGlobalPu(pu)
+
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		var executed []string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			executed = append(executed, sql)
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().ClearExecResultSet().Return().AnyTimes()
+
+		newTenant := &TenantInfo{
+			Tenant:        "test",
+			User:          "test_root",
+			DefaultRole:   accountAdminRoleName,
+			TenantID:      1,
+			UserID:        GetAdminUserId(),
+			DefaultRoleID: accountAdminRoleID,
+		}
+
+		ca := &createAccount{
+			Name:      "test",
+			AdminName: "test_root",
+			IdentTyp:  tree.AccountIdentifiedByPassword,
+			IdentStr:  "S7r0ng&Pa55",
+			Settings: tree.AccountSettings{
+				{Name: "TIME_ZONE", Value: "+08:00"},
+				{Name: "character_set", Value: "UTF8MB4"},
+			},
+		}
+		err := checkSettingsOfAccount(ctx, ca.Settings)
+		convey.So(err, convey.ShouldBeNil)
+
+		err = createTablesInMoCatalogOfGeneralTenant2(bh, ca, ctx, newTenant, pu)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForInsertSysVarWithAccount(1, "test", "time_zone", "+08:00"))
+		convey.So(executed, convey.ShouldContain, getSqlForInsertSysVarWithAccount(1, "test", "character_set_server", "utf8mb4"))
+	})
+
+	convey.Convey("checkCreateAccount rejects the invalid settings", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := context.TODO()
+
+		newCa := func(settings ...tree.AccountSetting) *createAccount {
+			return &createAccount{
+				Name:      "test",
+				AdminName: "test_root",
+				IdentTyp:  tree.AccountIdentifiedByPassword,
+				IdentStr:  "123",
+				Settings:  settings,
+			}
+		}
+
+		err := checkCreateAccount(ctx, ses, newCa(tree.AccountSetting{Name: "time_zone", Value: "-08:00"}))
+		convey.So(err, convey.ShouldBeNil)
+
+		err = checkCreateAccount(ctx, ses, newCa(tree.AccountSetting{Name: "time_zone", Value: "+15:00"}))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		err = checkCreateAccount(ctx, ses, newCa(tree.AccountSetting{Name: "time_zone", Value: "no/such_zone"}))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		err = checkCreateAccount(ctx, ses, newCa(tree.AccountSetting{Name: "character_set", Value: "no_such_charset"}))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		err = checkCreateAccount(ctx, ses, newCa(tree.AccountSetting{Name: "sql_mode", Value: ""}))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		err = checkCreateAccount(ctx, ses,
+			newCa(tree.AccountSetting{Name: "time_zone", Value: "+08:00"}, tree.AccountSetting{Name: "time_zone", Value: "+09:00"}))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
 
 func Test_initFunction(t *testing.T) {
@@ -430,6 +828,33 @@ func Test_initUser(t *testing.T) {
 		err := InitUser(ctx, ses, tenant, cu)
 		convey.So(err, convey.ShouldBeError)
 	})
+
+	convey.Convey("init user that shadows the special user", t, func() {
+		ctx := context.TODO()
+		SetSpecialUser("special_u1", nil)
+		defer func() {
+			specialUsers.Lock()
+			delete(specialUsers.users, "special_u1")
+			specialUsers.Unlock()
+		}()
+
+		cu := &createUser{
+			Users: []*user{
+				{
+					Username:  " special_u1 ",
+					AuthExist: true,
+					IdentTyp:  tree.AccountIdentifiedByPassword,
+					IdentStr:  "123",
+				},
+			},
+			MiscOpt: &tree.UserMiscOptionAccountUnlock{},
+		}
+
+		ses := &Session{}
+		err := InitUser(ctx, ses, &TenantInfo{}, cu)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the user special_u1 is reserved")
+	})
 }
 
 func Test_initRole(t *testing.T) {
@@ -2559,6 +2984,63 @@ func Test_determineCreateDatabase(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 		convey.So(ok, convey.ShouldBeFalse)
 	})
+	convey.Convey("create database in trusted context succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.CreateDatabase{}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		roleIdsInMoRolePrivs := []int{0}
+		rowsOfMoRolePrivs := make([][][][]interface{}, len(roleIdsInMoRolePrivs))
+		for i := 0; i < len(roleIdsInMoRolePrivs); i++ {
+			rowsOfMoRolePrivs[i] = make([][][]interface{}, len(priv.entries))
+		}
+
+		//role 0 without privilege create database, all, ownership
+		rowsOfMoRolePrivs[0][0] = [][]interface{}{}
+		rowsOfMoRolePrivs[0][1] = [][]interface{}{}
+
+		roleIdsInMoRoleGrant := []int{0}
+		rowsOfMoRoleGrant := make([][][]interface{}, len(roleIdsInMoRoleGrant))
+		rowsOfMoRoleGrant[0] = [][]interface{}{}
+
+		sql2result := makeSql2ExecResult2(0, rowsOfMoUserGrant, roleIdsInMoRolePrivs, priv.entries, rowsOfMoRolePrivs, roleIdsInMoRoleGrant, rowsOfMoRoleGrant, nil, nil)
+
+		bh := newBh(ctrl, sql2result)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		audited := 0
+		auditStub := gostub.Stub(&auditTrustedBypass, func(context.Context, *Session, *privilege) {
+			audited++
+		})
+		defer auditStub.Reset()
+
+		//trusted for the other object type
+		ses.setTrustedContext(objectTypeTable)
+		ok, err := authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ses.GetTxnHandler().GetTxnCtx(), ses, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+		convey.So(audited, convey.ShouldEqual, 0)
+
+		ses.setTrustedContext(objectTypeAccount)
+		ok, err = authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ses.GetTxnHandler().GetTxnCtx(), ses, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(audited, convey.ShouldEqual, 1)
+
+		ses.clearTrustedContext()
+		ok, err = authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ses.GetTxnHandler().GetTxnCtx(), ses, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+		convey.So(audited, convey.ShouldEqual, 1)
+	})
 }
 
 func Test_determineDropDatabase(t *testing.T) {
@@ -3489,7 +3971,7 @@ func Test_determineDML(t *testing.T) {
 
 			for _, roleId := range roleIds {
 				for _, entry := range priv.entries {
-					sql, _ := getSqlForCheckRoleHasTableLevelPrivilege(context.TODO(), int64(roleId), entry.privilegeId, entry.databaseName, entry.tableName)
+					sql, _ := getSqlForCheckRoleHasTableLevelPrivilege(context.TODO(), []int64{int64(roleId)}, entry.privilegeId, entry.databaseName, entry.tableName)
 					sql2result[sql] = newMrsForWithGrantOptionPrivilege([][]interface{}{
 						{entry.privilegeId, true},
 					})
@@ -3732,44 +4214,478 @@ func Test_determineDML(t *testing.T) {
 	})
 }
 
-func Test_doGrantRole(t *testing.T) {
-	convey.Convey("grant role to role succ", t, func() {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.GrantRole{
-			Roles: []*tree.Role{
-				{UserName: "r1"},
-				{UserName: "r2"},
-				{UserName: "r3"},
-			},
-			Users: []*tree.User{
-				{Username: "r4"},
-				{Username: "r5"},
-				{Username: "r6"},
+func Test_ownershipImpliesDML(t *testing.T) {
+	p := &plan2.Plan{
+		Plan: &plan2.Plan_Query{
+			Query: &plan2.Query{
+				Nodes: []*plan2.Node{
+					{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "t", ObjName: "a"}},
+				},
 			},
-		}
+		},
+	}
+
+	//the role 0 owns the table t.a but is not granted the select on it
+	check := func(ctrl *gomock.Controller, implied int64) (bool, error) {
+		stmt := &tree.Select{}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set(OwnershipImpliesDML, implied)
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		sql2result := makeSql2ExecResult2(0, rowsOfMoUserGrant, nil, nil, nil, nil, nil, nil, nil)
 
-		//init from roles
-		for i, role := range stmt.Roles {
-			sql, _ := getSqlForRoleIdOfRole(context.TODO(), role.UserName)
-			mrs := newMrsForRoleIdOfRole([][]interface{}{
-				{i},
-			})
-			bh.sql2result[sql] = mrs
+		arr := extractPrivilegeTipsFromPlan(p)
+		convertPrivilegeTipsToPrivilege(priv, arr)
+
+		rowsOfEntry := func(privId PrivilegeType) [][]interface{} {
+			if privId == PrivilegeTypeTableOwnership {
+				return [][]interface{}{
+					{privId, true},
+				}
+			}
+			return [][]interface{}{}
+		}
+
+		makeSql := func(entry privilegeEntry) {
+			sql, err := getSqlForCheckRoleHasTableLevelPrivilege(context.TODO(), []int64{0}, entry.privilegeId, entry.databaseName, entry.tableName)
+			convey.So(err, convey.ShouldBeNil)
+			sql2result[sql] = newMrsForWithGrantOptionPrivilege(rowsOfEntry(entry.privilegeId))
+
+			pls, err := getPrivilegeLevelsOfObjectType(context.TODO(), entry.objType)
+			convey.So(err, convey.ShouldBeNil)
+			for _, pl := range pls {
+				sql, err = getSqlForPrivilege(context.TODO(), 0, entry, pl)
+				convey.So(err, convey.ShouldBeNil)
+				sql2result[sql] = newMrsForWithGrantOptionPrivilege(rowsOfEntry(entry.privilegeId))
+			}
+		}
+
+		for _, entry := range priv.entries {
+			sql, _ := getSqlFromPrivilegeEntry(context.TODO(), 0, entry)
+			sql2result[sql] = newMrsForWithGrantOptionPrivilege(rowsOfEntry(entry.privilegeId))
+			if entry.privilegeEntryTyp == privilegeEntryTypeGeneral {
+				makeSql(entry)
+			} else if entry.privilegeEntryTyp == privilegeEntryTypeCompound {
+				for _, mi := range entry.compound.items {
+					tempEntry := privilegeEntriesMap[mi.privilegeTyp]
+					tempEntry.databaseName = mi.dbName
+					tempEntry.tableName = mi.tableName
+					tempEntry.privilegeEntryTyp = privilegeEntryTypeGeneral
+					tempEntry.compound = nil
+					makeSql(tempEntry)
+				}
+			}
+		}
+
+		sql := getSqlForInheritedRoleIdOfRoleId(0)
+		sql2result[sql] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		return authenticateUserCanExecuteStatementWithObjectTypeDatabaseAndTable(ses.GetTxnHandler().GetTxnCtx(), ses, stmt, p)
+	}
+
+	convey.Convey("the owner selects the table with the policy on", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ok, err := check(ctrl, 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("the owner selects the table with the policy off", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ok, err := check(ctrl, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func Test_decideGrantOption(t *testing.T) {
+	convey.Convey("decide the grant option with the policy of the account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.gSysVars = ses.gSysVars.Clone()
+		ctx := context.TODO()
+
+		type arg struct {
+			admin         bool
+			defaultOption int64
+			forNonAdmin   int64
+			grantOption   bool
+			want          bool
+			wantErr       bool
+		}
+
+		args := []arg{
+			{admin: true, defaultOption: 0, forNonAdmin: 0, grantOption: false, want: false},
+			{admin: true, defaultOption: 0, forNonAdmin: 0, grantOption: true, want: true},
+			{admin: true, defaultOption: 1, forNonAdmin: 0, grantOption: false, want: true},
+			{admin: false, defaultOption: 0, forNonAdmin: 1, grantOption: true, want: true},
+			{admin: false, defaultOption: 1, forNonAdmin: 1, grantOption: false, want: true},
+			{admin: false, defaultOption: 1, forNonAdmin: 0, grantOption: false, want: false},
+			{admin: false, defaultOption: 0, forNonAdmin: 0, grantOption: true, wantErr: true},
+		}
+
+		for _, a := range args {
+			if a.admin {
+				ses.GetTenantInfo().SetDefaultRole(moAdminRoleName)
+			} else {
+				ses.GetTenantInfo().SetDefaultRole("r1")
+			}
+			ses.gSysVars.Set(GrantOptionDefault, a.defaultOption)
+			ses.gSysVars.Set(GrantOptionForNonAdmin, a.forNonAdmin)
+
+			got, err := decideGrantOption(ctx, ses, a.grantOption)
+			if a.wantErr {
+				convey.So(err, convey.ShouldNotBeNil)
+			} else {
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(got, convey.ShouldEqual, a.want)
+			}
+		}
+	})
+}
+
+func Test_checkAuthTypeAllowed(t *testing.T) {
+	convey.Convey("check the auth type with the policy of the account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.gSysVars = ses.gSysVars.Clone()
+		ctx := context.TODO()
+
+		//the default permits the password
+		convey.So(checkAuthTypeAllowed(ctx, ses, tree.AccountIdentifiedByPassword), convey.ShouldBeNil)
+
+		type arg struct {
+			allowed string
+			typ     tree.AccountIdentifiedOption
+			want    bool
+		}
+
+		args := []arg{
+			{allowed: "password", typ: tree.AccountIdentifiedByPassword, want: true},
+			{allowed: "ssl, PASSWORD", typ: tree.AccountIdentifiedByPassword, want: true},
+			{allowed: "password", typ: tree.AccountIdentifiedWithSSL, want: false},
+			{allowed: "ssl", typ: tree.AccountIdentifiedByPassword, want: false},
+			{allowed: "ssl,random_password", typ: tree.AccountIdentifiedByRandomPassword, want: true},
+			{allowed: "", typ: tree.AccountIdentifiedByPassword, want: false},
+		}
+
+		for _, a := range args {
+			ses.gSysVars.Set(AllowedAuthTypes, a.allowed)
+			err := checkAuthTypeAllowed(ctx, ses, a.typ)
+			if a.want {
+				convey.So(err, convey.ShouldBeNil)
+			} else {
+				convey.So(err, convey.ShouldNotBeNil)
+			}
+		}
+	})
+
+	convey.Convey("create or alter user with the password forbidden", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set(AllowedAuthTypes, "ssl")
+		ctx := context.TODO()
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(ctx, "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
+		sql, _ = getSqlForRoleIdOfRole(ctx, "u1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		cu := &createUser{
+			Users: []*user{
+				{
+					Username:  "u1",
+					AuthExist: true,
+					IdentTyp:  tree.AccountIdentifiedByPassword,
+					IdentStr:  "123",
+				},
+			},
+		}
+		err := InitUser(ctx, ses, ses.GetTenantInfo(), cu)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the auth type password is not allowed")
+
+		au := &alterUser{
+			Users: []*user{
+				{
+					Username:  "u1",
+					Hostname:  "%",
+					AuthExist: true,
+					IdentTyp:  tree.AccountIdentifiedByPassword,
+					IdentStr:  "123",
+				},
+			},
+		}
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the auth type password is not allowed")
+	})
+}
+
+func Test_recordPrivilegeCheck(t *testing.T) {
+	convey.Convey("sample the audit of the privilege check", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.gSysVars = ses.gSysVars.Clone()
+		ctx := context.TODO()
+		stmt := &tree.Select{}
+
+		var allowed, denied int
+		auditStub := gostub.Stub(&auditPrivilegeCheck, func(ctx context.Context, ses *Session, stmt tree.Statement, ok bool, grant *privilegeGrant) {
+			if ok {
+				allowed++
+			} else {
+				denied++
+			}
+		})
+		defer auditStub.Reset()
+
+		const total = 10000
+		record := func(rate float64) {
+			allowed, denied = 0, 0
+			ses.gSysVars.Set(PrivilegeAuditSampleRate, rate)
+			for i := 0; i < total; i++ {
+				recordPrivilegeCheck(ctx, ses, stmt, true)
+				recordPrivilegeCheck(ctx, ses, stmt, false)
+			}
+		}
+
+		//the denials are always recorded
+		record(0)
+		convey.So(allowed, convey.ShouldEqual, 0)
+		convey.So(denied, convey.ShouldEqual, total)
+
+		record(1)
+		convey.So(allowed, convey.ShouldEqual, total)
+		convey.So(denied, convey.ShouldEqual, total)
+
+		//the allows are sampled at the rate approximately
+		record(0.2)
+		convey.So(allowed, convey.ShouldBeBetween, total*15/100, total*25/100)
+		convey.So(denied, convey.ShouldEqual, total)
+
+		//the allows are sampled by the random number
+		randStub := gostub.Stub(&privilegeAuditRandom, func() float64 { return 0.5 })
+		defer randStub.Reset()
+		record(0.4)
+		convey.So(allowed, convey.ShouldEqual, 0)
+		record(0.6)
+		convey.So(allowed, convey.ShouldEqual, total)
+	})
+}
+
+func Test_determineUserHasPrivilegeSetWithGrant(t *testing.T) {
+	convey.Convey("the grant authorizes the statement directly", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.CreateAccount{}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		roleIdsInMoRolePrivs := []int{0}
+		rowsOfMoRolePrivs := [][]interface{}{
+			{0, true},
+		}
+
+		sql2result := makeSql2ExecResult(0, rowsOfMoUserGrant,
+			roleIdsInMoRolePrivs, priv.entries, rowsOfMoRolePrivs,
+			nil, nil)
+
+		bh := newBh(ctrl, sql2result)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		grant := &privilegeGrant{}
+		ok, err := determineUserHasPrivilegeSet(ses.GetTxnHandler().GetTxnCtx(), ses, priv, grant)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(grant.roleId, convey.ShouldEqual, moAdminRoleID)
+		convey.So(grant.inherited, convey.ShouldBeFalse)
+		convey.So(grant.fromCache, convey.ShouldBeFalse)
+		convey.So(grant.privilegeId, convey.ShouldEqual, PrivilegeTypeCreateAccount)
+		convey.So(grant.privilegeLevel, convey.ShouldEqual, privilegeLevelStar)
+		convey.So(grant.objType, convey.ShouldEqual, objectTypeAccount)
+	})
+
+	convey.Convey("the grant authorizes the statement by the inherited role", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.CreateAccount{}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		//the role 0 does not have the privilege. the role 1 has it.
+		roleIdsInMoRolePrivs := []int{0, 1}
+		rowsOfMoRolePrivs := [][][][]interface{}{
+			{{}},
+			{{{0, true}}},
+		}
+		//the role 0 inherits the role 1
+		roleIdsInMoRoleGrant := []int{0, 1}
+		rowsOfMoRoleGrant := [][][]interface{}{
+			{{1, true}},
+			{},
+		}
+
+		sql2result := makeSql2ExecResult2(0, rowsOfMoUserGrant,
+			roleIdsInMoRolePrivs, priv.entries, rowsOfMoRolePrivs,
+			roleIdsInMoRoleGrant, rowsOfMoRoleGrant, nil, nil)
+
+		bh := newBh(ctrl, sql2result)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ok, err := authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		grant := ses.GetPrivilege().grant
+		convey.So(grant, convey.ShouldNotBeNil)
+		convey.So(grant.roleId, convey.ShouldEqual, 1)
+		convey.So(grant.inherited, convey.ShouldBeTrue)
+		convey.So(grant.privilegeId, convey.ShouldEqual, PrivilegeTypeCreateAccount)
+
+		//the audit event carries the grant
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set(PrivilegeAuditSampleRate, float64(1))
+		var audited *privilegeGrant
+		auditStub := gostub.Stub(&auditPrivilegeCheck, func(ctx context.Context, ses *Session, stmt tree.Statement, ok bool, grant *privilegeGrant) {
+			audited = grant
+		})
+		defer auditStub.Reset()
+		recordPrivilegeCheck(ses.GetTxnHandler().GetTxnCtx(), ses, stmt, true)
+		convey.So(audited, convey.ShouldEqual, grant)
+		convey.So(audited.String(), convey.ShouldStartWith, "role 1 (inherited) privilege ")
+	})
+}
+
+func Test_explainCompoundPrivilege(t *testing.T) {
+	convey.Convey("explain the join with the unauthorized table", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.Select{}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		convertPrivilegeTipsToPrivilege(priv, privilegeTipsArray{
+			{typ: PrivilegeTypeSelect, databaseName: "db", tableName: "t1"},
+			{typ: PrivilegeTypeSelect, databaseName: "db", tableName: "t2"},
+		})
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		//the role of the session only has the select on the db.t1
+		entry := privilegeEntriesMap[PrivilegeTypeSelect]
+		entry.databaseName = "db"
+		entry.tableName = "t1"
+		sql, err := getSqlForPrivilege(ctx, moAdminRoleID, entry, privilegeLevelDatabaseTable)
+		convey.So(err, convey.ShouldBeNil)
+		sql2result := map[string]ExecResult{
+			sql: newMrsForCheckRoleHasPrivilege([][]interface{}{
+				{moAdminRoleID, false},
+			}),
+		}
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ok, err := determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		checks, err := explainCompoundPrivilege(ctx, ses, priv)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(checks), convey.ShouldEqual, 2)
+		convey.So(checks[0].tableName, convey.ShouldEqual, "t1")
+		convey.So(checks[0].allowed, convey.ShouldBeTrue)
+		convey.So(checks[0].roleId, convey.ShouldEqual, moAdminRoleID)
+		convey.So(checks[0].grantedTyp, convey.ShouldEqual, PrivilegeTypeSelect)
+		convey.So(checks[1].tableName, convey.ShouldEqual, "t2")
+		convey.So(checks[1].allowed, convey.ShouldBeFalse)
+		convey.So(checks[1].String(), convey.ShouldEqual, "select on db.t2 denied (read the rows of the table)")
+
+		//it is off by default
+		detail, err := explainPrivilegeDenial(ctx, ses, stmt, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(detail, convey.ShouldBeEmpty)
+	})
+}
+
+func Test_doGrantRole(t *testing.T) {
+	convey.Convey("grant role to role succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.GrantRole{
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+				{UserName: "r2"},
+				{UserName: "r3"},
+			},
+			Users: []*tree.User{
+				{Username: "r4"},
+				{Username: "r5"},
+				{Username: "r6"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
+
+		//init from roles
+		for i, role := range stmt.Roles {
+			sql, _ := getSqlForRoleIdOfRole(context.TODO(), role.UserName)
+			mrs := newMrsForRoleIdOfRole([][]interface{}{
+				{i},
+			})
+			bh.sql2result[sql] = mrs
 		}
 
 		//init to roles
@@ -3831,6 +4747,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -3911,6 +4828,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4017,6 +4935,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4127,6 +5046,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4237,6 +5157,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4307,6 +5228,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4389,8 +5311,20 @@ func Test_doGrantRole(t *testing.T) {
 			}
 		}
 
-		err := doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		//the names of the roles on the cycle
+		for i, name := range []string{"r1", "r2", "r3", "r4", "r5"} {
+			bh.sql2result[getSqlForRoleNameOfRoleId(int64(i))] = newMrsForStrings([]string{"role_name"}, [][]interface{}{
+				{name},
+			})
+		}
+
+		//the cache of the session missed the grants by the other sessions.
+		//the loop is still found with the mo_role_grant.
+		ses.GetRoleGrantCache().setAll(sysAccountID, []roleGrantEdge{})
+
+		err := doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
 		convey.So(err, convey.ShouldBeError)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "r1 -> r4 -> r3 -> r2 -> r1")
 	})
 
 	convey.Convey("grant role to role fail no role", t, func() {
@@ -4422,6 +5356,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for _, role := range stmt.Roles {
@@ -4489,6 +5424,7 @@ func Test_doGrantRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4534,6 +5470,240 @@ func Test_doGrantRole(t *testing.T) {
 		err := doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
 		convey.So(err, convey.ShouldBeError)
 	})
+
+	convey.Convey("grant role on suspended account fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.GrantRole{
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+			},
+			Users: []*tree.User{
+				{Username: "r2"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusSuspend.String())
+
+		err := doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeError)
+	})
+
+	convey.Convey("grant role to user with expiry succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.GrantRole{
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+			},
+			Users: []*tree.User{
+				{Username: "u2"},
+			},
+			Expiry: "2099-01-01",
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{1},
+		})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "u2")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), "u2")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{2, "111", 2},
+		})
+		sql, _ = getSqlForRoleOfUser(context.TODO(), 2, moAdminRoleName)
+		sql2result[sql] = newMrsForRoleOfUser([][]interface{}{})
+		sql2result[getSqlForCheckUserGrant(1, 2)] = newMrsForCheckUserGrant([][]interface{}{})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		hasExpiry := func(prefix, expiry string) bool {
+			for _, sql := range executed {
+				if strings.HasPrefix(sql, prefix) && strings.Contains(sql, expiry) {
+					return true
+				}
+			}
+			return false
+		}
+
+		//insert the grant with the expiry
+		err := doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hasExpiry("insert into mo_catalog.mo_user_grant", `"2099-01-01 00:00:00");`), convey.ShouldBeTrue)
+
+		//the permanent grant with the same grant option gets the expiry
+		sql2result[getSqlForCheckUserGrant(1, 2)] = newMrsForCheckUserGrant([][]interface{}{
+			{1, 2, false, false},
+		})
+		executed = executed[:0]
+		err = doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hasExpiry("update mo_catalog.mo_user_grant", `expiry_time = "2099-01-01 00:00:00"`), convey.ShouldBeTrue)
+
+		//the grant without the expiry makes the expiring grant permanent
+		sql2result[getSqlForCheckUserGrant(1, 2)] = newMrsForCheckUserGrant([][]interface{}{
+			{1, 2, false, true},
+		})
+		stmt.Expiry = ""
+		executed = executed[:0]
+		err = doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hasExpiry("update mo_catalog.mo_user_grant", "expiry_time = NULL"), convey.ShouldBeTrue)
+
+		//the expiry has passed
+		stmt.Expiry = "2020-01-01"
+		err = doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "has passed")
+
+		//the expiry is not a timestamp
+		stmt.Expiry = "tomorrow"
+		err = doGrantRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_doGrantContinueOnError(t *testing.T) {
+	initBh := func(bh *backgroundExecTest) {
+		bh.init()
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
+
+		//the role r1 exists. the role r9 does not exist.
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{1}})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "r9")
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+	}
+	statusOf := func(statuses []grantPairStatus) [][]string {
+		ret := make([][]string, 0, len(statuses))
+		for _, status := range statuses {
+			msg := ""
+			if status.err != nil {
+				msg = status.err.Error()
+			}
+			ret = append(ret, []string{status.granted, status.grantee, msg})
+		}
+		return ret
+	}
+
+	convey.Convey("grant role continue on error", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		initBh(bh)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.GrantRole{
+			Roles: []*tree.Role{
+				{UserName: "R1"},
+				{UserName: "r9"},
+			},
+			Users: []*tree.User{
+				{Username: "u4"},
+			},
+			ContinueOnError: true,
+		}
+		ses := newSes(determinePrivilegeSetOfStatement(stmt), ctrl)
+
+		//the user u4 exists
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "u4")
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), "u4")
+		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{{4, "111", 4}})
+		sql, _ = getSqlForRoleOfUser(context.TODO(), 4, moAdminRoleName)
+		bh.sql2result[sql] = newMrsForRoleOfUser([][]interface{}{})
+		bh.sql2result[getSqlForCheckUserGrant(1, 4)] = newMrsForCheckUserGrant([][]interface{}{})
+
+		statuses := doGrantRoleContinueOnError(context.TODO(), ses, stmt)
+		convey.So(statusOf(statuses), convey.ShouldResemble, [][]string{
+			{"r1", "u4", ""},
+			{"r9", "u4", "internal error: there is no role r9"},
+		})
+		//the statement is not changed
+		convey.So(stmt.Roles[0].UserName, convey.ShouldEqual, "R1")
+
+		ses.SetMysqlResultSet(&MysqlResultSet{})
+		respondGrantPairStatuses(ses, statuses)
+		mrs := ses.GetMysqlResultSet()
+		convey.So(mrs.GetColumnCount(), convey.ShouldEqual, 4)
+		convey.So(mrs.GetRowCount(), convey.ShouldEqual, 2)
+		status, err := mrs.GetString(context.TODO(), 0, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(status, convey.ShouldEqual, "OK")
+		status, err = mrs.GetString(context.TODO(), 1, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(status, convey.ShouldEqual, "FAILED")
+	})
+
+	convey.Convey("grant privilege continue on error", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		initBh(bh)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES},
+			},
+			ObjType: tree.OBJECT_TYPE_ACCOUNT,
+			Level:   &tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_STAR},
+			Roles: []*tree.Role{
+				{UserName: "r9"},
+				{UserName: "r1"},
+			},
+			ContinueOnError: true,
+		}
+		ses := newSes(determinePrivilegeSetOfStatement(stmt), ctrl)
+
+		privType, err := convertAstPrivilegeTypeToPrivilegeType(context.TODO(), tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE, tree.OBJECT_TYPE_ACCOUNT)
+		convey.So(err, convey.ShouldBeNil)
+		sql := getSqlForCheckRoleHasPrivilege(1, objectTypeAccount, objectIDAll, int64(privType))
+		bh.sql2result[sql] = newMrsForCheckRoleHasPrivilege([][]interface{}{})
+
+		//the show tables is not the privilege on the account
+		statuses := doGrantPrivilegeContinueOnError(context.TODO(), ses, stmt)
+		convey.So(len(statuses), convey.ShouldEqual, 4)
+		convey.So(statusOf(statuses)[0], convey.ShouldResemble, []string{"create database", "r9", "internal error: there is no role r9"})
+		convey.So(statusOf(statuses)[1], convey.ShouldResemble, []string{"create database", "r1", ""})
+		for _, status := range statuses[2:] {
+			convey.So(status.granted, convey.ShouldEqual, "show tables")
+			convey.So(status.err, convey.ShouldNotBeNil)
+		}
+	})
 }
 
 func Test_doRevokeRole(t *testing.T) {
@@ -4566,6 +5736,7 @@ func Test_doRevokeRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4599,6 +5770,56 @@ func Test_doRevokeRole(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 
+	convey.Convey("revoke the default role from user succ (reset the default role)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.RevokeRole{
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+			},
+			Users: []*tree.User{
+				{Username: "u1"},
+				{Username: "r2"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{3},
+		})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "u1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{4, "111", 3},
+		})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "r2")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{5},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		err := doRevokeRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+
+		//the default role of the user u1 is reset to the public
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteUserGrant(3, 4))
+		convey.So(executed, convey.ShouldContain, getSqlForResetDefaultRoleOfUser(3, 4))
+		//the role r2 does not have the default role
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteRoleGrant(3, 5))
+		convey.So(executed, convey.ShouldNotContain, getSqlForResetDefaultRoleOfUser(3, 5))
+	})
+
 	convey.Convey("revoke role from role succ (if exists = true, miss role before FROM)", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -4629,6 +5850,7 @@ func Test_doRevokeRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		var mrs *MysqlResultSet
@@ -4692,6 +5914,7 @@ func Test_doRevokeRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		var mrs *MysqlResultSet
@@ -4760,6 +5983,7 @@ func Test_doRevokeRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		var mrs *MysqlResultSet
@@ -4832,6 +6056,7 @@ func Test_doRevokeRole(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4899,6 +6124,7 @@ func Test_doGrantPrivilege(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 		//init from roles
 		for i, role := range stmt.Roles {
@@ -4993,6 +6219,7 @@ func Test_doGrantPrivilege(t *testing.T) {
 			bh.sql2result["begin;"] = nil
 			bh.sql2result["commit;"] = nil
 			bh.sql2result["rollback;"] = nil
+			makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 			//init from roles
 			for i, role := range stmt.Roles {
@@ -5009,13 +6236,13 @@ func Test_doGrantPrivilege(t *testing.T) {
 			if stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_DATABASE {
 				sql, _ := getSqlForCheckDatabase(context.TODO(), stmt.Level.DbName)
 				mrs := newMrsForCheckDatabase([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			} else if stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_TABLE {
 				sql, _ := getSqlForCheckDatabase(context.TODO(), stmt.Level.TabName)
 				mrs := newMrsForCheckDatabase([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			}
@@ -5125,6 +6352,7 @@ func Test_doGrantPrivilege(t *testing.T) {
 			bh.sql2result["begin;"] = nil
 			bh.sql2result["commit;"] = nil
 			bh.sql2result["rollback;"] = nil
+			makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 			//init from roles
 			for i, role := range stmt.Roles {
@@ -5142,14 +6370,14 @@ func Test_doGrantPrivilege(t *testing.T) {
 				stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR {
 				sql, _ := getSqlForCheckDatabase(context.TODO(), dbName)
 				mrs := newMrsForCheckDatabase([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			} else if stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_TABLE ||
 				stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE {
 				sql, _ := getSqlForCheckDatabaseTable(context.TODO(), dbName, tableName)
 				mrs := newMrsForCheckDatabaseTable([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			}
@@ -5171,10 +6399,7 @@ func Test_doGrantPrivilege(t *testing.T) {
 			convey.So(err, convey.ShouldBeNil)
 		}
 	})
-}
-
-func Test_doRevokePrivilege(t *testing.T) {
-	convey.Convey("revoke account, role succ", t, func() {
+	convey.Convey("grant on suspended account fail, after resume succ", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -5184,7 +6409,7 @@ func Test_doRevokePrivilege(t *testing.T) {
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
-		stmt := &tree.RevokePrivilege{
+		stmt := &tree.GrantPrivilege{
 			Privileges: []*tree.Privilege{
 				{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE},
 			},
@@ -5201,68 +6426,307 @@ func Test_doRevokePrivilege(t *testing.T) {
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusSuspend.String())
 
-		//init from roles
-		for i, role := range stmt.Roles {
-			sql, _ := getSqlForRoleIdOfRole(context.TODO(), role.UserName)
-			mrs := newMrsForRoleIdOfRole([][]interface{}{
-				{i},
-			})
-			bh.sql2result[sql] = mrs
-		}
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{0},
+		})
 
-		for _, p := range stmt.Privileges {
-			privType, err := convertAstPrivilegeTypeToPrivilegeType(context.TODO(), p.Type, tree.OBJECT_TYPE_ACCOUNT)
-			convey.So(err, convey.ShouldBeNil)
-			for j := range stmt.Roles {
-				sql := getSqlForCheckRoleHasPrivilege(int64(j), objectTypeAccount, objectIDAll, int64(privType))
-				mrs := newMrsForCheckRoleHasPrivilege([][]interface{}{})
-				bh.sql2result[sql] = mrs
-			}
-		}
+		privType, err := convertAstPrivilegeTypeToPrivilegeType(context.TODO(), stmt.Privileges[0].Type, tree.OBJECT_TYPE_ACCOUNT)
+		convey.So(err, convey.ShouldBeNil)
+		sql = getSqlForCheckRoleHasPrivilege(0, objectTypeAccount, objectIDAll, int64(privType))
+		bh.sql2result[sql] = newMrsForCheckRoleHasPrivilege([][]interface{}{})
 
-		err := doRevokePrivilege(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		err = doGrantPrivilege(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//resume the account
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
+
+		err = doGrantPrivilege(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
 		convey.So(err, convey.ShouldBeNil)
 	})
-	convey.Convey("revoke database, role succ", t, func() {
+	convey.Convey("grant ownership on database, transfer the owner succ", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
+		stmt := &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP},
+			},
+			ObjType: tree.OBJECT_TYPE_DATABASE,
+			Level: &tree.PrivilegeLevel{
+				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
+				DbName: "d",
+			},
+			Roles: []*tree.Role{
+				{UserName: "r2"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r2")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{2},
+		})
+		sql, _ = getSqlForCheckDatabase(context.TODO(), "d")
+		sql2result[sql] = newMrsForCheckDatabase([][]interface{}{
+			{10, 0},
+		})
+		sql = getSqlForCheckRoleHasPrivilege(2, objectTypeDatabase, 10, int64(PrivilegeTypeDatabaseOwnership))
+		sql2result[sql] = newMrsForCheckRoleHasPrivilege([][]interface{}{})
+		//the previous owner is the creator role 1
+		sql2result[getSqlForGetGrantedOwnerOfDatabase("d")] = newMrsForCount([][]interface{}{})
+		sql2result[getSqlForGetOwnerOfDatabase("d")] = newMrsForCount([][]interface{}{
+			{1},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
 
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
-		stmts := []*tree.RevokePrivilege{
-			{
-				Privileges: []*tree.Privilege{
-					{Type: tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES},
-				},
-				ObjType: tree.OBJECT_TYPE_DATABASE,
-				Level: &tree.PrivilegeLevel{
-					Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
-				},
-				Roles: []*tree.Role{
-					{UserName: "r1"},
-				},
+		ses.GetPrivilegeCache().add(objectTypeDatabase, privilegeLevelDatabase, "d", "", PrivilegeTypeDatabaseOwnership)
+
+		err := doGrantPrivilege(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+
+		deleteSql := getSqlForDeleteOwnershipOfRole(objectTypeDatabase, 10, PrivilegeTypeDatabaseOwnership, 1)
+		convey.So(executed, convey.ShouldContain, deleteSql)
+		for _, sqlx := range executed {
+			//the mo_database is not updated by the sql
+			convey.So(sqlx, convey.ShouldNotContainSubstring, "update mo_catalog.mo_database")
+			//the ownership of the other roles is kept
+			convey.So(sqlx, convey.ShouldNotContainSubstring, "role_id !=")
+		}
+		//the cached decisions are invalid
+		convey.So(ses.GetPrivilegeCache().has(objectTypeDatabase, privilegeLevelDatabase, "d", "", PrivilegeTypeDatabaseOwnership), convey.ShouldBeFalse)
+
+		//the owner is the role 2 granted latest now
+		sql2result[getSqlForGetGrantedOwnerOfDatabase("d")] = newMrsForCount([][]interface{}{
+			{2},
+		})
+		ses.GetTenantInfo().SetDefaultRoleID(1)
+		ok, err := checkRoleWhetherDatabaseOwner(ses.GetTxnHandler().GetTxnCtx(), ses, "d", false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		ses.GetTenantInfo().SetDefaultRoleID(2)
+		ok, err = checkRoleWhetherDatabaseOwner(ses.GetTxnHandler().GetTxnCtx(), ses, "d", false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		//grant the ownership to the owner again does nothing
+		executed = nil
+		err = doGrantPrivilege(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldNotContain, deleteSql)
+	})
+
+	convey.Convey("grant on the object of the other account fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT},
 			},
-			{
-				Privileges: []*tree.Privilege{
-					{Type: tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES},
-				},
-				ObjType: tree.OBJECT_TYPE_DATABASE,
-				Level: &tree.PrivilegeLevel{
-					Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
-				},
-				Roles: []*tree.Role{
-					{UserName: "r1"},
-				},
+			ObjType: tree.OBJECT_TYPE_TABLE,
+			Level: &tree.PrivilegeLevel{
+				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
+				DbName:  "d",
+				TabName: "t",
 			},
-			{
-				Privileges: []*tree.Privilege{
-					{Type: tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES},
-				},
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		//the general account 1
+		ses.GetTenantInfo().SetTenantID(1)
+
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+		})
+		//the table d.t and the database d only exist in the account 2
+		sql, _ = getSqlForCheckDatabaseTable(context.TODO(), "d", "t")
+		sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{
+			{100, 2},
+		})
+		sql, _ = getSqlForCheckDatabase(context.TODO(), "d")
+		sql2result[sql] = newMrsForCheckDatabase([][]interface{}{
+			{20, 2},
+		})
+		//the database d2 exists in both accounts
+		sql, _ = getSqlForCheckDatabase(context.TODO(), "d2")
+		sql2result[sql] = newMrsForCheckDatabase([][]interface{}{
+			{30, 2},
+			{31, 1},
+		})
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		_, _, err := checkPrivilegeObjectTypeAndPrivilegeLevel(context.TODO(), ses, bh, stmt.ObjType, *stmt.Level)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "does not belong to the current account")
+
+		_, _, err = checkPrivilegeObjectTypeAndPrivilegeLevel(context.TODO(), ses, bh, tree.OBJECT_TYPE_DATABASE,
+			tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE, DbName: "d"})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the id of the database in the current account is taken
+		_, objId, err := checkPrivilegeObjectTypeAndPrivilegeLevel(context.TODO(), ses, bh, tree.OBJECT_TYPE_DATABASE,
+			tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE, DbName: "d2"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(objId, convey.ShouldEqual, int64(31))
+
+		err = doGrantPrivilege(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "does not belong to the current account")
+	})
+
+	convey.Convey("the bare name in the object type database", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.gSysVars = ses.gSysVars.Clone()
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckDatabase(context.TODO(), "d")
+		sql2result[sql] = newMrsForCheckDatabase([][]interface{}{
+			{20, 0},
+		})
+		bh := newBh(ctrl, sql2result)
+
+		ambiguous := tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_TABLE, TabName: "d"}
+		explicit := *tree.ResolvePrivilegeLevel(tree.OBJECT_TYPE_DATABASE, &ambiguous)
+		convey.So(explicit.Level, convey.ShouldEqual, tree.PRIVILEGE_LEVEL_TYPE_DATABASE)
+		convey.So(explicit.DbName, convey.ShouldEqual, "d")
+
+		//the default reinterprets the table name as the database name
+		ses.gSysVars.Set(StrictPrivilegeLevel, int64(0))
+		privLevel, objId, err := checkPrivilegeObjectTypeAndPrivilegeLevel(context.TODO(), ses, bh, tree.OBJECT_TYPE_DATABASE, ambiguous)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(privLevel, convey.ShouldEqual, privilegeLevelDatabase)
+		convey.So(objId, convey.ShouldEqual, int64(20))
+
+		//the strict mode rejects it
+		ses.gSysVars.Set(StrictPrivilegeLevel, int64(1))
+		_, _, err = checkPrivilegeObjectTypeAndPrivilegeLevel(context.TODO(), ses, bh, tree.OBJECT_TYPE_DATABASE, ambiguous)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "use ON DATABASE d instead")
+
+		//the explicit database is accepted in the strict mode
+		privLevel, objId, err = checkPrivilegeObjectTypeAndPrivilegeLevel(context.TODO(), ses, bh, tree.OBJECT_TYPE_DATABASE, explicit)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(privLevel, convey.ShouldEqual, privilegeLevelDatabase)
+		convey.So(objId, convey.ShouldEqual, int64(20))
+	})
+}
+
+func Test_doRevokePrivilege(t *testing.T) {
+	convey.Convey("revoke account, role succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.RevokePrivilege{
+			Privileges: []*tree.Privilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE},
+			},
+			ObjType: tree.OBJECT_TYPE_ACCOUNT,
+			Level:   &tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_STAR},
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+		makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
+
+		//init from roles
+		for i, role := range stmt.Roles {
+			sql, _ := getSqlForRoleIdOfRole(context.TODO(), role.UserName)
+			mrs := newMrsForRoleIdOfRole([][]interface{}{
+				{i},
+			})
+			bh.sql2result[sql] = mrs
+		}
+
+		for _, p := range stmt.Privileges {
+			privType, err := convertAstPrivilegeTypeToPrivilegeType(context.TODO(), p.Type, tree.OBJECT_TYPE_ACCOUNT)
+			convey.So(err, convey.ShouldBeNil)
+			for j := range stmt.Roles {
+				sql := getSqlForCheckRoleHasPrivilege(int64(j), objectTypeAccount, objectIDAll, int64(privType))
+				mrs := newMrsForCheckRoleHasPrivilege([][]interface{}{})
+				bh.sql2result[sql] = mrs
+			}
+		}
+
+		err := doRevokePrivilege(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+	})
+	convey.Convey("revoke database, role succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmts := []*tree.RevokePrivilege{
+			{
+				Privileges: []*tree.Privilege{
+					{Type: tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES},
+				},
+				ObjType: tree.OBJECT_TYPE_DATABASE,
+				Level: &tree.PrivilegeLevel{
+					Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
+				},
+				Roles: []*tree.Role{
+					{UserName: "r1"},
+				},
+			},
+			{
+				Privileges: []*tree.Privilege{
+					{Type: tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES},
+				},
+				ObjType: tree.OBJECT_TYPE_DATABASE,
+				Level: &tree.PrivilegeLevel{
+					Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
+				},
+				Roles: []*tree.Role{
+					{UserName: "r1"},
+				},
+			},
+			{
+				Privileges: []*tree.Privilege{
+					{Type: tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES},
+				},
 				ObjType: tree.OBJECT_TYPE_DATABASE,
 				Level: &tree.PrivilegeLevel{
 					Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5295,6 +6759,7 @@ func Test_doRevokePrivilege(t *testing.T) {
 			bh.sql2result["begin;"] = nil
 			bh.sql2result["commit;"] = nil
 			bh.sql2result["rollback;"] = nil
+			makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 			//init from roles
 			for i, role := range stmt.Roles {
@@ -5311,13 +6776,13 @@ func Test_doRevokePrivilege(t *testing.T) {
 			if stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_DATABASE {
 				sql, _ := getSqlForCheckDatabase(context.TODO(), stmt.Level.DbName)
 				mrs := newMrsForCheckDatabase([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			} else if stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_TABLE {
 				sql, _ := getSqlForCheckDatabase(context.TODO(), stmt.Level.TabName)
 				mrs := newMrsForCheckDatabase([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			}
@@ -5427,6 +6892,7 @@ func Test_doRevokePrivilege(t *testing.T) {
 			bh.sql2result["begin;"] = nil
 			bh.sql2result["commit;"] = nil
 			bh.sql2result["rollback;"] = nil
+			makeRowsOfCheckTenant(bh.sql2result, sysAccountName, tree.AccountStatusOpen.String())
 
 			//init from roles
 			for i, role := range stmt.Roles {
@@ -5444,14 +6910,14 @@ func Test_doRevokePrivilege(t *testing.T) {
 				stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR {
 				sql, _ := getSqlForCheckDatabase(context.TODO(), dbName)
 				mrs := newMrsForCheckDatabase([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			} else if stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_TABLE ||
 				stmt.Level.Level == tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE {
 				sql, _ := getSqlForCheckDatabaseTable(context.TODO(), dbName, tableName)
 				mrs := newMrsForCheckDatabaseTable([][]interface{}{
-					{0},
+					{0, 0},
 				})
 				bh.sql2result[sql] = mrs
 			}
@@ -5475,123 +6941,578 @@ func Test_doRevokePrivilege(t *testing.T) {
 	})
 }
 
-func Test_doDropFunctionWithDB(t *testing.T) {
-	convey.Convey("drop function with db", t, func() {
+func Test_doRevokeGrantOption(t *testing.T) {
+	stmt := &tree.RevokePrivilege{
+		GrantOptionOnly: true,
+		Privileges: []*tree.Privilege{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE_USER},
+			{Type: tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS},
+		},
+		ObjType: tree.OBJECT_TYPE_ACCOUNT,
+		Level:   &tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_STAR},
+		Roles: []*tree.Role{
+			{UserName: "r1"},
+		},
+	}
+
+	convey.Convey("revoke the grant option keeps the privileges", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{5}})
 
-		bh := &backgroundExecTest{}
-		bh.init()
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
-		stmt := &tree.DropDatabase{
-			Name: tree.Identifier("abc"),
-		}
-
-		ses := &Session{}
-		sql := getSqlForCheckUdfWithDb(string(stmt.Name))
+		ses := newSes(determinePrivilegeSetOfStatement(stmt), ctrl)
+		err := doRevokePrivilege(context.TODO(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
 
-		bh.sql2result[sql] = nil
-		err := doDropFunctionWithDB(ctx, ses, stmt, nil)
-		convey.So(err, convey.ShouldNotBeNil)
+		updated := make(map[int64]bool)
+		for _, s := range executed {
+			convey.So(strings.HasPrefix(s, "delete from mo_catalog.mo_role_privs"), convey.ShouldBeFalse)
+			if !strings.HasPrefix(s, "update mo_catalog.mo_role_privs") {
+				continue
+			}
+			for _, privType := range []PrivilegeType{PrivilegeTypeCreateUser, PrivilegeTypeManageGrants} {
+				if strings.HasSuffix(s, fmt.Sprintf(`with_grant_option = false where role_id = 5 and obj_type = "account" and obj_id = %d and privilege_id = %d;`, objectIDAll, privType)) {
+					updated[int64(privType)] = true
+				}
+			}
+		}
+		convey.So(updated, convey.ShouldResemble, map[int64]bool{
+			int64(PrivilegeTypeCreateUser):   true,
+			int64(PrivilegeTypeManageGrants): true,
+		})
 	})
-}
 
-func Test_doDropFunction(t *testing.T) {
-	convey.Convey("drop function", t, func() {
+	convey.Convey("the privileges without the grant option satisfy the compound entry", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
-
-		bh := mock_frontend.NewMockBackgroundExec(ctrl)
-		bh.EXPECT().ClearExecResultSet().AnyTimes()
-		bh.EXPECT().Close().Return().AnyTimes()
-		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-		rs := mock_frontend.NewMockExecResult(ctrl)
-		rs.EXPECT().GetRowCount().Return(uint64(0)).AnyTimes()
-		bh.EXPECT().GetExecResultSet().Return([]interface{}{rs}).AnyTimes()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
+		cu := &tree.CreateUser{
+			Users: []*tree.User{{Username: "u2"}},
+			Role:  &tree.Role{UserName: "r1"},
+		}
+		priv := determinePrivilegeSetOfStatement(cu)
+		ses := newSes(priv, ctrl)
 
-		cu := &tree.DropFunction{
-			Name: tree.NewFuncName("testFunc",
-				tree.ObjectNamePrefix{
-					SchemaName:      tree.Identifier("db"),
-					CatalogName:     tree.Identifier(""),
-					ExplicitSchema:  true,
-					ExplicitCatalog: false,
-				},
-			),
-			Args: nil,
+		//the role 5 has the create user and the manage grants without the grant option
+		entries := []privilegeEntry{
+			privilegeEntriesMap[PrivilegeTypeAccountAll],
+			privilegeEntriesMap[PrivilegeTypeCreateUser],
+			privilegeEntriesMap[PrivilegeTypeManageGrants],
 		}
+		rowsOfMoRolePrivs := [][][][]interface{}{
+			{{}, {{5, false}}, {{5, false}}},
+		}
+		sql2result := makeSql2ExecResult2(5, nil, []int{5}, entries, rowsOfMoRolePrivs, nil, nil, nil, nil)
+		bh := newBh(ctrl, sql2result)
 
-		ses := &Session{}
-		err := doDropFunction(ctx, ses, cu, nil)
-		convey.So(err, convey.ShouldNotBeNil)
+		roles := &btree.Set[int64]{}
+		roles.Insert(5)
+		grant := &privilegeGrant{}
+		ok, err := determineRoleSetHasPrivilegeSet(context.TODO(), bh, ses, roles, priv, false, grant)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(grant.roleId, convey.ShouldEqual, 5)
 	})
 }
 
-func Test_doDropRole(t *testing.T) {
-	convey.Convey("drop role succ", t, func() {
+func Test_privilegeOnAllTablesInDatabase(t *testing.T) {
+	convey.Convey("grant and revoke on all tables in database", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.DropRole{
-			Roles: []*tree.Role{
-				{UserName: "r1"},
-				{UserName: "r2"},
-				{UserName: "r3"},
-			},
+		level := &tree.PrivilegeLevel{
+			Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_ALL_TABLES,
+			DbName: "db1",
+		}
+		privileges := []*tree.Privilege{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT},
+		}
+		roles := []*tree.Role{
+			{UserName: "r1"},
 		}
-		priv := determinePrivilegeSetOfStatement(stmt)
-		ses := newSes(priv, ctrl)
-
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
 
-		//init from roles
-		for i, role := range stmt.Roles {
-			sql, _ := getSqlForRoleIdOfRole(context.TODO(), role.UserName)
-			mrs := newMrsForRoleIdOfRole([][]interface{}{
-				{i},
-			})
-			bh.sql2result[sql] = mrs
+		ses := newSes(nil, ctrl)
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+		})
+		sql, _ = getSqlForCheckDatabase(context.TODO(), "db1")
+		sql2result[sql] = newMrsForCheckDatabase([][]interface{}{
+			{5, sysAccountID},
+		})
+		sql, _ = getSqlForGetTablesOfDatabase(context.TODO(), "db1", sysAccountID)
+		sql2result[sql] = newMrsForStrings([]string{"rel_id", "relname"}, [][]interface{}{
+			{100, "t1"},
+			{101, catalog.IndexTableNamePrefix + "t1_idx"},
+			{102, "t2"},
+		})
+		for _, tableId := range []int64{100, 101, 102} {
+			sql2result[getSqlForCheckRoleHasPrivilege(10, objectTypeTable, tableId, int64(PrivilegeTypeSelect))] = newMrsForCheckRoleHasPrivilege(nil)
 		}
 
-		for i := range stmt.Roles {
-			sqls := getSqlForDeleteRole(int64(i))
-			for _, sql := range sqls {
-				bh.sql2result[sql] = nil
+		hasPrefix := func(sqls []string, prefix string) []string {
+			var ret []string
+			for _, s := range sqls {
+				if strings.HasPrefix(s, prefix) {
+					ret = append(ret, s)
+				}
 			}
+			return ret
 		}
 
-		err := doDropRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		//one row per table. the index table is skipped
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		err := grantPrivilegeInTxn(context.TODO(), ses, bh, &tree.GrantPrivilege{
+			Privileges: privileges,
+			ObjType:    tree.OBJECT_TYPE_TABLE,
+			Level:      level,
+			Roles:      roles,
+		})
 		convey.So(err, convey.ShouldBeNil)
+		inserted := hasPrefix(executed, "insert into mo_catalog.mo_role_privs")
+		convey.So(len(inserted), convey.ShouldEqual, 2)
+		convey.So(inserted[0], convey.ShouldContainSubstring, `"table",100,`)
+		convey.So(inserted[1], convey.ShouldContainSubstring, `"table",102,`)
+		for _, s := range inserted {
+			convey.So(s, convey.ShouldContainSubstring, privilegeLevelDatabaseTable.String())
+		}
+
+		//revoke mirrors the grant
+		executed = nil
+		bh = newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		err = revokePrivilegeInTxn(context.TODO(), ses, bh, &tree.RevokePrivilege{
+			Privileges: privileges,
+			ObjType:    tree.OBJECT_TYPE_TABLE,
+			Level:      level,
+			Roles:      roles,
+		})
+		convey.So(err, convey.ShouldBeNil)
+		deleted := hasPrefix(executed, "delete from mo_catalog.mo_role_privs")
+		convey.So(deleted, convey.ShouldResemble, []string{
+			getSqlForDeleteRolePrivs(10, objectTypeTable.String(), 100, int64(PrivilegeTypeSelect), privilegeLevelDatabaseTable.String()),
+			getSqlForDeleteRolePrivs(10, objectTypeTable.String(), 102, int64(PrivilegeTypeSelect), privilegeLevelDatabaseTable.String()),
+		})
+
+		//the ownership needs a single table
+		executed = nil
+		bh = newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		err = grantPrivilegeInTxn(context.TODO(), ses, bh, &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP},
+			},
+			ObjType: tree.OBJECT_TYPE_TABLE,
+			Level:   level,
+			Roles:   roles,
+		})
+		convey.So(err, convey.ShouldNotBeNil)
 	})
-	convey.Convey("drop role succ (if exists)", t, func() {
+}
+
+func Test_privilegeOnColumns(t *testing.T) {
+	convey.Convey("grant and revoke on the columns of the table", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
+		level := &tree.PrivilegeLevel{
+			Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
+			DbName:  "db1",
+			TabName: "t1",
+		}
+		privileges := []*tree.Privilege{
+			{
+				Type: tree.PRIVILEGE_TYPE_STATIC_SELECT,
+				ColumnList: []*tree.UnresolvedName{
+					tree.NewUnresolvedColName("a"),
+					tree.NewUnresolvedColName("b"),
+				},
+			},
+		}
+		roles := []*tree.Role{
+			{UserName: "r1"},
+		}
+
+		ses := newSes(nil, ctrl)
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+		})
+		sql, _ = getSqlForCheckDatabaseTable(context.TODO(), "db1", "t1")
+		sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{
+			{100, sysAccountID},
+		})
+		for _, column := range []string{"a", "b"} {
+			sql, _ = getSqlForCheckColumnOfTable(context.TODO(), 100, column)
+			sql2result[sql] = newMrsForStrings([]string{"attname"}, [][]interface{}{
+				{column},
+			})
+			sql, _ = getSqlForCheckRoleHasColumnPrivs(context.TODO(), 10, 100, column, int64(PrivilegeTypeSelect))
+			sql2result[sql] = newMrsForStrings([]string{"role_id"}, nil)
+		}
+
+		hasPrefix := func(sqls []string, prefix string) []string {
+			var ret []string
+			for _, s := range sqls {
+				if strings.HasPrefix(s, prefix) {
+					ret = append(ret, s)
+				}
+			}
+			return ret
+		}
+
+		//one row per column. nothing on the table
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		err := grantPrivilegeInTxn(context.TODO(), ses, bh, &tree.GrantPrivilege{
+			Privileges: privileges,
+			ObjType:    tree.OBJECT_TYPE_TABLE,
+			Level:      level,
+			Roles:      roles,
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(hasPrefix(executed, "insert into mo_catalog.mo_role_privs")), convey.ShouldEqual, 0)
+		inserted := hasPrefix(executed, "insert into mo_catalog.mo_column_privs")
+		convey.So(len(inserted), convey.ShouldEqual, 2)
+		convey.So(inserted[0], convey.ShouldContainSubstring, `100,"a",`)
+		convey.So(inserted[1], convey.ShouldContainSubstring, `100,"b",`)
+
+		//revoke mirrors the grant
+		executed = nil
+		bh = newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		err = revokePrivilegeInTxn(context.TODO(), ses, bh, &tree.RevokePrivilege{
+			Privileges: privileges,
+			ObjType:    tree.OBJECT_TYPE_TABLE,
+			Level:      level,
+			Roles:      roles,
+		})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(hasPrefix(executed, "delete from mo_catalog.mo_role_privs")), convey.ShouldEqual, 0)
+		deleted := hasPrefix(executed, "delete from mo_catalog.mo_column_privs")
+		convey.So(deleted, convey.ShouldResemble, []string{
+			getSqlForDeleteColumnPrivs(10, 100, "a", int64(PrivilegeTypeSelect)),
+			getSqlForDeleteColumnPrivs(10, 100, "b", int64(PrivilegeTypeSelect)),
+		})
+
+		//the column does not exist
+		bh = newBh(ctrl, sql2result)
+		err = grantPrivilegeInTxn(context.TODO(), ses, bh, &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{
+					Type:       tree.PRIVILEGE_TYPE_STATIC_UPDATE,
+					ColumnList: []*tree.UnresolvedName{tree.NewUnresolvedColName("c")},
+				},
+			},
+			ObjType: tree.OBJECT_TYPE_TABLE,
+			Level:   level,
+			Roles:   roles,
+		})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//only the select and the update on the columns
+		bh = newBh(ctrl, sql2result)
+		err = grantPrivilegeInTxn(context.TODO(), ses, bh, &tree.GrantPrivilege{
+			Privileges: []*tree.Privilege{
+				{
+					Type:       tree.PRIVILEGE_TYPE_STATIC_INSERT,
+					ColumnList: []*tree.UnresolvedName{tree.NewUnresolvedColName("a")},
+				},
+			},
+			ObjType: tree.OBJECT_TYPE_TABLE,
+			Level:   level,
+			Roles:   roles,
+		})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("verify the privileges on the columns", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckRoleHasColumnLevelPrivilege(context.TODO(), 10, PrivilegeTypeSelect, "db1", "t1")
+		sql2result[sql] = newMrsForStrings([]string{"column_name"}, [][]interface{}{
+			{"a"},
+			{"b"},
+		})
+		bh := newBh(ctrl, sql2result)
+
+		mi := privilegeItem{
+			privilegeTyp: PrivilegeTypeSelect,
+			dbName:       "db1",
+			tableName:    "t1",
+			columns:      []string{"a", "B"},
+		}
+		yes, err := verifyColumnPrivilegesOfItem(context.TODO(), bh, ses, 10, mi)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(yes, convey.ShouldBeTrue)
+
+		mi.columns = []string{"a", "c"}
+		yes, err = verifyColumnPrivilegesOfItem(context.TODO(), bh, ses, 10, mi)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(yes, convey.ShouldBeFalse)
+	})
+}
+
+func Test_revokeAllPrivileges(t *testing.T) {
+	convey.Convey("revoke all privileges, grant option from roles", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+		})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "r2")
+		sql2result[sql] = newMrsForRoleIdOfRole(nil)
+
+		rp := &tree.RevokePrivilege{
+			IfExists: true,
+			Privileges: []*tree.Privilege{
+				tree.NewPrivilege(tree.PRIVILEGE_TYPE_STATIC_ALL, nil),
+				tree.NewPrivilege(tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION, nil),
+			},
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+				{UserName: "r2"},
+			},
+		}
+		convey.So(rp.IsAllPrivileges(), convey.ShouldBeTrue)
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		err := revokePrivilegeInTxn(context.TODO(), ses, bh, rp)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, fmt.Sprintf(deleteRoleFromMoRolePrivsFormat, 10))
+		convey.So(executed, convey.ShouldContain, fmt.Sprintf(deleteRoleFromMoColumnPrivsFormat, 10))
+
+		//the role r2 does not exist
+		rp.IfExists = false
+		bh = newBh(ctrl, sql2result)
+		err = revokePrivilegeInTxn(context.TODO(), ses, bh, rp)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the admin roles and the public
+		for _, role := range []string{moAdminRoleName, publicRoleName} {
+			rp.Roles = []*tree.Role{{UserName: role}}
+			bh = newBh(ctrl, sql2result)
+			err = revokePrivilegeInTxn(context.TODO(), ses, bh, rp)
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+	})
+}
+
+func Test_markPrivilegeCacheStale(t *testing.T) {
+	convey.Convey("the stale privilege cache is cleared by the session itself", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.GetPrivilegeCache().add(objectTypeTable, privilegeLevelStar, "", "", PrivilegeTypeSelect)
+		convey.So(ses.GetPrivilegeCache().has(objectTypeTable, privilegeLevelStar, "", "", PrivilegeTypeSelect), convey.ShouldBeTrue)
+
+		//the other session only marks the cache
+		ses.markPrivilegeCacheStale()
+		convey.So(ses.cache.has(objectTypeTable, privilegeLevelStar, "", "", PrivilegeTypeSelect), convey.ShouldBeTrue)
+
+		//cleared when the session uses it
+		cache := ses.GetPrivilegeCache()
+		convey.So(cache.has(objectTypeTable, privilegeLevelStar, "", "", PrivilegeTypeSelect), convey.ShouldBeFalse)
+
+		//the refilled cache is kept
+		cache.add(objectTypeTable, privilegeLevelStar, "", "", PrivilegeTypeSelect)
+		convey.So(ses.GetPrivilegeCache().has(objectTypeTable, privilegeLevelStar, "", "", PrivilegeTypeSelect), convey.ShouldBeTrue)
+	})
+}
+
+func Test_doDropFunctionWithDB(t *testing.T) {
+	convey.Convey("drop function with db", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		bh := &backgroundExecTest{}
+		bh.init()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.DropDatabase{
+			Name: tree.Identifier("abc"),
+		}
+
+		ses := &Session{}
+		sql := getSqlForCheckUdfWithDb(string(stmt.Name))
+
+		bh.sql2result[sql] = nil
+		err := doDropFunctionWithDB(ctx, ses, stmt, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_doDropFunction(t *testing.T) {
+	convey.Convey("drop function", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		rs := mock_frontend.NewMockExecResult(ctrl)
+		rs.EXPECT().GetRowCount().Return(uint64(0)).AnyTimes()
+		bh.EXPECT().GetExecResultSet().Return([]interface{}{rs}).AnyTimes()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		cu := &tree.DropFunction{
+			Name: tree.NewFuncName("testFunc",
+				tree.ObjectNamePrefix{
+					SchemaName:      tree.Identifier("db"),
+					CatalogName:     tree.Identifier(""),
+					ExplicitSchema:  true,
+					ExplicitCatalog: false,
+				},
+			),
+			Args: nil,
+		}
+
+		ses := &Session{}
+		err := doDropFunction(ctx, ses, cu, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_doDropFunctionIfExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetDatabaseName("db1")
+
+	parseDrop := func(sql string) *tree.DropFunction {
+		stmt, err := mysql.ParseOne(ctx, sql, 1)
+		require.NoError(t, err)
+		return stmt.(*tree.DropFunction)
+	}
+
+	sql2result := make(map[string]ExecResult)
+	sql, err := getSqlForCheckDatabase(ctx, "db1")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"dat_id"}, [][]interface{}{{1}})
+	sql2result[fmt.Sprintf(checkUdfArgs, "f1", "db1")] = newMrsForStrings(
+		[]string{"args", "function_id", "body"},
+		[][]interface{}{{`[{"name": "a", "type": "int32"}]`, 1, "$1 + 1"}},
+	)
+	sql2result[fmt.Sprintf(checkUdfArgs, "f2", "db1")] = newMrsForStrings(
+		[]string{"args", "function_id", "body"},
+		nil,
+	)
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	// no function with the name
+	err = doDropFunction(ctx, ses, parseDrop("drop function f2 (int)"), nil)
+	require.Error(t, err)
+	err = doDropFunction(ctx, ses, parseDrop("drop function if exists f2 (int)"), nil)
+	require.NoError(t, err)
+
+	// no function with the arguments
+	err = doDropFunction(ctx, ses, parseDrop("drop function f1 (bigint)"), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "f1(int64)")
+	require.Contains(t, err.Error(), "the available signatures are f1(int32)")
+	err = doDropFunction(ctx, ses, parseDrop("drop function f1 (int, int)"), nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "f1(int32, int32)")
+	err = doDropFunction(ctx, ses, parseDrop("drop function if exists f1 (int, int)"), nil)
+	require.NoError(t, err)
+	require.NotContains(t, executed, fmt.Sprintf(deleteUserDefinedFunctionFormat, 1))
+
+	// the function with the arguments
+	err = doDropFunction(ctx, ses, parseDrop("drop function if exists f1 (int)"), nil)
+	require.NoError(t, err)
+	require.Contains(t, executed, fmt.Sprintf(deleteUserDefinedFunctionFormat, 1))
+}
+
+func Test_doDropRole(t *testing.T) {
+	convey.Convey("drop role succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.DropRole{
+			Roles: []*tree.Role{
+				{UserName: "r1"},
+				{UserName: "r2"},
+				{UserName: "r3"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		//init from roles
+		for i, role := range stmt.Roles {
+			sql, _ := getSqlForRoleIdOfRole(context.TODO(), role.UserName)
+			mrs := newMrsForRoleIdOfRole([][]interface{}{
+				{i},
+			})
+			bh.sql2result[sql] = mrs
+		}
+
+		for i := range stmt.Roles {
+			sqls := getSqlForDeleteRole(int64(i))
+			for _, sql := range sqls {
+				bh.sql2result[sql] = nil
+			}
+		}
+
+		err := doDropRole(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+	})
+	convey.Convey("drop role succ (if exists)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
 		bh.init()
 
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
@@ -5740,41 +7661,151 @@ func Test_doDropUser(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 
-	convey.Convey("drop user succ (if exists)", t, func() {
+	convey.Convey("drop user succ (reassign the objects of the user)", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
 		stmt := &tree.DropUser{
-			IfExists: true,
 			Users: []*tree.User{
 				{Username: "u1"},
-				{Username: "u2"},
-				{Username: "u3"},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		oldPolicy := getGlobalPu().SV.DropUserCleanupPolicy
+		defer func() {
+			getGlobalPu().SV.DropUserCleanupPolicy = oldPolicy
+		}()
+		getGlobalPu().SV.DropUserCleanupPolicy = dropUserCleanupPolicyReassign
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", "public"},
+		})
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "u1", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{})
 
-		var mrs *MysqlResultSet
-		//init from roles
-		for i, user := range stmt.Users {
-			sql, _ := getSqlForPasswordOfUser(context.TODO(), user.Username)
-			if i == 0 {
-				mrs = newMrsForPasswordOfUser([][]interface{}{})
-			} else {
-				mrs = newMrsForPasswordOfUser([][]interface{}{
-					{i, "111", "public"},
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		err := doDropUser(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+
+		cleanupSqls := getSqlForCleanupDroppedUser(5, GetUserRootId())
+		convey.So(cleanupSqls, convey.ShouldContain, "update mo_catalog.mo_role set creator = 0 where creator = 5;")
+		convey.So(cleanupSqls, convey.ShouldContain, "update mo_catalog.mo_role_grant set operation_user_id = 2147483647 where operation_user_id = 5;")
+		convey.So(cleanupSqls, convey.ShouldContain, "update mo_catalog.mo_role_privs set operation_user_id = 2147483647 where operation_user_id = 5;")
+		for _, sql := range cleanupSqls {
+			convey.So(sql, convey.ShouldNotContainSubstring, "definer")
+		}
+		for _, sql := range getSqlForDeleteUser(5) {
+			convey.So(executed, convey.ShouldContain, sql)
+		}
+		for _, sql := range cleanupSqls {
+			convey.So(executed, convey.ShouldContain, sql)
+		}
+
+		//the objects are kept by default
+		getGlobalPu().SV.DropUserCleanupPolicy = "none"
+		executed = nil
+		err = doDropUser(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		for _, sql := range cleanupSqls {
+			convey.So(executed, convey.ShouldNotContain, sql)
+		}
+	})
+
+	convey.Convey("drop user succ (reassign the objects of the user before the upgrade)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.DropUser{
+			Users: []*tree.User{
+				{Username: "u1"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		oldPolicy := getGlobalPu().SV.DropUserCleanupPolicy
+		defer func() {
+			getGlobalPu().SV.DropUserCleanupPolicy = oldPolicy
+		}()
+		getGlobalPu().SV.DropUserCleanupPolicy = dropUserCleanupPolicyReassign
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", "public"},
+		})
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "u1", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+
+		//the account has no mo_column_privs and mo_variable_audit
+		var executed []string
+		var currentSql string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, sql string) error {
+			currentSql = sql
+			if strings.Contains(sql, "mo_column_privs") || strings.Contains(sql, "mo_variable_audit") {
+				return moerr.NewNoSuchTable(ctx, "mo_catalog", "mo_column_privs")
+			}
+			executed = append(executed, sql)
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		err := doDropUser(ses.GetTxnHandler().GetTxnCtx(), ses, stmt)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, "update mo_catalog.mo_role_privs set operation_user_id = 2147483647 where operation_user_id = 5;")
+		convey.So(executed, convey.ShouldContain, "commit;")
+	})
+
+	convey.Convey("drop user succ (if exists)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.DropUser{
+			IfExists: true,
+			Users: []*tree.User{
+				{Username: "u1"},
+				{Username: "u2"},
+				{Username: "u3"},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		var mrs *MysqlResultSet
+		//init from roles
+		for i, user := range stmt.Users {
+			sql, _ := getSqlForPasswordOfUser(context.TODO(), user.Username)
+			if i == 0 {
+				mrs = newMrsForPasswordOfUser([][]interface{}{})
+			} else {
+				mrs = newMrsForPasswordOfUser([][]interface{}{
+					{i, "111", "public"},
 				})
 			}
 
@@ -6072,6 +8103,156 @@ func Test_initProcedure(t *testing.T) {
 	})
 }
 
+func Test_initProcedureOverloads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newSes(nil, ctrl)
+	ses.SetDatabaseName("db1")
+
+	parseCreate := func(sql string) *tree.CreateProcedure {
+		stmt, err := mysql.ParseOne(ctx, sql, 1)
+		require.NoError(t, err)
+		return stmt.(*tree.CreateProcedure)
+	}
+
+	cp1 := parseCreate("create procedure p1 (in a int) 'begin select a; end'")
+	args1, err := getProcedureArgsJson(cp1.Args)
+	require.NoError(t, err)
+
+	sql2result := make(map[string]ExecResult)
+	sql2result[getSqlForCheckProcedureExistence("p1", "db1")] = newMrsForStrings(
+		[]string{"proc_id", "args"},
+		[][]interface{}{{1, string(args1)}},
+	)
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	// the same arguments
+	err = InitProcedure(ctx, ses, ses.GetTenantInfo(), cp1)
+	require.Error(t, err)
+
+	// the same number of the arguments can not be told apart by the call
+	err = InitProcedure(ctx, ses, ses.GetTenantInfo(), parseCreate("create procedure p1 (in b varchar(10)) 'begin select b; end'"))
+	require.Error(t, err)
+	require.NotContains(t, executed, "begin;")
+
+	// the different number of the arguments
+	err = InitProcedure(ctx, ses, ses.GetTenantInfo(), parseCreate("create procedure p1 (in a int, in b int) 'begin select a, b; end'"))
+	require.NoError(t, err)
+	require.Contains(t, executed, "begin;")
+}
+
+func Test_doDropProcedureOverloads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetDatabaseName("db1")
+
+	parseDrop := func(sql string) *tree.DropProcedure {
+		stmt, err := mysql.ParseOne(ctx, sql, 1)
+		require.NoError(t, err)
+		return stmt.(*tree.DropProcedure)
+	}
+
+	dp1 := parseDrop("drop procedure p1 (in a int)")
+	args1, err := getProcedureArgsJson(dp1.Args)
+	require.NoError(t, err)
+	dp2 := parseDrop("drop procedure p1 (in a int, out b varchar(10))")
+	args2, err := getProcedureArgsJson(dp2.Args)
+	require.NoError(t, err)
+	require.NotEqual(t, args1, args2)
+
+	sql2result := make(map[string]ExecResult)
+	sql2result[fmt.Sprintf(checkStoredProcedureArgs, "p1", "db1")] = newMrsForStrings(
+		[]string{"proc_id", "args"},
+		[][]interface{}{{1, string(args1)}, {2, string(args2)}},
+	)
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	// the overload with the same arguments
+	err = doDropProcedure(ctx, ses, dp2)
+	require.NoError(t, err)
+	require.Contains(t, executed, fmt.Sprintf(deleteStoredProcedureFormat, 2))
+	require.NotContains(t, executed, fmt.Sprintf(deleteStoredProcedureFormat, 1))
+
+	// no overload with the arguments
+	executed = nil
+	err = doDropProcedure(ctx, ses, parseDrop("drop procedure p1 ()"))
+	require.Error(t, err)
+	err = doDropProcedure(ctx, ses, parseDrop("drop procedure if exists p1 ()"))
+	require.NoError(t, err)
+
+	// the procedure with the overloads needs the arguments or the all
+	err = doDropProcedure(ctx, ses, parseDrop("drop procedure p1"))
+	require.Error(t, err)
+	require.NotContains(t, executed, fmt.Sprintf(deleteStoredProcedureFormat, 1))
+
+	err = doDropProcedure(ctx, ses, parseDrop("drop procedure p1 all"))
+	require.NoError(t, err)
+	require.Contains(t, executed, fmt.Sprintf(deleteStoredProcedureFormat, 1))
+	require.Contains(t, executed, fmt.Sprintf(deleteStoredProcedureFormat, 2))
+}
+
+func Test_authenticateUserCanCallProcedure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parseCall := func(sql string) *tree.CallStmt {
+		stmt, err := mysql.ParseOne(context.Background(), sql, 1)
+		require.NoError(t, err)
+		return stmt.(*tree.CallStmt)
+	}
+
+	call := parseCall("call db1.p1()")
+	priv := determinePrivilegeSetOfStatement(call)
+	require.Equal(t, objectTypeFunction, priv.objectType())
+	require.Len(t, priv.entries, 1)
+	require.Equal(t, PrivilegeTypeExecute, priv.entries[0].privilegeId)
+	ses := newSes(priv, ctrl)
+	ctx := ses.GetTxnHandler().GetTxnCtx()
+
+	//the overload without arguments is created by the role 5, the other one is created by the moadmin
+	sql2result := make(map[string]ExecResult)
+	sql2result[getSqlForGetOverloadsOfProcedure("p1", "db1")] = newMrsForStrings(
+		[]string{"proc_id", "creator", "args"},
+		[][]interface{}{{1, 5, "{}"}, {2, moAdminRoleID, `{"a": {}}`}},
+	)
+	sql2result[getSqlForInheritedRoleIdOfRoleId(moAdminRoleID)] = newMrsForInheritedRoleIdOfRoleId(nil)
+	bh := newBh(ctrl, sql2result)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	check := func(call *tree.CallStmt) bool {
+		ses.SetPrivilege(determinePrivilegeSetOfStatement(call))
+		ok, err := authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ctx, ses, call)
+		require.NoError(t, err)
+		return ok
+	}
+
+	//the owner of the procedure
+	require.True(t, check(parseCall("call db1.p1(1)")))
+
+	//no privilege execute on the procedure
+	require.False(t, check(call))
+
+	//the privilege execute on the procedure
+	privSql := getSqlForCheckRolesHaveRoutinePrivilege([]int64{moAdminRoleID}, 1, int64(PrivilegeTypeExecute))
+	sql2result[privSql] = newMrsForCheckRoleHasPrivilege([][]interface{}{{moAdminRoleID, false}})
+	require.True(t, check(call))
+	delete(sql2result, privSql)
+
+	//the missing procedure is reported by the call
+	require.True(t, check(parseCall("call db1.p2()")))
+}
+
 func TestDoSetSecondaryRoleAll(t *testing.T) {
 	convey.Convey("do set secondary role succ", t, func() {
 		ctrl := gomock.NewController(t)
@@ -6662,143 +8843,538 @@ func TestSetGlobalSysVar(t *testing.T) {
 	})
 }
 
-func boxExprStr(s string) tree.Expr {
-	return tree.NewNumValWithType(constant.MakeString(s), s, false, tree.P_char)
-}
-
-func mustUnboxExprStr(e tree.Expr) string {
-	if e == nil {
-		return ""
-	}
-	return e.(*tree.NumVal).OrigString()
-}
-
-func Test_doAlterUser(t *testing.T) {
-
-	alterUserFrom := func(stmt *tree.AlterUser) *alterUser {
-		au := &alterUser{}
-		for _, su := range stmt.Users {
-			u := &user{
-				Username: su.Username,
-				Hostname: su.Hostname,
-			}
-			if su.AuthOption != nil {
-				u.AuthExist = true
-				u.IdentTyp = su.AuthOption.Typ
-				u.IdentStr = mustUnboxExprStr(su.AuthOption.Str)
-			}
-			au.Users = append(au.Users, u)
-		}
-		return au
-	}
-
-	convey.Convey("alter user success", t, func() {
+func Test_exportAndImportCompatibilityModeVariables(t *testing.T) {
+	convey.Convey("export and import the variables succ", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		//export
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForGetSystemVariablesWithAccount(sysAccountID)] = newMrsForSystemVariablesOfAccount([][]interface{}{
+			{"query_result_timeout", "48"},
+		})
+		sql2result[getSqlForGetDatabaseVariablesWithAccount(sysAccountID)] = newMrsForDatabaseVariablesOfAccount([][]interface{}{
+			{"db1", "version_compatibility", "0.7"},
+			{"db1", "unique_check_on_autoincr", "Check"},
+			{"db2", "unknown_variable", "xxx"},
+			//the name needs to be quoted
+			{"my-db", "version_compatibility", "0.8"},
+		})
 
+		bh := newBh(ctrl, sql2result)
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
-		stmt := &tree.AlterUser{
-			Users: []*tree.User{
-				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
-			},
-		}
-		priv := determinePrivilegeSetOfStatement(stmt)
-		ses := newSes(priv, ctrl)
+		stmts, err := exportCompatibilityModeVariables(ctx, ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(stmts, convey.ShouldResemble, []string{
+			"set global query_result_timeout = '48';",
+			"alter database `db1` set mysql_compatibility_mode = '0.7';",
+			"alter database `db1` set unique_check_on_autoincr = 'Check';",
+			"alter database `my-db` set mysql_compatibility_mode = '0.8';",
+		})
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		//import
+		sql2result = make(map[string]ExecResult)
+		sql2result[getSqlForGetSysVarWithAccount(sysAccountID, "query_result_timeout")] = newMrsForSystemVariableNameOfAccount([][]interface{}{})
+		sql, _ := getSqlForCheckDatabaseWithOwner(ctx, "db1", sysAccountID)
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{0, 0},
+		})
+		sql, _ = getSqlForCheckDatabaseWithOwner(ctx, "my-db", sysAccountID)
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{0, 0},
+		})
 
-		rm, _ := NewRoutineManager(ctx)
-		ses.rm = rm
+		var executed []string
+		bh = newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub.Reset()
+		bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		err = importCompatibilityModeVariables(ctx, ses, strings.Join(stmts, "\n"))
+		convey.So(err, convey.ShouldBeNil)
 
-		for i, user := range stmt.Users {
-			sql, _ := getSqlForPasswordOfUser(context.TODO(), user.Username)
-			mrs := newMrsForPasswordOfUser([][]interface{}{
-				{i, "111", 0},
-			})
-			bh.sql2result[sql] = mrs
+		value, err := ses.GetGlobalSysVar("query_result_timeout")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(value, convey.ShouldEqual, 48)
+
+		updateVersionSql, _ := getSqlForupdateConfigurationByDbNameAndAccountName(ctx, "0.7", sysAccountName, "db1", "version_compatibility")
+		updateUniqueCheckSql, _ := getSqlForupdateConfigurationByDbNameAndAccountName(ctx, "Check", sysAccountName, "db1", "unique_check_on_autoincr")
+		convey.So(executed, convey.ShouldContain, getSqlForInsertSysVarWithAccount(sysAccountID, sysAccountName, "query_result_timeout", "48"))
+		convey.So(executed, convey.ShouldContain, updateVersionSql)
+		convey.So(executed, convey.ShouldContain, updateUniqueCheckSql)
+		updateQuotedSql, _ := getSqlForupdateConfigurationByDbNameAndAccountName(ctx, "0.8", sysAccountName, "my-db", "version_compatibility")
+		convey.So(executed, convey.ShouldContain, updateQuotedSql)
+	})
 
-			sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
-			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{
-				{0, 0},
-			})
-			bh.sql2result[sql] = mrs
-		}
+	convey.Convey("import the variables fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		for _, user := range stmt.Users {
-			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), user.Username)
-			bh.sql2result[sql] = nil
-		}
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
 
-		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
-		convey.So(err, convey.ShouldBeNil)
+		//not the replayable statement
+		err := importCompatibilityModeVariables(ctx, ses, "create database db1;")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the session variable
+		err = importCompatibilityModeVariables(ctx, ses, "set autocommit = '0';")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//not the admin
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		_, err = exportCompatibilityModeVariables(ctx, ses)
+		convey.So(err, convey.ShouldNotBeNil)
+		err = importCompatibilityModeVariables(ctx, ses, "set global autocommit = '0';")
+		convey.So(err, convey.ShouldNotBeNil)
 	})
+}
 
-	convey.Convey("alter user fail for alter multi user", t, func() {
+func Test_checkVariableValue(t *testing.T) {
+	ctx := context.TODO()
+	kases := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"autocommit", "1", false},
+		{"autocommit", "2", true},
+		{"query_result_timeout", "48", false},
+		{"query_result_timeout", "-1", true},
+		{"query_result_timeout", "abc", true},
+		{"sql_mode", "ANSI,TRADITIONAL", false},
+		{"sql_mode", "NO_SUCH_MODE", true},
+		{"transaction_isolation", "READ-COMMITTED", false},
+		{"transaction_isolation", "NO-SUCH-LEVEL", true},
+		{"no_such_variable", "1", true},
+		//the database configs keep their own values
+		{"version_compatibility", "8.0.30-MatrixOne-v0.7.0", false},
+		{"version_compatibility", "0.7.0", false},
+		{"version_compatibility", "", true},
+		{"unique_check_on_autoincr", "Check", false},
+		{"unique_check_on_autoincr", "check", true},
+	}
+	for _, kase := range kases {
+		err := checkVariableValue(ctx, kase.name, kase.value)
+		if kase.wantErr {
+			require.Error(t, err, kase.name+" "+kase.value)
+		} else {
+			require.NoError(t, err, kase.name+" "+kase.value)
+		}
+	}
+}
+
+func Test_auditVariableChanges(t *testing.T) {
+	convey.Convey("record the changes of the variables and read them", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		columns := []string{"account_id", "account_name", "dat_name", "variable_value"}
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForGetSysVarWithAccount(sysAccountID, "query_result_timeout")] = newMrsForSystemVariableNameOfAccount([][]interface{}{
+			{"query_result_timeout"},
+		})
+		sql2result[getSqlForGetVariableRowsWithAccount(sysAccountID, "query_result_timeout")] = newMrsForStrings(columns, [][]interface{}{
+			{sysAccountID, sysAccountName, "", "24"},
+		})
+		sql2result[getSqlForGetSysVarWithAccount(sysAccountID, "query_result_maxsize")] = newMrsForSystemVariableNameOfAccount(nil)
 
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
-		stmt := &tree.AlterUser{
-			Users: []*tree.User{
-				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
-				{Username: "u2", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
-				{Username: "u3", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
-			},
+		hasAudit := func(oldValue, newValue string) bool {
+			for _, sql := range executed {
+				if strings.HasPrefix(sql, "insert into mo_catalog.mo_variable_audit") &&
+					strings.Contains(sql, quoteVariableValue(oldValue)+", "+quoteVariableValue(newValue)) {
+					return true
+				}
+			}
+			return false
 		}
-		priv := determinePrivilegeSetOfStatement(stmt)
-		ses := newSes(priv, ctrl)
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		//update the variable in the table
+		err := doSetGlobalSystemVariable(ctx, ses, "query_result_timeout", int64(48))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hasAudit("24", "48"), convey.ShouldBeTrue)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateSysVarValue("48", sysAccountID, "query_result_timeout"))
 
-		rm, _ := NewRoutineManager(ctx)
-		ses.rm = rm
+		//the variable is not in the table, the old value is the default one
+		err = doSetGlobalSystemVariable(ctx, ses, "query_result_maxsize", uint64(200))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hasAudit(getVariableValue(gSysVarsDefs["query_result_maxsize"].Default), "200"), convey.ShouldBeTrue)
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		//read the recent changes
+		sql, _ := getSqlForGetRecentVariableChanges(ctx, sysAccountName, 10)
+		sql2result[sql] = newMrsForStrings([]string{"account_id", "account_name", "dat_name", "variable_name", "old_value", "new_value", "operation_user_id", "changed_time"}, [][]interface{}{
+			{sysAccountID, sysAccountName, "", "query_result_maxsize", "100", "200", rootID, "2024-01-02 00:00:00"},
+			{sysAccountID, sysAccountName, "", "query_result_timeout", "24", "48", rootID, "2024-01-01 00:00:00"},
+		})
+		changes, err := getRecentVariableChanges(ctx, bh, sysAccountName, 10)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(changes, convey.ShouldHaveLength, 2)
+		convey.So(changes[0].varName, convey.ShouldEqual, "query_result_maxsize")
+		convey.So(changes[1].oldValue, convey.ShouldEqual, "24")
+		convey.So(changes[1].newValue, convey.ShouldEqual, "48")
+	})
+}
 
-		for i, user := range stmt.Users {
-			sql, _ := getSqlForPasswordOfUser(context.TODO(), user.Username)
-			mrs := newMrsForPasswordOfUser([][]interface{}{
-				{i, "111", "public"},
-			})
-			bh.sql2result[sql] = mrs
+func Test_doSetUserSystemVariable(t *testing.T) {
+	convey.Convey("persist the variable for the user and apply it at the login", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-			sql, _ = getSqlForCheckUserHasRole(context.TODO(), user.Username, moAdminRoleID)
-			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{})
-			bh.sql2result[sql] = mrs
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, map[string]ExecResult{}, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		err := doSetUserSystemVariable(ctx, ses, "SQL_MODE", "ANSI")
+		convey.So(err, convey.ShouldBeNil)
+		value, err := ses.GetSessionSysVar("sql_mode")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(value, convey.ShouldEqual, "ANSI")
+		for _, sql := range getSqlForSetUserVariable(rootID, "sql_mode", "ANSI") {
+			convey.So(executed, convey.ShouldContain, sql)
 		}
 
-		for _, user := range stmt.Users {
-			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), user.Username)
-			bh.sql2result[sql] = nil
+		//the global variable can not be persisted for the user
+		err = doSetUserSystemVariable(ctx, ses, "version_comment", "xxx")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the user variables override the account variables
+		bgStub := gostub.Stub(&ExeSqlInBgSes, func(_ context.Context, _ *Session, sql string) ([]ExecResult, error) {
+			switch sql {
+			case getSqlForGetUserVariables(rootID):
+				return []ExecResult{newMrsForSystemVariablesOfAccount([][]interface{}{
+					{"sql_mode", "TRADITIONAL"},
+					{"unknown_variable", "xxx"},
+				})}, nil
+			}
+			return nil, nil
+		})
+		defer bgStub.Reset()
+
+		err = ses.InitSystemVariables(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		value, err = ses.GetSessionSysVar("sql_mode")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(value, convey.ShouldEqual, "TRADITIONAL")
+		value, err = ses.GetGlobalSysVar("sql_mode")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(value, convey.ShouldNotEqual, "TRADITIONAL")
+
+		//the account has not been upgraded to have the mo_user_variables
+		bgStub.Reset()
+		bgStub = gostub.Stub(&ExeSqlInBgSes, func(ctx context.Context, _ *Session, sql string) ([]ExecResult, error) {
+			if sql == getSqlForGetUserVariables(rootID) {
+				return nil, moerr.NewNoSuchTable(ctx, "mo_catalog", "mo_user_variables")
+			}
+			return nil, nil
+		})
+		defer bgStub.Reset()
+		err = ses.InitSystemVariables(ctx)
+		convey.So(err, convey.ShouldBeNil)
+	})
+}
+
+func Test_doResetMysqlCompatibilityMode(t *testing.T) {
+	convey.Convey("reset the mysql_compatibility_mode of the account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		bgStub := gostub.Stub(&ExeSqlInBgSes, func(_ context.Context, _ *Session, _ string) ([]ExecResult, error) {
+			return nil, nil
+		})
+		defer bgStub.Reset()
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckDatabase(ctx, "db1")
+		sql2result[sql] = newMrsForStrings([]string{"dat_id"}, [][]interface{}{
+			{1},
+		})
+		sql, _ = getSqlForCheckDatabase(ctx, "db2")
+		sql2result[sql] = newMrsForStrings([]string{"dat_id"}, nil)
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		//the variables of the databases are kept
+		err := doResetMysqlCompatibilityMode(ctx, ses, &tree.ResetMysqlCompatibilityMode{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteSystemVariablesWithAccount(sysAccountID))
+		for _, sql = range getSqlForInitSystemVariablesOfAccount(sysAccountID, sysAccountName, getGlobalPu()) {
+			convey.So(executed, convey.ShouldContain, sql)
 		}
+		convey.So(executed, convey.ShouldNotContain, getSqlForDeleteMysqlCompatbilityMode("db1"))
 
-		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
-		convey.So(err, convey.ShouldBeError)
+		//the variables of the database are reset too
+		executed = executed[:0]
+		err = doResetMysqlCompatibilityMode(ctx, ses, &tree.ResetMysqlCompatibilityMode{DbName: "db1"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteSystemVariablesWithAccount(sysAccountID))
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteMysqlCompatbilityMode("db1"))
+		versionValue, _ := ses.GetSessionSysVar("version")
+		for _, sql = range getSqlForInitDatabaseVariables(sysAccountID, sysAccountName, "db1", getVariableValue(versionValue)) {
+			convey.So(executed, convey.ShouldContain, sql)
+		}
+
+		//the database does not exist
+		err = doResetMysqlCompatibilityMode(ctx, ses, &tree.ResetMysqlCompatibilityMode{DbName: "db2"})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//only the admin can reset the variables
+		ses.GetTenantInfo().SetDefaultRole("role1")
+		err = doResetMysqlCompatibilityMode(ctx, ses, &tree.ResetMysqlCompatibilityMode{})
+		convey.So(err, convey.ShouldNotBeNil)
 	})
+}
 
-	convey.Convey("alter user fail for privilege", t, func() {
+func Test_exportAndImportDataSharingConfig(t *testing.T) {
+	newAccountSes := func(ctrl *gomock.Controller, name string, id uint32) *Session {
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(&TenantInfo{
+			Tenant:        name,
+			User:          "admin",
+			DefaultRole:   accountAdminRoleName,
+			TenantID:      id,
+			UserID:        2,
+			DefaultRoleID: accountAdminRoleID,
+		})
+		return ses
+	}
+	pubColumns := []string{"pub_name", "database_name", "account_list", "comment"}
+	subColumns := []string{"datname", "dat_createsql", "created_time"}
+	accountNames := map[string]string{
+		"acc1": "acc1_new",
+		"acc2": "acc2_new",
+	}
+
+	convey.Convey("export and import the publications and the subscriptions succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//export the publisher
+		ses := newAccountSes(ctrl, "acc1", 1)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+		sql2result := make(map[string]ExecResult)
+		sql2result[getPubsForExportSql] = newMrsForStrings(pubColumns, [][]interface{}{
+			{"pub1", "db1", "acc2,acc3", "shared"},
+		})
+		sql2result[fmt.Sprintf(getSubsFormat, 1)] = newMrsForStrings(subColumns, nil)
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		pubStmts, err := exportDataSharingConfig(ctx, ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pubStmts, convey.ShouldResemble, []string{
+			"create publication pub1 database db1 account acc2, acc3 comment 'shared';",
+		})
+
+		//export the subscriber
+		ses = newAccountSes(ctrl, "acc2", 2)
+		sql2result = make(map[string]ExecResult)
+		sql2result[getPubsForExportSql] = newMrsForStrings(pubColumns, nil)
+		sql2result[fmt.Sprintf(getSubsFormat, 2)] = newMrsForStrings(subColumns, [][]interface{}{
+			{"sub1", "create database sub1 from acc1 publication pub1", "2024-01-01 00:00:00"},
+		})
+		bh = newBh(ctrl, sql2result)
+		bhStub.Reset()
+		bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
+
+		subStmts, err := exportDataSharingConfig(ctx, ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(subStmts, convey.ShouldResemble, []string{
+			"create database sub1 from acc1 publication pub1;",
+		})
+
+		//import into the fresh publisher
+		ses = newAccountSes(ctrl, "acc1_new", 11)
+		sql2result = make(map[string]ExecResult)
+		sql, _ := getSqlForGetDbIdAndType(ctx, "db1", true, 11)
+		sql2result[sql] = newMrsForStrings([]string{"dat_id", "dat_type"}, [][]interface{}{
+			{uint64(100), ""},
+		})
+		var executed []string
+		bh = newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub.Reset()
+		bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
+
+		err = importDataSharingConfig(ctx, ses, strings.Join(pubStmts, "\n"), accountNames)
+		convey.So(err, convey.ShouldBeNil)
+		insertPubSql, _ := getSqlForInsertIntoMoPubs(ctx, "pub1", "db1", 100, true, "", "acc2_new,acc3", accountAdminRoleID, 2, "shared", true)
+		convey.So(executed, convey.ShouldContain, insertPubSql)
+
+		//import into the fresh subscriber.
+		//the subscriber can subscribe the publication of the fresh publisher.
+		ses = newAccountSes(ctrl, "acc2_new", 12)
+		sql2result = make(map[string]ExecResult)
+		sql, _ = getSqlForAccountIdAndStatus(ctx, "acc1_new", true)
+		sql2result[sql] = newMrsForStrings([]string{"account_id", "status"}, [][]interface{}{
+			{11, "open"},
+		})
+		sql, _ = getSqlForPubInfoForSub(ctx, "pub1", true)
+		sql2result[sql] = newMrsForStrings([]string{"database_name", "account_list", "all_table", "table_list"}, [][]interface{}{
+			{"db1", "acc2_new,acc3", "true", ""},
+		})
+		executed = nil
+		bh = newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub.Reset()
+		bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
+
+		err = importDataSharingConfig(ctx, ses, strings.Join(subStmts, "\n"), accountNames)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, "create database sub1 from acc1_new publication pub1;")
+	})
+
+	convey.Convey("import the publications and the subscriptions fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newAccountSes(ctrl, "acc2_new", 12)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		//the publication does not exist in the publisher
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForAccountIdAndStatus(ctx, "acc1_new", true)
+		sql2result[sql] = newMrsForStrings([]string{"account_id", "status"}, [][]interface{}{
+			{11, "open"},
+		})
+		sql, _ = getSqlForPubInfoForSub(ctx, "pub1", true)
+		sql2result[sql] = newMrsForStrings([]string{"database_name", "account_list", "all_table", "table_list"}, nil)
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		err := importDataSharingConfig(ctx, ses, "create database sub1 from acc1 publication pub1;", accountNames)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldNotContain, "create database sub1 from acc1_new publication pub1;")
+
+		//not the replayable statement
+		err = importDataSharingConfig(ctx, ses, "create database db1;", accountNames)
+		convey.So(err, convey.ShouldNotBeNil)
+		err = importDataSharingConfig(ctx, ses, "set global autocommit = '0';", accountNames)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//not the admin
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		_, err = exportDataSharingConfig(ctx, ses)
+		convey.So(err, convey.ShouldNotBeNil)
+		err = importDataSharingConfig(ctx, ses, "create database sub1 from acc1 publication pub1;", accountNames)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_checkSubscriptionsHealth(t *testing.T) {
+	convey.Convey("check the health of the subscriptions", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(&TenantInfo{
+			Tenant:        "acc2",
+			User:          "admin",
+			DefaultRole:   accountAdminRoleName,
+			TenantID:      2,
+			UserID:        2,
+			DefaultRoleID: accountAdminRoleID,
+		})
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		sql2result := make(map[string]ExecResult)
+		sql2result[fmt.Sprintf(getSubsFormat, 2)] = newMrsForStrings([]string{"datname", "dat_createsql", "created_time"}, [][]interface{}{
+			{"sub1", "create database sub1 from acc1 publication pub1", "2024-01-01 00:00:00"},
+			{"sub2", "create database sub2 from acc1 publication pub2", "2024-01-01 00:00:00"},
+			{"sub3", "create database sub3 from acc1 publication pub3", "2024-01-01 00:00:00"},
+			{"sub4", "create database sub4 from acc4 publication pub1", "2024-01-01 00:00:00"},
+			{"sub5", "create database sub5 from acc5 publication pub1", "2024-01-01 00:00:00"},
+		})
+		accountColumns := []string{"account_id", "status"}
+		sql, _ := getSqlForAccountIdAndStatus(ctx, "acc1", true)
+		sql2result[sql] = newMrsForStrings(accountColumns, [][]interface{}{{1, "open"}})
+		sql, _ = getSqlForAccountIdAndStatus(ctx, "acc4", true)
+		sql2result[sql] = newMrsForStrings(accountColumns, [][]interface{}{{4, tree.AccountStatusSuspend.String()}})
+		sql, _ = getSqlForAccountIdAndStatus(ctx, "acc5", true)
+		sql2result[sql] = newMrsForStrings(accountColumns, nil)
+
+		pubColumns := []string{"database_name", "account_list", "all_table", "table_list"}
+		sql, _ = getSqlForPubInfoForSub(ctx, "pub1", true)
+		sql2result[sql] = newMrsForStrings(pubColumns, [][]interface{}{{"db1", "acc2,acc3", "true", ""}})
+		sql, _ = getSqlForPubInfoForSub(ctx, "pub2", true)
+		sql2result[sql] = newMrsForStrings(pubColumns, nil)
+		sql, _ = getSqlForPubInfoForSub(ctx, "pub3", true)
+		sql2result[sql] = newMrsForStrings(pubColumns, [][]interface{}{{"db3", "acc3", "true", ""}})
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ret, err := checkSubscriptionsHealth(ctx, ses)
+		convey.So(err, convey.ShouldBeNil)
+		statuses := make(map[string]string)
+		for _, health := range ret {
+			statuses[health.subName] = health.status
+		}
+		convey.So(statuses, convey.ShouldResemble, map[string]string{
+			"sub1": subStatusValid,
+			"sub2": subStatusPubMissing,
+			"sub3": subStatusNotAuthorized,
+			"sub4": subStatusPubAccountSuspended,
+			"sub5": subStatusPubMissing,
+		})
+	})
+}
+
+func boxExprStr(s string) tree.Expr {
+	return tree.NewNumValWithType(constant.MakeString(s), s, false, tree.P_char)
+}
+
+func mustUnboxExprStr(e tree.Expr) string {
+	if e == nil {
+		return ""
+	}
+	return e.(*tree.NumVal).OrigString()
+}
+
+func Test_doAlterUser(t *testing.T) {
+
+	alterUserFrom := func(stmt *tree.AlterUser) *alterUser {
+		au := &alterUser{}
+		for _, su := range stmt.Users {
+			u := &user{
+				Username: su.Username,
+				Hostname: su.Hostname,
+			}
+			if su.AuthOption != nil {
+				u.AuthExist = true
+				u.IdentTyp = su.AuthOption.Typ
+				u.IdentStr = mustUnboxExprStr(su.AuthOption.Str)
+			}
+			au.Users = append(au.Users, u)
+		}
+		return au
+	}
+
+	convey.Convey("alter user success", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -6819,6 +9395,7 @@ func Test_doAlterUser(t *testing.T) {
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
 		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
@@ -6830,13 +9407,19 @@ func Test_doAlterUser(t *testing.T) {
 		for i, user := range stmt.Users {
 			sql, _ := getSqlForPasswordOfUser(context.TODO(), user.Username)
 			mrs := newMrsForPasswordOfUser([][]interface{}{
-				{i, "111", "public"},
+				{i, "111", 0},
 			})
 			bh.sql2result[sql] = mrs
 
-			sql, _ = getSqlForCheckUserHasRole(context.TODO(), user.Username, moAdminRoleID)
-			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+			sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{
+				{0, 0},
+			})
 			bh.sql2result[sql] = mrs
+
+			bh.sql2result[getSqlForPasswordLifetimeOfUser(int64(i))] = newMrsForPasswordLifetimeOfUser([][]interface{}{
+				{-1},
+			})
 		}
 
 		for _, user := range stmt.Users {
@@ -6845,50 +9428,21 @@ func Test_doAlterUser(t *testing.T) {
 		}
 
 		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
-		convey.So(err, convey.ShouldBeError)
+		convey.So(err, convey.ShouldBeNil)
 	})
-}
-
-func Test_doAlterAccount(t *testing.T) {
-	alterAcountFromStmt := func(stmt *tree.AlterAccount) *alterAccount {
-		aa := &alterAccount{
-			IfExists:     stmt.IfExists,
-			AuthExist:    stmt.AuthOption.Exist,
-			StatusOption: stmt.StatusOption,
-			Comment:      stmt.Comment,
-		}
-		aa.Name = mustUnboxExprStr(stmt.Name)
-		if stmt.AuthOption.Exist {
-			aa.AdminName = mustUnboxExprStr(stmt.AuthOption.AdminName)
-			aa.IdentTyp = stmt.AuthOption.IdentifiedType.Typ
-			aa.IdentStr = mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str)
-		}
-		return aa
-	}
 
-	convey.Convey("alter account (auth_option) succ", t, func() {
+	convey.Convey("alter user success (caching_sha2_password)", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			Name: boxExprStr("acc"),
-			AuthOption: tree.AlterAccountAuthOption{
-				Exist:     true,
-				AdminName: boxExprStr("rootx"),
-				IdentifiedType: tree.AccountIdentified{
-					Typ: tree.AccountIdentifiedByPassword,
-					Str: boxExprStr("111"),
-				},
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		ses.SetAuthPlugin(AuthCachingSha2Password)
 
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
@@ -6897,52 +9451,44 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
-
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{
-			{0, 0, 0, 0},
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{0, "111", 0},
 		})
-		bh.sql2result[sql] = mrs
-
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
-			{10, "111", 0},
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
 		})
+		sql2result[getSqlForPasswordLifetimeOfUser(0)] = newMrsForPasswordLifetimeOfUser([][]interface{}{})
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
 		convey.So(err, convey.ShouldBeNil)
+
+		//the new password is hashed by the scheme of the session
+		encryption := getPasswordHasher(AuthCachingSha2Password).Hash([]byte("123456"))
+		convey.So(getPasswordHasherOfAuthString(encryption).Plugin(), convey.ShouldEqual, AuthCachingSha2Password)
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), encryption, "u1")
+		convey.So(executed, convey.ShouldContain, sql)
 	})
 
-	convey.Convey("alter account (auth_option) failed (wrong identifiedBy)", t, func() {
+	convey.Convey("alter user resets the expired password", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			Name: boxExprStr("acc"),
-			AuthOption: tree.AlterAccountAuthOption{
-				Exist:     true,
-				AdminName: boxExprStr("rootx"),
-				IdentifiedType: tree.AccountIdentified{
-					Typ: tree.AccountIdentifiedByRandomPassword,
-					Str: boxExprStr("111"),
-				},
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "root", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		ses.setPasswordExpired(true)
 
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
@@ -6951,52 +9497,59 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		//only the ALTER USER of the current user can be executed
+		selStmt := &tree.Select{}
+		convey.So(isAlterPasswordOfCurrentUser(ctx, ses, selStmt), convey.ShouldBeFalse)
+		convey.So(authenticateUserCanExecuteStatement(ctx, ses, selStmt), convey.ShouldBeError)
+		convey.So(isAlterPasswordOfCurrentUser(ctx, ses, stmt), convey.ShouldBeTrue)
+		otherStmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
+			},
+		}
+		convey.So(isAlterPasswordOfCurrentUser(ctx, ses, otherStmt), convey.ShouldBeFalse)
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{
-			{0, 0, 0, 0},
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "root")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{0, "111", 0},
 		})
-		bh.sql2result[sql] = mrs
-
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
-			{10, "111", 0},
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
+		sql2result[getSqlForPasswordLifetimeOfUser(0)] = newMrsForPasswordLifetimeOfUser([][]interface{}{
+			{-1},
 		})
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
-		convey.So(err, convey.ShouldNotBeNil)
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldBeNil)
+
+		//the default_password_lifetime 0 denotes the password never expires
+		sql, _ = getSqlForUpdateExpiredTimeOfUser(context.TODO(), "NULL", "root")
+		convey.So(executed, convey.ShouldContain, sql)
+		convey.So(ses.isPasswordExpired(), convey.ShouldBeFalse)
 	})
 
-	convey.Convey("alter account (auth_option) failed (no account)", t, func() {
+	convey.Convey("alter user fail for the password policy", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			Name: boxExprStr("acc"),
-			AuthOption: tree.AlterAccountAuthOption{
-				Exist:     true,
-				AdminName: boxExprStr("rootx"),
-				IdentifiedType: tree.AccountIdentified{
-					Typ: tree.AccountIdentifiedByRandomPassword,
-					Str: boxExprStr("111"),
-				},
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		//do not change the cached global variables of the sys account
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set(ValidatePassword, int64(1))
 
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
@@ -7005,48 +9558,52 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{0, "111", 0},
+		})
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
+		sql2result[getSqlForPasswordLifetimeOfUser(0)] = newMrsForPasswordLifetimeOfUser([][]interface{}{})
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldNotBeNil)
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		//the transaction is rolled back before the password is hashed
+		convey.So(executed, convey.ShouldContain, "rollback;")
+		for _, sqlx := range executed {
+			convey.So(sqlx, convey.ShouldNotStartWith, "update mo_catalog.mo_user")
+		}
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
-		convey.So(err, convey.ShouldNotBeNil)
+		executed = nil
+		stmt.Users[0].AuthOption.Str = boxExprStr("Abc-1234")
+		err = doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, "commit;")
 	})
 
-	convey.Convey("alter account (auth_option) succ (no account, if exists)", t, func() {
+	convey.Convey("alter user fail for the reused password", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			IfExists: true,
-			Name:     boxExprStr("acc"),
-			AuthOption: tree.AlterAccountAuthOption{
-				Exist:     true,
-				AdminName: boxExprStr("rootx"),
-				IdentifiedType: tree.AccountIdentified{
-					Typ: tree.AccountIdentifiedByPassword,
-					Str: boxExprStr("111"),
-				},
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		//do not change the cached global variables of the sys account
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set(PasswordHistory, int64(2))
 
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
@@ -7055,49 +9612,77 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		//the same plaintext is hashed into the same stored value
+		hasher := getPasswordHasher(ses.GetAuthPlugin())
+		convey.So(hasher.Hash([]byte("123456")), convey.ShouldEqual, hasher.Hash([]byte("123456")))
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{})
-		bh.sql2result[sql] = mrs
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", 0},
+		})
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
+		sql2result[getSqlForPasswordLifetimeOfUser(5)] = newMrsForPasswordLifetimeOfUser([][]interface{}{})
+		sql2result[getSqlForPasswordHistoryOfUser(5, 0)] = newMrsForPasswordHistoryOfUser([][]interface{}{
+			{9, hasher.Hash([]byte("123456")), 0},
+			{7, getPasswordHasher(AuthCachingSha2Password).Hash([]byte("Abc-1234")), 0},
+			{3, getPasswordHasher(AuthNativePassword).Hash([]byte("Old-1234")), 0},
+		})
 
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		//the recent passwords hashed by any scheme
+		for _, pwd := range []string{"123456", "Abc-1234"} {
+			executed = nil
+			stmt.Users[0].AuthOption.Str = boxExprStr(pwd)
+			err := doAlterUser(ctx, ses, alterUserFrom(stmt))
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(executed, convey.ShouldContain, "rollback;")
+			for _, sqlx := range executed {
+				convey.So(sqlx, convey.ShouldNotStartWith, "update mo_catalog.mo_user")
+				convey.So(sqlx, convey.ShouldNotStartWith, "insert into mo_catalog.mo_password_history")
+			}
+		}
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		//the password out of the retention can be reused
+		executed = nil
+		stmt.Users[0].AuthOption.Str = boxExprStr("Old-1234")
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
 		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, "commit;")
+		recorded := false
+		for _, sqlx := range executed {
+			if strings.HasPrefix(sqlx, "insert into mo_catalog.mo_password_history") {
+				recorded = true
+				convey.So(sqlx, convey.ShouldContainSubstring, hasher.Hash([]byte("Old-1234")))
+			}
+		}
+		convey.So(recorded, convey.ShouldBeTrue)
+		//only the recent 2 passwords are kept
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteOldPasswordHistory(5, 7))
 	})
 
-	convey.Convey("alter account (auth_option) failed (has account,if exists, no user)", t, func() {
+	convey.Convey("alter user with the password used in the recent days", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			IfExists: true,
-			Name:     boxExprStr("acc"),
-			AuthOption: tree.AlterAccountAuthOption{
-				Exist:     true,
-				AdminName: boxExprStr("rootx"),
-				IdentifiedType: tree.AccountIdentified{
-					Typ: tree.AccountIdentifiedByPassword,
-					Str: boxExprStr("111"),
-				},
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		//do not change the cached global variables of the sys account
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set(PasswordHistory, int64(1))
+		ses.gSysVars.Set(PasswordReuseInterval, int64(365))
 
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
@@ -7106,42 +9691,55 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
-
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{
-			{0, "0", "open", 0},
+		hasher := getPasswordHasher(ses.GetAuthPlugin())
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", 0},
 		})
-		bh.sql2result[sql] = mrs
-
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
-
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = newMrsForCheckTenant([][]interface{}{
-			{0, 0, 0, 0},
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
+		//the password 7 is out of the count but in the window.
+		//the password 3 is out of both.
+		sql2result[getSqlForPasswordLifetimeOfUser(5)] = newMrsForPasswordLifetimeOfUser([][]interface{}{})
+		sql2result[getSqlForPasswordHistoryOfUser(5, 365)] = newMrsForPasswordHistoryOfUser([][]interface{}{
+			{9, hasher.Hash([]byte("Cur-1234")), 1},
+			{7, hasher.Hash([]byte("Abc-1234")), 1},
+			{3, hasher.Hash([]byte("Old-1234")), 0},
 		})
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		//the password used in the window
+		stmt.Users[0].AuthOption.Str = boxExprStr("Abc-1234")
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
 		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the password has been used in the recent 365 days")
+		convey.So(executed, convey.ShouldContain, "rollback;")
+
+		//the password used before the window can be reused
+		executed = nil
+		stmt.Users[0].AuthOption.Str = boxExprStr("Old-1234")
+		err = doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, "commit;")
+		//the passwords in the window are kept
+		convey.So(executed, convey.ShouldContain, getSqlForDeleteOldPasswordHistory(5, 3))
 	})
 
-	convey.Convey("alter account (auth_option) failed (no option)", t, func() {
+	convey.Convey("alter user account lock and unlock", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			IfExists: true,
-			Name:     boxExprStr("acc"),
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%"},
+			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
@@ -7153,52 +9751,84 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		sql2result := make(map[string]ExecResult)
+		for i, name := range []string{"u1", "root"} {
+			sql, _ := getSqlForPasswordOfUser(context.TODO(), name)
+			sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+				{5 - i*5, "111", 0},
+			})
+		}
+		sql, _ := getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		var selfLocked string
+		auditStub := gostub.Stub(&auditSelfLock, func(ctx context.Context, ses *Session, userName string) {
+			selfLocked = userName
+		})
+		defer auditStub.Reset()
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		//the admin locks the user without the password
+		au := alterUserFrom(stmt)
+		au.MiscOpt = tree.NewUserMiscOptionAccountLock()
+		err := doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForAccountLockOfUser(5))
+		convey.So(executed, convey.ShouldContain, "commit;")
+		for _, sqlx := range executed {
+			convey.So(sqlx, convey.ShouldNotContainSubstring, "authentication_string =")
+		}
+		convey.So(selfLocked, convey.ShouldBeEmpty)
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		executed = nil
+		au.MiscOpt = tree.NewUserMiscOptionAccountUnlock()
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUnlockUser(5))
+
+		//the other misc options
+		au.MiscOpt = tree.NewUserMiscOptionPasswordHistoryDefault()
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the non-admin can not lock the other user
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+		executed = nil
+		au.MiscOpt = tree.NewUserMiscOptionAccountLock()
+		err = doAlterUser(ctx, ses, au)
 		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldNotContain, getSqlForAccountLockOfUser(5))
+
+		//but can lock itself
+		stmt.Users[0].Username = "root"
+		au = alterUserFrom(stmt)
+		au.MiscOpt = tree.NewUserMiscOptionAccountLock()
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForAccountLockOfUser(0))
+		convey.So(selfLocked, convey.ShouldEqual, "root")
 	})
 
-	convey.Convey("alter account (auth_option) failed (two options)", t, func() {
+	convey.Convey("alter user password expire", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			IfExists: true,
-			Name:     boxExprStr("acc"),
-			AuthOption: tree.AlterAccountAuthOption{
-				Exist:     true,
-				AdminName: boxExprStr("rootx"),
-				IdentifiedType: tree.AccountIdentified{
-					Typ: tree.AccountIdentifiedByPassword,
-					Str: boxExprStr("111"),
-				},
-			},
-			StatusOption: tree.AccountStatus{
-				Exist:  true,
-				Option: tree.AccountStatusOpen,
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
+		//do not change the cached global variables of the sys account
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set("default_password_lifetime", int64(10))
 
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
@@ -7207,38 +9837,97 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", 0},
+		})
+		roleSql, _ := getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[roleSql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
+		//the user never expires the password
+		sql2result[getSqlForPasswordLifetimeOfUser(5)] = newMrsForPasswordLifetimeOfUser([][]interface{}{
+			{0},
+		})
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		//the new password keeps the password lifetime of the user
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldBeNil)
+		sql, _ = getSqlForUpdateExpiredTimeOfUser(context.TODO(), "NULL", "u1")
+		convey.So(executed, convey.ShouldContain, sql)
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		//the user without the password lifetime uses the default_password_lifetime
+		sql2result[getSqlForPasswordLifetimeOfUser(5)] = newMrsForPasswordLifetimeOfUser([][]interface{}{
+			{-1},
+		})
+		executed = nil
+		err = doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldNotContain, sql)
+
+		//the password lifetime is set without the password
+		stmt.Users[0].AuthOption = nil
+		au := alterUserFrom(stmt)
+		au.MiscOpt = tree.NewUserMiscOptionPasswordExpireNever()
+		executed = nil
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdatePasswordLifetimeOfUser(5, "0", "NULL"))
+		for _, sqlx := range executed {
+			convey.So(sqlx, convey.ShouldNotContainSubstring, "authentication_string =")
+		}
+
+		au.MiscOpt = tree.NewUserMiscOptionPasswordExpireInterval(30)
+		executed = nil
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, "commit;")
+
+		au.MiscOpt = tree.NewUserMiscOptionPasswordExpireInterval(0)
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the PASSWORD EXPIRE expires the password at once
+		au.MiscOpt = tree.NewUserMiscOptionPasswordExpireNone()
+		executed = nil
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		expired := false
+		for _, sqlx := range executed {
+			if strings.HasPrefix(sqlx, `update mo_catalog.mo_user set expired_time = "`) {
+				expired = true
+			}
+		}
+		convey.So(expired, convey.ShouldBeTrue)
+
+		//the general user can not change the password expiration of itself
+		sql2result[roleSql] = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), "root")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{0, "111", 0},
+		})
+		stmt.Users[0].Username = "root"
+		au = alterUserFrom(stmt)
+		au.MiscOpt = tree.NewUserMiscOptionPasswordExpireNever()
+		executed = nil
+		err = doAlterUser(ctx, ses, au)
 		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldNotContain, getSqlForUpdatePasswordLifetimeOfUser(0, "0", "NULL"))
 	})
 
-	convey.Convey("alter account (auth_option) succ Comments", t, func() {
+	convey.Convey("alter user comment and attribute", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			Name: boxExprStr("acc"),
-			Comment: tree.AccountComment{
-				Exist:   true,
-				Comment: "new account",
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%"},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
@@ -7251,42 +9940,61 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
-
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{
-			{0, 0, 0, 0},
+		sql2result := make(map[string]ExecResult)
+		for i, name := range []string{"u1", "root"} {
+			sql, _ := getSqlForPasswordOfUser(context.TODO(), name)
+			sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+				{5 - i*5, "111", 0},
+			})
+		}
+		sql, _ := getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
+		})
+		sql, _ = getSqlForCommentOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForCommentOfUser([][]interface{}{
+			{"", `{"team":"dev","level":1}`},
 		})
-		bh.sql2result[sql] = mrs
 
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		sql, _ = getSqlForUpdateCommentsOfAccount(context.TODO(), stmt.Comment.Comment, mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		//the comment without the password
+		au := alterUserFrom(stmt)
+		au.CommentOrAttribute = tree.AccountCommentOrAttribute{Exist: true, IsComment: true, Str: "it's u1"}
+		err := doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateCommentOfUser("it's u1", 5))
+		for _, sqlx := range executed {
+			convey.So(sqlx, convey.ShouldNotContainSubstring, "authentication_string =")
+		}
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		//the attribute is merged into the old one
+		executed = nil
+		au.CommentOrAttribute = tree.AccountCommentOrAttribute{Exist: true, Str: `{"level":null,"city":"sh"}`}
+		err = doAlterUser(ctx, ses, au)
 		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateAttributeOfUser(`{"city":"sh","team":"dev"}`, 5))
+
+		//the attribute is not a JSON object
+		executed = nil
+		au.CommentOrAttribute = tree.AccountCommentOrAttribute{Exist: true, Str: "test"}
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldNotBeNil)
+		for _, sqlx := range executed {
+			convey.So(sqlx, convey.ShouldNotContainSubstring, "set attribute")
+		}
 	})
 
-	convey.Convey("alter account (auth_option) succ Status", t, func() {
+	convey.Convey("alter user default role", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
-
-		stmt := &tree.AlterAccount{
-			Name: boxExprStr("acc"),
-			StatusOption: tree.AccountStatus{
-				Exist:  true,
-				Option: tree.AccountStatusSuspend,
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%"},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
@@ -7299,28 +10007,79 @@ func Test_doAlterAccount(t *testing.T) {
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
-
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{
-			{0, 0, 0, 0},
+		sql2result := make(map[string]ExecResult)
+		for i, name := range []string{"u1", "root"} {
+			sql, _ := getSqlForPasswordOfUser(context.TODO(), name)
+			sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+				{5 - i*5, "111", 0},
+			})
+		}
+		adminSql, _ := getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		sql2result[adminSql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+			{0, 0},
 		})
-		bh.sql2result[sql] = mrs
+		for i, name := range []string{"r1", "r2"} {
+			sql, _ := getSqlForRoleIdOfRole(context.TODO(), name)
+			sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+				{10 + i},
+			})
+		}
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r3")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		for _, name := range []string{"u1", "root"} {
+			sql, _ = getSqlForCheckUserHasRole(context.TODO(), name, 10)
+			sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{
+				{0, 10},
+			})
+			sql, _ = getSqlForCheckUserHasRole(context.TODO(), name, 11)
+			sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+		}
 
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-		sql, _ = getSqlForUpdateStatusOfAccount(context.TODO(), stmt.StatusOption.Option.String(), types.CurrentTimestamp().String2(time.UTC, 0), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		//the admin changes the default role of the user without the password
+		au := alterUserFrom(stmt)
+		au.Role = &tree.Role{UserName: "r1"}
+		err := doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateDefaultRoleOfUser(10, 5))
+		for _, sqlx := range executed {
+			convey.So(sqlx, convey.ShouldNotContainSubstring, "authentication_string =")
+		}
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		//the role has not been granted to the user
+		executed = nil
+		au.Role = &tree.Role{UserName: "r2"}
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldNotContain, getSqlForUpdateDefaultRoleOfUser(11, 5))
+
+		//the role does not exist
+		au.Role = &tree.Role{UserName: "r3"}
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the non-admin can not change the default role of the other user
+		sql2result[adminSql] = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+		executed = nil
+		au.Role = &tree.Role{UserName: "r1"}
+		err = doAlterUser(ctx, ses, au)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldNotContain, getSqlForUpdateDefaultRoleOfUser(10, 5))
+
+		//but can change its own
+		stmt.Users[0].Username = "root"
+		au = alterUserFrom(stmt)
+		au.Role = &tree.Role{UserName: "r1"}
+		err = doAlterUser(ctx, ses, au)
 		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateDefaultRoleOfUser(10, 0))
 	})
 
-	convey.Convey("alter account (status_option) fail", t, func() {
+	convey.Convey("alter user fail for alter multi user", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -7330,11 +10089,11 @@ func Test_doAlterAccount(t *testing.T) {
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
-		stmt := &tree.AlterAccount{
-			Name: boxExprStr("sys"),
-			StatusOption: tree.AccountStatus{
-				Exist:  true,
-				Option: tree.AccountStatusSuspend,
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
+				{Username: "u2", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
+				{Username: "u3", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
 			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
@@ -7352,37 +10111,28 @@ func Test_doAlterAccount(t *testing.T) {
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		for i, user := range stmt.Users {
+			sql, _ := getSqlForPasswordOfUser(context.TODO(), user.Username)
+			mrs := newMrsForPasswordOfUser([][]interface{}{
+				{i, "111", "public"},
+			})
+			bh.sql2result[sql] = mrs
 
-		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
-		bh.sql2result[sql] = nil
+			sql, _ = getSqlForCheckUserHasRole(context.TODO(), user.Username, moAdminRoleID)
+			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+			bh.sql2result[sql] = mrs
+		}
 
-		sql, _ = getSqlForUpdateStatusOfAccount(context.TODO(), stmt.StatusOption.Option.String(), types.CurrentTimestamp().String2(time.UTC, 0), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		for _, user := range stmt.Users {
+			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), user.Username)
+			bh.sql2result[sql] = nil
+		}
 
-		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
-		convey.So(err, convey.ShouldNotBeNil)
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldBeError)
 	})
-}
-
-func newMrsForShowTables(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
-
-	col2 := &MysqlColumn{}
-	col2.SetName("table")
-	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
-	mrs.AddColumn(col2)
-
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
-
-	return mrs
-}
 
-func Test_doDropAccount(t *testing.T) {
-	convey.Convey("drop account", t, func() {
+	convey.Convey("alter user fail for privilege", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -7392,8 +10142,10 @@ func Test_doDropAccount(t *testing.T) {
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
-		stmt := &tree.DropAccount{
-			Name: boxExprStr("acc"),
+		stmt := &tree.AlterUser{
+			Users: []*tree.User{
+				{Username: "u1", Hostname: "%", AuthOption: &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: boxExprStr("123456")}},
+			},
 		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
@@ -7401,7 +10153,6 @@ func Test_doDropAccount(t *testing.T) {
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
 		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
-
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
@@ -7410,91 +10161,342 @@ func Test_doDropAccount(t *testing.T) {
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{
-			{0, "0", "open", 0},
-		})
-		bh.sql2result[sql] = mrs
+		for i, user := range stmt.Users {
+			sql, _ := getSqlForPasswordOfUser(context.TODO(), user.Username)
+			mrs := newMrsForPasswordOfUser([][]interface{}{
+				{i, "111", "public"},
+			})
+			bh.sql2result[sql] = mrs
 
-		sql, _ = getSqlForDeleteAccountFromMoAccount(context.TODO(), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+			sql, _ = getSqlForCheckUserHasRole(context.TODO(), user.Username, moAdminRoleID)
+			mrs = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+			bh.sql2result[sql] = mrs
+		}
 
-		for _, sql = range getSqlForDropAccount() {
+		for _, user := range stmt.Users {
+			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), user.Username)
 			bh.sql2result[sql] = nil
 		}
 
-		sql = "show databases;"
-		bh.sql2result[sql] = newMrsForSqlForShowDatabases([][]interface{}{})
-
-		bh.sql2result["show tables from mo_catalog;"] = newMrsForShowTables([][]interface{}{})
-
-		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{
-			IfExists: stmt.IfExists,
-			Name:     mustUnboxExprStr(stmt.Name),
-		})
-		convey.So(err, convey.ShouldBeNil)
+		err := doAlterUser(ctx, ses, alterUserFrom(stmt))
+		convey.So(err, convey.ShouldBeError)
 	})
-	convey.Convey("drop account (if exists)", t, func() {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		bh := &backgroundExecTest{}
-		bh.init()
-
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
+}
 
-		stmt := &tree.DropAccount{
-			IfExists: true,
-			Name:     boxExprStr("acc"),
+func Test_checkPasswordPolicy(t *testing.T) {
+	ctx := context.TODO()
+	tests := []struct {
+		password string
+		wantErr  bool
+	}{
+		{"Abc-1234", false},
+		{"Ab-1234", true},
+		{"abc-1234", true},
+		{"ABC-1234", true},
+		{"Abc-defg", true},
+		{"Abc12345", true},
+		{"Passw0rd!", true},
+		{"PASSW0RD!", true},
+		{"密码Abc-1234", false},
+	}
+	for _, tt := range tests {
+		err := checkPasswordPolicy(ctx, tt.password, 8, 1, 1, 1, "passw0rd!, qwer-1234")
+		if tt.wantErr {
+			assert.Error(t, err, tt.password)
+		} else {
+			assert.NoError(t, err, tt.password)
 		}
-		priv := determinePrivilegeSetOfStatement(stmt)
-		ses := newSes(priv, ctrl)
+	}
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+	//the policy without requirements
+	assert.NoError(t, checkPasswordPolicy(ctx, "1", 0, 0, 0, 0, ""))
+}
 
-		rm, _ := NewRoutineManager(ctx)
-		ses.rm = rm
+func Test_loginLock(t *testing.T) {
+	newMrsForLoginLockOfUser := func(rows [][]interface{}) *MysqlResultSet {
+		mrs := &MysqlResultSet{}
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		col1 := &MysqlColumn{}
+		col1.SetName("login_attempts")
+		col1.SetColumnType(defines.MYSQL_TYPE_LONG)
 
-		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{})
-		bh.sql2result[sql] = mrs
+		col2 := &MysqlColumn{}
+		col2.SetName("has_lock_time")
+		col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
 
-		sql, _ = getSqlForDeleteAccountFromMoAccount(context.TODO(), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		col3 := &MysqlColumn{}
+		col3.SetName("locked")
+		col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
-		for _, sql = range getSqlForDropAccount() {
-			bh.sql2result[sql] = nil
+		mrs.AddColumn(col1)
+		mrs.AddColumn(col2)
+		mrs.AddColumn(col3)
+
+		for _, row := range rows {
+			mrs.AddRow(row)
 		}
 
-		bh.sql2result["show tables from mo_catalog;"] = newMrsForShowTables([][]interface{}{})
+		return mrs
+	}
 
-		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{
-			IfExists: stmt.IfExists,
-			Name:     mustUnboxExprStr(stmt.Name),
-		})
-		convey.So(err, convey.ShouldBeNil)
-	})
-	convey.Convey("drop account fail", t, func() {
+	convey.Convey("lock the user after the failed logins", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		bh := &backgroundExecTest{}
-		bh.init()
+		ses := newSes(nil, ctrl)
+		//do not change the cached global variables of the sys account
+		ses.gSysVars = ses.gSysVars.Clone()
+		ses.gSysVars.Set(FailedLoginAttempts, int64(3))
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		var executed []string
+		var row []interface{}
+		bgStub := gostub.Stub(&ExeSqlInBgSes, func(_ context.Context, _ *Session, sql string) ([]ExecResult, error) {
+			executed = append(executed, sql)
+			if strings.HasPrefix(sql, "select") {
+				return []ExecResult{newMrsForLoginLockOfUser([][]interface{}{row})}, nil
+			}
+			return nil, nil
+		})
+		defer bgStub.Reset()
 
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
+		u1 := &TenantInfo{Tenant: "acc1", User: "u1", TenantID: 1, UserID: 5}
 
-		stmt := &tree.DropAccount{
-			Name: boxExprStr("acc"),
-		}
+		//the second failed login
+		row = []interface{}{1, false, false}
+		lock, err := getLoginLockOfUser(ctx, ses, u1, 5)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lock.enabled, convey.ShouldBeTrue)
+		convey.So(lock.locked, convey.ShouldBeFalse)
+		err = recordFailedLogin(ctx, ses, lock)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed[len(executed)-1], convey.ShouldEqual, getSqlForUpdateLoginAttemptsOfUser(5, 2))
+
+		//the third failed login locks the user
+		row = []interface{}{2, false, false}
+		lock, err = getLoginLockOfUser(ctx, ses, u1, 5)
+		convey.So(err, convey.ShouldBeNil)
+		err = recordFailedLogin(ctx, ses, lock)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed[len(executed)-1], convey.ShouldStartWith, `update mo_catalog.mo_user set status = "lock", login_attempts = 0, lock_time = "`)
+
+		//the user is locked in the window
+		row = []interface{}{0, true, true}
+		lock, err = getLoginLockOfUser(ctx, ses, u1, 5)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lock.locked, convey.ShouldBeTrue)
+
+		//the successful login after the window unlocks the user
+		row = []interface{}{0, true, false}
+		lock, err = getLoginLockOfUser(ctx, ses, u1, 5)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lock.locked, convey.ShouldBeFalse)
+		err = resetLoginLockOfUser(ctx, ses, lock)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed[len(executed)-1], convey.ShouldEqual, getSqlForUnlockUser(5))
+
+		//nothing to reset
+		cnt := len(executed)
+		row = []interface{}{0, false, false}
+		lock, err = getLoginLockOfUser(ctx, ses, u1, 5)
+		convey.So(err, convey.ShouldBeNil)
+		err = resetLoginLockOfUser(ctx, ses, lock)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(executed), convey.ShouldEqual, cnt+1)
+
+		//the root of the sys account is never locked
+		executed = nil
+		lock, err = getLoginLockOfUser(ctx, ses, ses.GetTenantInfo(), 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lock.enabled, convey.ShouldBeFalse)
+		err = recordFailedLogin(ctx, ses, lock)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldBeEmpty)
+
+		//the account has not been upgraded to have the login_attempts
+		bgStub.Reset()
+		bgStub = gostub.Stub(&ExeSqlInBgSes, func(ctx context.Context, _ *Session, sql string) ([]ExecResult, error) {
+			return nil, moerr.NewInvalidInput(ctx, "column login_attempts does not exist")
+		})
+		lock, err = getLoginLockOfUser(ctx, ses, u1, 5)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lock.enabled, convey.ShouldBeFalse)
+		convey.So(lock.locked, convey.ShouldBeFalse)
+
+		//the lockout is disabled
+		ses.gSysVars.Set(FailedLoginAttempts, int64(0))
+		lock, err = getLoginLockOfUser(ctx, ses, u1, 5)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lock.enabled, convey.ShouldBeFalse)
+		convey.So(executed, convey.ShouldBeEmpty)
+	})
+}
+
+func Test_mergeAttributeOfUser(t *testing.T) {
+	convey.Convey("merge the attribute of the user", t, func() {
+		kases := []struct {
+			old       string
+			attribute string
+			want      string
+			wantErr   bool
+		}{
+			{"", `{"a":1}`, `{"a":1}`, false},
+			{`{"a":1}`, `{"b":"x"}`, `{"a":1,"b":"x"}`, false},
+			{`{"a":1,"b":"x"}`, `{"a":2,"b":null}`, `{"a":2}`, false},
+			{`{"a":1}`, `{}`, `{"a":1}`, false},
+			{"", "test", "", true},
+			{"", `[1,2]`, "", true},
+			{"", `null`, "", true},
+			{"bad", `{"a":1}`, "", true},
+		}
+
+		for _, kase := range kases {
+			got, err := mergeAttributeOfUser(context.TODO(), kase.old, kase.attribute)
+			if kase.wantErr {
+				convey.So(err, convey.ShouldNotBeNil)
+				continue
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(got, convey.ShouldEqual, kase.want)
+		}
+	})
+}
+
+func Test_checkComment(t *testing.T) {
+	convey.Convey("check the comment", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		//do not change the cached global variables of the sys account
+		ses.gSysVars = ses.gSysVars.Clone()
+		ctx := context.TODO()
+
+		//valid comments
+		convey.So(checkComment(ctx, ses, "", 0), convey.ShouldBeNil)
+		convey.So(checkComment(ctx, ses, "the user of the\treport\r\n", 0), convey.ShouldBeNil)
+		convey.So(checkComment(ctx, ses, "报表用户", commentMaxLengthOfAccount), convey.ShouldBeNil)
+
+		//over-length
+		convey.So(checkComment(ctx, ses, strings.Repeat("a", 2048), 0), convey.ShouldBeNil)
+		convey.So(checkComment(ctx, ses, strings.Repeat("a", 2049), 0), convey.ShouldNotBeNil)
+		convey.So(checkComment(ctx, ses, strings.Repeat("a", 257), commentMaxLengthOfAccount), convey.ShouldNotBeNil)
+		//the length is the count of the characters
+		convey.So(checkComment(ctx, ses, strings.Repeat("报", 256), commentMaxLengthOfAccount), convey.ShouldBeNil)
+
+		ses.gSysVars.Set(CommentMaxLength, int64(10))
+		convey.So(checkComment(ctx, ses, strings.Repeat("a", 11), 0), convey.ShouldNotBeNil)
+		ses.gSysVars.Set(CommentMaxLength, int64(0))
+		convey.So(checkComment(ctx, ses, strings.Repeat("a", 10000), 0), convey.ShouldBeNil)
+		convey.So(checkComment(ctx, ses, strings.Repeat("a", 257), commentMaxLengthOfAccount), convey.ShouldNotBeNil)
+
+		//control characters
+		convey.So(checkComment(ctx, ses, "abc\x00", 0), convey.ShouldNotBeNil)
+		convey.So(checkComment(ctx, ses, "abc\x1b[31m", 0), convey.ShouldNotBeNil)
+		convey.So(checkComment(ctx, ses, "abc\u0085", 0), convey.ShouldNotBeNil)
+
+		//invalid utf8
+		convey.So(checkComment(ctx, ses, "abc\xff", 0), convey.ShouldNotBeNil)
+		ses.gSysVars.Set(CommentRequireUtf8, int64(0))
+		convey.So(checkComment(ctx, ses, "abc\xff", 0), convey.ShouldBeNil)
+	})
+}
+
+func Test_checkAccountLockOfUser(t *testing.T) {
+	convey.Convey("refuse the user locked by the account lock", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := context.TODO()
+		u1 := &TenantInfo{Tenant: sysAccountName, User: "u1", UserID: 5}
+
+		var row []interface{}
+		var executed []string
+		noLockTime := false
+		bgStub := gostub.Stub(&ExeSqlInBgSes, func(ctx context.Context, _ *Session, sql string) ([]ExecResult, error) {
+			executed = append(executed, sql)
+			mrs := &MysqlResultSet{}
+			col1 := &MysqlColumn{}
+			col1.SetName("status")
+			col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+			mrs.AddColumn(col1)
+			if sql == getSqlForStatusOfUser(5) {
+				mrs.AddRow(row[:1])
+				return []ExecResult{mrs}, nil
+			}
+			convey.So(sql, convey.ShouldEqual, getSqlForCheckAccountLockOfUser(5))
+			if noLockTime {
+				return nil, moerr.NewInvalidInput(ctx, "column lock_time does not exist")
+			}
+			col2 := &MysqlColumn{}
+			col2.SetName("lock_time is null")
+			col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+			mrs.AddColumn(col2)
+			mrs.AddRow(row)
+			return []ExecResult{mrs}, nil
+		})
+		defer bgStub.Reset()
+
+		//locked by the account lock
+		row = []interface{}{userStatusLock, true}
+		convey.So(checkAccountLockOfUser(ctx, ses, u1, 5), convey.ShouldNotBeNil)
+
+		//locked by the failed logins. it is checked by the login lock
+		row = []interface{}{userStatusLock, false}
+		convey.So(checkAccountLockOfUser(ctx, ses, u1, 5), convey.ShouldBeNil)
+
+		//the lock_time is not read for the unlocked user
+		executed = nil
+		row = []interface{}{userStatusUnlock, true}
+		convey.So(checkAccountLockOfUser(ctx, ses, u1, 5), convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldResemble, []string{getSqlForStatusOfUser(5)})
+
+		//the account has not been upgraded to have the lock_time
+		noLockTime = true
+		row = []interface{}{userStatusLock, true}
+		convey.So(checkAccountLockOfUser(ctx, ses, u1, 5), convey.ShouldBeNil)
+	})
+}
+
+func Test_doAlterAccount(t *testing.T) {
+	alterAcountFromStmt := func(stmt *tree.AlterAccount) *alterAccount {
+		aa := &alterAccount{
+			IfExists:     stmt.IfExists,
+			AuthExist:    stmt.AuthOption.Exist,
+			StatusOption: stmt.StatusOption,
+			Comment:      stmt.Comment,
+		}
+		aa.Name = mustUnboxExprStr(stmt.Name)
+		if stmt.AuthOption.Exist {
+			aa.AdminName = mustUnboxExprStr(stmt.AuthOption.AdminName)
+			aa.IdentTyp = stmt.AuthOption.IdentifiedType.Typ
+			aa.IdentStr = mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str)
+		}
+		return aa
+	}
+
+	convey.Convey("alter account (auth_option) succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			Name: boxExprStr("acc"),
+			AuthOption: tree.AlterAccountAuthOption{
+				Exist:     true,
+				AdminName: boxExprStr("rootx"),
+				IdentifiedType: tree.AccountIdentified{
+					Typ: tree.AccountIdentifiedByPassword,
+					Str: boxExprStr("111"),
+				},
+			},
+		}
 		priv := determinePrivilegeSetOfStatement(stmt)
 		ses := newSes(priv, ctrl)
 
@@ -7511,334 +10513,2252 @@ func Test_doDropAccount(t *testing.T) {
 		bh.sql2result["rollback;"] = nil
 
 		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
-		mrs := newMrsForCheckTenant([][]interface{}{})
+		mrs := newMrsForCheckTenant([][]interface{}{
+			{0, 0, 0, 0},
+		})
 		bh.sql2result[sql] = mrs
 
-		sql, _ = getSqlForDeleteAccountFromMoAccount(context.TODO(), mustUnboxExprStr(stmt.Name))
-		bh.sql2result[sql] = nil
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{10, "111", 0},
+		})
 
-		for _, sql = range getSqlForDropAccount() {
-			bh.sql2result[sql] = nil
-		}
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
 
-		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{
-			IfExists: stmt.IfExists,
-			Name:     mustUnboxExprStr(stmt.Name),
-		})
-		convey.So(err, convey.ShouldBeError)
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldBeNil)
 	})
-}
 
-func generateGrantPrivilege(grant, to string, exists bool, roleNames []string, withGrantOption bool) {
-	names := ""
-	for i, name := range roleNames {
-		if i > 0 {
-			names += ","
-		}
-		names += name
-	}
-	levels := make(map[objectType][]privilegeLevelType)
-	levels[objectTypeTable] = []privilegeLevelType{
-		privilegeLevelStar,
-		privilegeLevelStarStar,
-		privilegeLevelDatabaseStar,
-		privilegeLevelDatabaseTable,
-		privilegeLevelTable,
-	}
-	levels[objectTypeDatabase] = []privilegeLevelType{
-		privilegeLevelStar,
-		privilegeLevelStarStar,
-		privilegeLevelDatabase,
-	}
-	levels[objectTypeAccount] = []privilegeLevelType{
-		privilegeLevelStar,
-	}
-	for i := PrivilegeTypeCreateAccount; i <= PrivilegeTypeExecute; i++ {
-		switch i {
-		case PrivilegeTypeCreateObject, PrivilegeTypeDropObject, PrivilegeTypeAlterObject:
-			continue
-		}
-		for j := objectTypeDatabase; j <= objectTypeAccount; j++ {
-			switch i.Scope() {
-			case PrivilegeScopeSys, PrivilegeScopeAccount, PrivilegeScopeUser, PrivilegeScopeRole:
-				if j != objectTypeAccount {
-					continue
-				}
-			case PrivilegeScopeDatabase:
-				if j != objectTypeDatabase {
-					continue
-				}
-			case PrivilegeScopeTable:
-				if j != objectTypeTable {
-					continue
-				}
-			case PrivilegeScopeRoutine:
-				if j != objectTypeFunction {
-					continue
-				}
-			}
-			if j == objectTypeFunction {
-				continue
-			}
+	convey.Convey("alter account (auth_option) failed (wrong identifiedBy)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-			for _, k := range levels[j] {
-				bb := bytes.Buffer{}
-				bb.WriteString(grant)
-				if exists {
-					bb.WriteString(" ")
-					bb.WriteString("if exists")
-				}
+		bh := &backgroundExecTest{}
+		bh.init()
 
-				bb.WriteString(" ")
-				s := fmt.Sprintf("%v on %v %v", i, j, k)
-				bb.WriteString(s)
-				bb.WriteString(" ")
-				bb.WriteString(to)
-				bb.WriteString(" ")
-				bb.WriteString(names)
-				if withGrantOption {
-					bb.WriteString(" with grant option")
-				}
-				bb.WriteString(";")
-				convey.So(len(s) != 0, convey.ShouldBeTrue)
-				//fmt.Println(bb.String())
-			}
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			Name: boxExprStr("acc"),
+			AuthOption: tree.AlterAccountAuthOption{
+				Exist:     true,
+				AdminName: boxExprStr("rootx"),
+				IdentifiedType: tree.AccountIdentified{
+					Typ: tree.AccountIdentifiedByRandomPassword,
+					Str: boxExprStr("111"),
+				},
+			},
 		}
-	}
-}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
 
-func Test_generateGrantPrivilege(t *testing.T) {
-	convey.Convey("grant privilege combination", t, func() {
-		generateGrantPrivilege("grant", "to", false, []string{"role_r1"}, false)
-	})
-}
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
 
-func Test_generateRevokePrivilege(t *testing.T) {
-	convey.Convey("grant privilege combination", t, func() {
-		generateGrantPrivilege("revoke", "from", true, []string{"role_r1", "rx"}, false)
-		generateGrantPrivilege("revoke", "from", false, []string{"role_r1", "rx"}, false)
-	})
-}
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
 
-func Test_Name(t *testing.T) {
-	convey.Convey("test", t, func() {
-		type arg struct {
-			input string
-			want  string
-		}
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
 
-		args := []arg{
-			{" abc ", "abc"},
-		}
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{
+			{0, 0, 0, 0},
+		})
+		bh.sql2result[sql] = mrs
 
-		for _, a := range args {
-			ret, _ := normalizeName(context.TODO(), a.input)
-			convey.So(ret == a.want, convey.ShouldBeTrue)
-		}
-	})
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{10, "111", 0},
+		})
 
-	convey.Convey("test2", t, func() {
-		type arg struct {
-			input string
-			want  bool
-		}
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
 
-		args := []arg{
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter account (auth_option) failed (no account)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			Name: boxExprStr("acc"),
+			AuthOption: tree.AlterAccountAuthOption{
+				Exist:     true,
+				AdminName: boxExprStr("rootx"),
+				IdentifiedType: tree.AccountIdentified{
+					Typ: tree.AccountIdentifiedByRandomPassword,
+					Str: boxExprStr("111"),
+				},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter account (auth_option) succ (no account, if exists)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			IfExists: true,
+			Name:     boxExprStr("acc"),
+			AuthOption: tree.AlterAccountAuthOption{
+				Exist:     true,
+				AdminName: boxExprStr("rootx"),
+				IdentifiedType: tree.AccountIdentified{
+					Typ: tree.AccountIdentifiedByPassword,
+					Str: boxExprStr("111"),
+				},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	convey.Convey("alter account (auth_option) failed (has account,if exists, no user)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			IfExists: true,
+			Name:     boxExprStr("acc"),
+			AuthOption: tree.AlterAccountAuthOption{
+				Exist:     true,
+				AdminName: boxExprStr("rootx"),
+				IdentifiedType: tree.AccountIdentified{
+					Typ: tree.AccountIdentifiedByPassword,
+					Str: boxExprStr("111"),
+				},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{
+			{0, "0", "open", 0},
+		})
+		bh.sql2result[sql] = mrs
+
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
+
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{0, 0, 0, 0},
+		})
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter account (auth_option) failed (no option)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			IfExists: true,
+			Name:     boxExprStr("acc"),
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter account (auth_option) failed (two options)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			IfExists: true,
+			Name:     boxExprStr("acc"),
+			AuthOption: tree.AlterAccountAuthOption{
+				Exist:     true,
+				AdminName: boxExprStr("rootx"),
+				IdentifiedType: tree.AccountIdentified{
+					Typ: tree.AccountIdentifiedByPassword,
+					Str: boxExprStr("111"),
+				},
+			},
+			StatusOption: tree.AccountStatus{
+				Exist:  true,
+				Option: tree.AccountStatusOpen,
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter account (auth_option) succ Comments", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			Name: boxExprStr("acc"),
+			Comment: tree.AccountComment{
+				Exist:   true,
+				Comment: "new account",
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{
+			{0, 0, 0, 0},
+		})
+		bh.sql2result[sql] = mrs
+
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForUpdateCommentsOfAccount(context.TODO(), stmt.Comment.Comment, mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	convey.Convey("alter account (auth_option) succ Status", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			Name: boxExprStr("acc"),
+			StatusOption: tree.AccountStatus{
+				Exist:  true,
+				Option: tree.AccountStatusSuspend,
+				Reason: "overdue",
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{
+			{0, 0, 0, 0},
+		})
+		bh.sql2result[sql] = mrs
+
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForUpdateStatusOfAccount(context.TODO(), stmt.StatusOption.Option.String(), types.CurrentTimestamp().String2(time.UTC, 0), mustUnboxExprStr(stmt.Name), int64(ses.GetTenantInfo().GetUserID()), stmt.StatusOption.Reason)
+		bh.sql2result[sql] = nil
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	convey.Convey("alter account (status_option) fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterAccount{
+			Name: boxExprStr("sys"),
+			StatusOption: tree.AccountStatus{
+				Exist:  true,
+				Option: tree.AccountStatusSuspend,
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		bh.sql2result[sql] = nil
+
+		sql, _ = getSqlForUpdateStatusOfAccount(context.TODO(), stmt.StatusOption.Option.String(), types.CurrentTimestamp().String2(time.UTC, 0), mustUnboxExprStr(stmt.Name), int64(ses.GetTenantInfo().GetUserID()), stmt.StatusOption.Reason)
+		bh.sql2result[sql] = nil
+
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter account rename to", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.AlterAccount{
+			Name:     boxExprStr("acc"),
+			RenameTo: "acc2",
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "acc")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{5, "acc", "open", 0},
+		})
+		sql, _ = getSqlForCheckTenant(context.TODO(), "acc2")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		aa := alterAcountFromStmt(stmt)
+		aa.RenameTo = stmt.RenameTo
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldBeNil)
+		sql, _ = getSqlForUpdateNameOfAccount(context.TODO(), "acc", "acc2")
+		convey.So(executed, convey.ShouldContain, sql)
+		sql, _ = getSqlForUpdateAccountNameOfMysqlCompatibilityMode(context.TODO(), "acc", "acc2")
+		convey.So(executed, convey.ShouldContain, sql)
+		convey.So(executed, convey.ShouldContain, "commit;")
+
+		//the new name exists
+		sql, _ = getSqlForCheckTenant(context.TODO(), "acc2")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{6, "acc2", "open", 0},
+		})
+		executed = nil
+		aa = alterAcountFromStmt(stmt)
+		aa.RenameTo = stmt.RenameTo
+		err = doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldNotBeNil)
+		sql, _ = getSqlForUpdateNameOfAccount(context.TODO(), "acc", "acc2")
+		convey.So(executed, convey.ShouldNotContain, sql)
+
+		//the new name is invalid
+		aa = alterAcountFromStmt(stmt)
+		aa.RenameTo = "acc:2"
+		err = doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the sys account can not be renamed
+		aa = alterAcountFromStmt(stmt)
+		aa.Name = sysAccountName
+		aa.RenameTo = stmt.RenameTo
+		err = doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//only one option at a time
+		aa = alterAcountFromStmt(stmt)
+		aa.RenameTo = stmt.RenameTo
+		aa.Comment = tree.AccountComment{Exist: true, Comment: "new name"}
+		err = doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter account set quota", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.AlterAccount{
+			Name: boxExprStr("acc"),
+			Quotas: tree.AccountQuotas{
+				{Name: "MAX_DATABASES", Value: 10},
+				{Name: "max_connections", Unlimited: true},
+			},
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "acc")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{5, "acc", "open", 0},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		aa := alterAcountFromStmt(stmt)
+		aa.Quotas = stmt.Quotas
+		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, `update mo_catalog.mo_account set max_databases = 10, max_connections = NULL where account_name = "acc" order by account_id;`)
+		convey.So(executed, convey.ShouldContain, "commit;")
+
+		//the unsupported quota
+		aa = alterAcountFromStmt(stmt)
+		aa.Quotas = tree.AccountQuotas{{Name: "max_users", Value: 10}}
+		err = doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the quota is set twice
+		aa = alterAcountFromStmt(stmt)
+		aa.Quotas = tree.AccountQuotas{{Name: "max_tables", Value: 10}, {Name: "max_tables", Unlimited: true}}
+		err = doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the sys account is unlimited
+		aa = alterAcountFromStmt(stmt)
+		aa.Name = sysAccountName
+		aa.Quotas = tree.AccountQuotas{{Name: "max_tables", Value: 10}}
+		err = doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, aa)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func newMrsForQuotaOfAccount(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"max_databases", "max_tables", "max_connections"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_getSqlForUpdateStatusOfAccount(t *testing.T) {
+	convey.Convey("record who suspends the account and why", t, func() {
+		sql, err := getSqlForUpdateStatusOfAccount(context.TODO(), tree.AccountStatusSuspend.String(), "2024-01-01 00:00:00", "acc", 5, "overdue")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(sql, convey.ShouldEqual, `update mo_catalog.mo_account set status = "suspend",suspended_time = "2024-01-01 00:00:00",suspended_by = 5,suspend_reason = "overdue" where account_name = "acc" order by account_id;;`)
+
+		_, err = getSqlForUpdateStatusOfAccount(context.TODO(), tree.AccountStatusSuspend.String(), "2024-01-01 00:00:00", "a c", 5, "")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("clear the records of the suspension when the account is opened", t, func() {
+		sql, err := getSqlForUpdateStatusAndVersionOfAccount(context.TODO(), tree.AccountStatusOpen.String(), "acc", 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(sql, convey.ShouldEqual, `update mo_catalog.mo_account set status = "open",version = 2,suspended_time = default,suspended_by = default,suspend_reason = default where account_name = "acc";`)
+	})
+}
+
+func Test_checkQuotaOfAccountForStatement(t *testing.T) {
+	convey.Convey("check the quota of the account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(&TenantInfo{Tenant: "acc", User: "u1", TenantID: 5, UserID: 5})
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForCountOfDatabasesOfAccount(5)] = newMrsForCount([][]interface{}{{2}})
+		sql2result[getSqlForCountOfTablesOfAccount(5)] = newMrsForCount([][]interface{}{{3}})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		createDb := &tree.CreateDatabase{Name: "db1"}
+		createTable := &tree.CreateTable{}
+		createTable.Table = *tree.NewTableName("t1", tree.ObjectNamePrefix{SchemaName: "db1", ExplicitSchema: true}, nil)
+
+		//NULL means unlimited
+		sql2result[getSqlForQuotaOfAccount(5)] = newMrsForQuotaOfAccount([][]interface{}{{-1, -1, -1}})
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createDb), convey.ShouldBeNil)
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createTable), convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldNotContain, getSqlForCountOfDatabasesOfAccount(5))
+
+		//under the quota
+		sql2result[getSqlForQuotaOfAccount(5)] = newMrsForQuotaOfAccount([][]interface{}{{3, 4, -1}})
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createDb), convey.ShouldBeNil)
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createTable), convey.ShouldBeNil)
+
+		//reach the quota
+		sql2result[getSqlForQuotaOfAccount(5)] = newMrsForQuotaOfAccount([][]interface{}{{2, 3, -1}})
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createDb), convey.ShouldNotBeNil)
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createTable), convey.ShouldNotBeNil)
+
+		//the existing database with IF NOT EXISTS does not consume the quota
+		sql, _ := getSqlForCheckDatabase(ctx, "db1")
+		sql2result[sql] = newMrsForCount([][]interface{}{{1}})
+		createDb.IfNotExists = true
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createDb), convey.ShouldBeNil)
+
+		//the sys account is unlimited
+		ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: rootName, TenantID: sysAccountID})
+		executed = nil
+		convey.So(checkQuotaOfAccountForStatement(ctx, ses, createTable), convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldBeEmpty)
+	})
+}
+
+func newMrsForParentOfAccount(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("account_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("admin_name")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("parent_account_id")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func Test_childAccount(t *testing.T) {
+	//p1 and p2 are the parents. c1 and c2 are the children of the p1.
+	makeSql2Result := func() map[string]ExecResult {
+		sql2result := make(map[string]ExecResult)
+		for _, row := range [][]interface{}{
+			{5, "p1", "admin1", -1},
+			{6, "c1", "admin2", 5},
+			{7, "c2", "admin3", 5},
+			{8, "p2", "admin4", -1},
+		} {
+			sql, _ := getSqlForParentOfAccount(context.TODO(), row[1].(string))
+			sql2result[sql] = newMrsForParentOfAccount([][]interface{}{
+				{row[0], row[2], row[3]},
+			})
+			sql, _ = getSqlForCheckTenant(context.TODO(), row[1].(string))
+			sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+				{row[0], row[1], tree.AccountStatusOpen.String(), 0},
+			})
+		}
+		return sql2result
+	}
+	adminOf := func(account string, accountId uint32) *TenantInfo {
+		return &TenantInfo{
+			Tenant:        account,
+			User:          "admin",
+			DefaultRole:   accountAdminRoleName,
+			TenantID:      accountId,
+			UserID:        GetAdminUserId(),
+			DefaultRoleID: accountAdminRoleID,
+			delimiter:     ':',
+		}
+	}
+
+	convey.Convey("the admin of the parent account manages the child", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := newBh(ctrl, makeSql2Result())
+		ctx := context.TODO()
+
+		//the child
+		childId, childAdmin, ok, err := isChildOfAccount(ctx, bh, adminOf("p1", 5), "c1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(childId, convey.ShouldEqual, int64(6))
+		convey.So(childAdmin, convey.ShouldEqual, "admin2")
+
+		//the other parent
+		_, _, ok, err = isChildOfAccount(ctx, bh, adminOf("p1", 5), "p2")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		//the sibling and the parent of the child
+		for _, account := range []string{"c2", "p1"} {
+			_, _, ok, err = isChildOfAccount(ctx, bh, adminOf("c1", 6), account)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeFalse)
+		}
+
+		//not the admin
+		nonAdmin := adminOf("p1", 5)
+		nonAdmin.SetDefaultRole("r1")
+		_, _, ok, err = isChildOfAccount(ctx, bh, nonAdmin, "c1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+
+	convey.Convey("the admin of the parent account suspends the child", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, makeSql2Result(), &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(adminOf("p1", 5))
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		suspend := func(account string) error {
+			return doAlterAccount(ctx, ses, &alterAccount{
+				Name: account,
+				StatusOption: tree.AccountStatus{
+					Exist:  true,
+					Option: tree.AccountStatusSuspend,
+				},
+			})
+		}
+
+		//the child is allowed
+		err := suspend("c1")
+		convey.So(err, convey.ShouldBeNil)
+		suspended := false
+		for _, sqlx := range executed {
+			if strings.HasPrefix(sqlx, "update mo_catalog.mo_account set status") {
+				suspended = true
+				convey.So(sqlx, convey.ShouldContainSubstring, `account_name = "c1"`)
+			}
+		}
+		convey.So(suspended, convey.ShouldBeTrue)
+
+		//the sibling, the other parent and itself are denied
+		for _, account := range []string{"p2", "p1"} {
+			executed = nil
+			err = suspend(account)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(executed, convey.ShouldContain, "rollback;")
+			for _, sqlx := range executed {
+				convey.So(sqlx, convey.ShouldNotStartWith, "update mo_catalog.mo_account")
+			}
+		}
+
+		ses.SetTenantInfo(adminOf("c1", 6))
+		for _, account := range []string{"c2", "p1"} {
+			err = suspend(account)
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+
+		//only the status of the child can be changed
+		ses.SetTenantInfo(adminOf("p1", 5))
+		err = doAlterAccount(ctx, ses, &alterAccount{
+			Name:    "c1",
+			Comment: tree.AccountComment{Exist: true, Comment: "child"},
+		})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("set the parent of the account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sql2result := makeSql2Result()
+		sql2result[getSqlForCountOfChildrenOfAccount(8)] = newMrsForCount([][]interface{}{
+			{0},
+		})
+		sql2result[getSqlForCountOfChildrenOfAccount(5)] = newMrsForCount([][]interface{}{
+			{2},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ses := newSes(nil, ctrl)
+		ctx := context.TODO()
+
+		err := doSetParentOfAccount(ctx, ses, "p2", "p1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateParentOfAccount(5, 8))
+
+		//at most two tiers
+		convey.So(doSetParentOfAccount(ctx, ses, "p2", "c1"), convey.ShouldNotBeNil)
+		convey.So(doSetParentOfAccount(ctx, ses, "p1", "p2"), convey.ShouldNotBeNil)
+		convey.So(doSetParentOfAccount(ctx, ses, "c1", "p2"), convey.ShouldNotBeNil)
+		convey.So(doSetParentOfAccount(ctx, ses, "p1", "p1"), convey.ShouldNotBeNil)
+		convey.So(doSetParentOfAccount(ctx, ses, sysAccountName, "p1"), convey.ShouldNotBeNil)
+
+		//only the sys account
+		ses.SetTenantInfo(adminOf("p1", 5))
+		convey.So(doSetParentOfAccount(ctx, ses, "p2", "p1"), convey.ShouldNotBeNil)
+	})
+}
+
+func newMrsForShowTables(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col2 := &MysqlColumn{}
+	col2.SetName("table")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func Test_doDropAccount(t *testing.T) {
+	convey.Convey("drop account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.DropAccount{
+			Name: boxExprStr("acc"),
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{
+			{0, "0", "open", 0},
+		})
+		bh.sql2result[sql] = mrs
+
+		sql, _ = getSqlForDeleteAccountFromMoAccount(context.TODO(), mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+
+		for _, sql = range getSqlForDropAccount() {
+			bh.sql2result[sql] = nil
+		}
+
+		sql = "show databases;"
+		bh.sql2result[sql] = newMrsForSqlForShowDatabases([][]interface{}{})
+
+		bh.sql2result["show tables from mo_catalog;"] = newMrsForShowTables([][]interface{}{})
+
+		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{
+			IfExists: stmt.IfExists,
+			Name:     mustUnboxExprStr(stmt.Name),
+		})
+		convey.So(err, convey.ShouldBeNil)
+	})
+	convey.Convey("drop account (if exists)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.DropAccount{
+			IfExists: true,
+			Name:     boxExprStr("acc"),
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		sql, _ = getSqlForDeleteAccountFromMoAccount(context.TODO(), mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+
+		for _, sql = range getSqlForDropAccount() {
+			bh.sql2result[sql] = nil
+		}
+
+		bh.sql2result["show tables from mo_catalog;"] = newMrsForShowTables([][]interface{}{})
+
+		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{
+			IfExists: stmt.IfExists,
+			Name:     mustUnboxExprStr(stmt.Name),
+		})
+		convey.So(err, convey.ShouldBeNil)
+	})
+	convey.Convey("drop account fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.DropAccount{
+			Name: boxExprStr("acc"),
+		}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckTenant(context.TODO(), mustUnboxExprStr(stmt.Name))
+		mrs := newMrsForCheckTenant([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		sql, _ = getSqlForDeleteAccountFromMoAccount(context.TODO(), mustUnboxExprStr(stmt.Name))
+		bh.sql2result[sql] = nil
+
+		for _, sql = range getSqlForDropAccount() {
+			bh.sql2result[sql] = nil
+		}
+
+		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{
+			IfExists: stmt.IfExists,
+			Name:     mustUnboxExprStr(stmt.Name),
+		})
+		convey.So(err, convey.ShouldBeError)
+	})
+
+	convey.Convey("drop account with the corrupt database", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "acc")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{5, "acc", "open", 0},
+		})
+		sql2result["show databases;"] = newMrsForSqlForShowDatabases([][]interface{}{
+			{"db1"},
+			{"db2"},
+		})
+		sql2result["show tables from mo_catalog;"] = newMrsForShowTables([][]interface{}{})
+		deleteAccountSql, _ := getSqlForDeleteAccountFromMoAccount(context.TODO(), "acc")
+
+		//the db1 is corrupt
+		var executed []string
+		var currentSql string
+		var mu sync.Mutex
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			//the databases are dropped concurrently
+			mu.Lock()
+			defer mu.Unlock()
+			currentSql = sql
+			executed = append(executed, sql)
+			if sql == "drop database if exists `db1`;" {
+				return moerr.NewInternalErrorNoCtx("db1 is corrupt")
+			}
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			mu.Lock()
+			defer mu.Unlock()
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		//fail fast without the force
+		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{Name: "acc"})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldContain, "rollback;")
+		convey.So(executed, convey.ShouldNotContain, deleteAccountSql)
+		//nothing is committed. the account and its databases are kept.
+		convey.So(executed, convey.ShouldNotContain, "commit;")
+		begins := 0
+		for _, s := range executed {
+			if s == "begin;" {
+				begins++
+			}
+		}
+		convey.So(begins, convey.ShouldEqual, 1)
+
+		//go on dropping with the force
+		executed = nil
+		err = doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{Name: "acc", Force: true})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the account acc has been dropped with errors")
+		convey.So(err.Error(), convey.ShouldContainSubstring, "db1 is corrupt")
+		convey.So(executed, convey.ShouldContain, "drop database if exists `db2`;")
+		convey.So(executed, convey.ShouldContain, deleteAccountSql)
+		convey.So(executed, convey.ShouldContain, "commit;")
+	})
+
+	convey.Convey("drop account with many databases", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "acc")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{5, "acc", "open", 0},
+		})
+		dbCount := dropAccountDatabaseWorkers * 3
+		dbs := make([][]interface{}, 0, dbCount+1)
+		dbs = append(dbs, []interface{}{"mo_catalog"})
+		for i := 0; i < dbCount; i++ {
+			dbs = append(dbs, []interface{}{fmt.Sprintf("db%d", i)})
+		}
+		sql2result["show databases;"] = newMrsForSqlForShowDatabases(dbs)
+		sql2result["show tables from mo_catalog;"] = newMrsForShowTables([][]interface{}{})
+		deleteAccountSql, _ := getSqlForDeleteAccountFromMoAccount(context.TODO(), "acc")
+
+		var executed []string
+		var currentSql string
+		var mu sync.Mutex
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			currentSql = sql
+			executed = append(executed, sql)
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			mu.Lock()
+			defer mu.Unlock()
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		err := doDropAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{Name: "acc", Force: true})
+		convey.So(err, convey.ShouldBeNil)
+
+		//every database is dropped in its own transaction
+		begins := 0
+		for _, s := range executed {
+			if s == "begin;" {
+				begins++
+			}
+		}
+		convey.So(begins, convey.ShouldEqual, dbCount+1)
+		for i := 0; i < dbCount; i++ {
+			convey.So(executed, convey.ShouldContain, fmt.Sprintf("drop database if exists `db%d`;", i))
+		}
+		convey.So(executed, convey.ShouldNotContain, "drop database if exists `mo_catalog`;")
+		convey.So(executed[len(executed)-1], convey.ShouldEqual, "commit;")
+		convey.So(executed, convey.ShouldContain, deleteAccountSql)
+	})
+}
+
+func Test_dropDatabasesConcurrently(t *testing.T) {
+	convey.Convey("drop the databases concurrently in the force mode", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		sqls := []string{
+			"drop database if exists `a`;",
+			"drop database if exists `b`;",
+			"drop database if exists `c`;",
+		}
+
+		var mu sync.Mutex
+		var executed []string
+		created := 0
+		bhStub := gostub.Stub(&NewBackgroundExec, func(context.Context, FeSession) BackgroundExec {
+			//the executors are created by the caller goroutine only
+			created++
+			bh := mock_frontend.NewMockBackgroundExec(ctrl)
+			bh.EXPECT().Close().Return().Times(1)
+			bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+				mu.Lock()
+				defer mu.Unlock()
+				executed = append(executed, sql)
+				if sql == sqls[1] {
+					return moerr.NewInternalErrorNoCtx("drop b failed")
+				}
+				return nil
+			}).AnyTimes()
+			return bh
+		})
+		defer bhStub.Reset()
+
+		workersStub := gostub.Stub(&dropAccountDatabaseWorkers, 2)
+		defer workersStub.Reset()
+
+		var failed []string
+		dropDatabasesConcurrently(ses.GetTxnHandler().GetTxnCtx(), ses, sqls,
+			func(sql string, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				failed = append(failed, sql)
+			})
+		convey.So(created, convey.ShouldEqual, len(sqls))
+		convey.So(failed, convey.ShouldResemble, []string{sqls[1]})
+		for _, sql := range sqls {
+			convey.So(executed, convey.ShouldContain, sql)
+		}
+		convey.So(executed, convey.ShouldContain, "rollback;")
+	})
+}
+
+func Test_getDroppedObjectsOfAccount(t *testing.T) {
+	convey.Convey("dry run of the drop account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "acc")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{5, "acc", "open", 0},
+		})
+		sql2result["show databases;"] = newMrsForSqlForShowDatabases([][]interface{}{
+			{"db2"},
+			{"mo_catalog"},
+			{"db1"},
+		})
+		sql2result[getPubsSql] = newMrsForStrings([]string{"pub_name"}, [][]interface{}{
+			{"pub1"},
+		})
+		sql2result["show tables from mo_catalog;"] = newMrsForShowTables([][]interface{}{
+			{"mo_user"},
+			{"ct1"},
+		})
+		sql2result[getSqlForCountOfAccountInClusterTable("ct1", 5)] = newMrsForCount([][]interface{}{
+			{int64(10)},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		objs, err := getDroppedObjectsOfAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{Name: "acc", DryRun: true})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(objs, convey.ShouldResemble, []droppedObjectOfAccount{
+			{objType: dropAccountObjectDatabase, name: "db1"},
+			{objType: dropAccountObjectDatabase, name: "db2"},
+			{objType: dropAccountObjectPublication, name: "pub1"},
+			{objType: dropAccountObjectClusterTable, name: "ct1", rows: 10},
+		})
+
+		//nothing is dropped or deleted
+		for _, s := range executed {
+			convey.So(strings.HasPrefix(s, "drop"), convey.ShouldBeFalse)
+			convey.So(strings.HasPrefix(s, "delete"), convey.ShouldBeFalse)
+		}
+	})
+
+	convey.Convey("dry run of the drop account that does not exist", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "acc")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{})
+
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		_, err := getDroppedObjectsOfAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{Name: "acc", DryRun: true})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		objs, err := getDroppedObjectsOfAccount(ses.GetTxnHandler().GetTxnCtx(), ses, &dropAccount{Name: "acc", IfExists: true, DryRun: true})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(objs, convey.ShouldBeEmpty)
+	})
+}
+
+func generateGrantPrivilege(grant, to string, exists bool, roleNames []string, withGrantOption bool) {
+	names := ""
+	for i, name := range roleNames {
+		if i > 0 {
+			names += ","
+		}
+		names += name
+	}
+	levels := make(map[objectType][]privilegeLevelType)
+	levels[objectTypeTable] = []privilegeLevelType{
+		privilegeLevelStar,
+		privilegeLevelStarStar,
+		privilegeLevelDatabaseStar,
+		privilegeLevelDatabaseTable,
+		privilegeLevelTable,
+	}
+	levels[objectTypeDatabase] = []privilegeLevelType{
+		privilegeLevelStar,
+		privilegeLevelStarStar,
+		privilegeLevelDatabase,
+	}
+	levels[objectTypeAccount] = []privilegeLevelType{
+		privilegeLevelStar,
+	}
+	for i := PrivilegeTypeCreateAccount; i <= PrivilegeTypeExecute; i++ {
+		switch i {
+		case PrivilegeTypeCreateObject, PrivilegeTypeDropObject, PrivilegeTypeAlterObject:
+			continue
+		}
+		for j := objectTypeDatabase; j <= objectTypeAccount; j++ {
+			switch i.Scope() {
+			case PrivilegeScopeSys, PrivilegeScopeAccount, PrivilegeScopeUser, PrivilegeScopeRole:
+				if j != objectTypeAccount {
+					continue
+				}
+			case PrivilegeScopeDatabase:
+				if j != objectTypeDatabase {
+					continue
+				}
+			case PrivilegeScopeTable:
+				if j != objectTypeTable {
+					continue
+				}
+			case PrivilegeScopeRoutine:
+				if j != objectTypeFunction {
+					continue
+				}
+			}
+			if j == objectTypeFunction {
+				continue
+			}
+
+			for _, k := range levels[j] {
+				bb := bytes.Buffer{}
+				bb.WriteString(grant)
+				if exists {
+					bb.WriteString(" ")
+					bb.WriteString("if exists")
+				}
+
+				bb.WriteString(" ")
+				s := fmt.Sprintf("%v on %v %v", i, j, k)
+				bb.WriteString(s)
+				bb.WriteString(" ")
+				bb.WriteString(to)
+				bb.WriteString(" ")
+				bb.WriteString(names)
+				if withGrantOption {
+					bb.WriteString(" with grant option")
+				}
+				bb.WriteString(";")
+				convey.So(len(s) != 0, convey.ShouldBeTrue)
+				//fmt.Println(bb.String())
+			}
+		}
+	}
+}
+
+func Test_generateGrantPrivilege(t *testing.T) {
+	convey.Convey("grant privilege combination", t, func() {
+		generateGrantPrivilege("grant", "to", false, []string{"role_r1"}, false)
+	})
+}
+
+func Test_generateRevokePrivilege(t *testing.T) {
+	convey.Convey("grant privilege combination", t, func() {
+		generateGrantPrivilege("revoke", "from", true, []string{"role_r1", "rx"}, false)
+		generateGrantPrivilege("revoke", "from", false, []string{"role_r1", "rx"}, false)
+	})
+}
+
+func Test_Name(t *testing.T) {
+	convey.Convey("test", t, func() {
+		type arg struct {
+			input string
+			want  string
+		}
+
+		args := []arg{
+			{" abc ", "abc"},
+		}
+
+		for _, a := range args {
+			ret, _ := normalizeName(context.TODO(), a.input)
+			convey.So(ret == a.want, convey.ShouldBeTrue)
+		}
+	})
+
+	convey.Convey("test2", t, func() {
+		type arg struct {
+			input string
+			want  bool
+		}
+
+		args := []arg{
 			{"abc", false},
 			{"a:bc", true},
 			{"  a:bc  ", true},
 		}
 
-		for _, a := range args {
-			ret := nameIsInvalid(a.input)
-			convey.So(ret == a.want, convey.ShouldBeTrue)
-		}
-	})
+		for _, a := range args {
+			ret := nameIsInvalid(a.input)
+			convey.So(ret == a.want, convey.ShouldBeTrue)
+		}
+	})
+}
+
+func Test_normalizeNameByConfiguration(t *testing.T) {
+	setNameNormalization := func(caseFold, unicodeNormalization bool) {
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		pu.SV.NameCaseFold = caseFold
+		pu.SV.NameUnicodeNormalization = unicodeNormalization
+		setGlobalPu(pu)
+	}
+	defer setNameNormalization(false, false)
+
+	//the e with the combining acute accent and the precomposed one
+	decomposed := "Cafe\u0301"
+	composed := "Caf\u00e9"
+
+	normalize := func(name string) []string {
+		ret := make([]string, 0, 3)
+		n, err := normalizeName(context.TODO(), name)
+		convey.So(err, convey.ShouldBeNil)
+		ret = append(ret, n)
+
+		role := &tree.Role{UserName: name}
+		convey.So(normalizeNameOfRole(context.TODO(), role), convey.ShouldBeNil)
+		ret = append(ret, role.UserName)
+
+		user := &tree.User{Username: name}
+		convey.So(normalizeNameOfUser(context.TODO(), user), convey.ShouldBeNil)
+		ret = append(ret, user.Username)
+
+		return ret
+	}
+	repeat := func(name string) []string {
+		return []string{name, name, name}
+	}
+	//the name of the account is restricted to the ascii letters
+	normalizeAccount := func(name string) string {
+		ca := &createAccount{Name: name}
+		convey.So(normalizeNameOfAccount(context.TODO(), ca), convey.ShouldBeNil)
+		return ca.Name
+	}
+
+	convey.Convey("the names are only trimmed by default", t, func() {
+		setNameNormalization(false, false)
+		convey.So(normalize(" Abc "), convey.ShouldResemble, repeat("Abc"))
+		convey.So(normalize(decomposed), convey.ShouldResemble, repeat(decomposed))
+		convey.So(normalizeAccount(" Acc1 "), convey.ShouldEqual, "Acc1")
+
+		ti, err := GetTenantInfo(context.TODO(), "Acc1:U1:R1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.String(), convey.ShouldEqual, "{account Acc1:U1:R1 -- 0:0:0}")
+	})
+
+	convey.Convey("the names are lowercased", t, func() {
+		setNameNormalization(true, false)
+		convey.So(normalize(" Abc "), convey.ShouldResemble, repeat("abc"))
+		convey.So(normalizeAccount(" Acc1 "), convey.ShouldEqual, "acc1")
+		convey.So(normalize(decomposed), convey.ShouldResemble, repeat(strings.ToLower(decomposed)))
+
+		ti, err := GetTenantInfo(context.TODO(), "Acc1:U1:R1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.String(), convey.ShouldEqual, "{account acc1:u1:r1 -- 0:0:0}")
+	})
+
+	convey.Convey("the names are in the unicode NFC form", t, func() {
+		setNameNormalization(false, true)
+		convey.So(normalize(" Abc "), convey.ShouldResemble, repeat("Abc"))
+		convey.So(normalize(decomposed), convey.ShouldResemble, repeat(composed))
+
+		ti, err := GetTenantInfo(context.TODO(), "Acc1:"+decomposed)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.GetUser(), convey.ShouldEqual, composed)
+	})
+
+	convey.Convey("the names are lowercased in the unicode NFC form", t, func() {
+		setNameNormalization(true, true)
+		convey.So(normalize(decomposed), convey.ShouldResemble, repeat(strings.ToLower(composed)))
+
+		ti, err := GetTenantInfo(context.TODO(), decomposed)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ti.GetUser(), convey.ShouldEqual, strings.ToLower(composed))
+	})
+}
+
+func genRevokeCases1(A [][]string, path []string, cur int, exists bool, out *[]string) {
+	if cur == len(A) {
+		bb := bytes.Buffer{}
+		bb.WriteString("revoke ")
+		if exists {
+			bb.WriteString("if exists ")
+		}
+		bb.WriteString(path[0])
+		bb.WriteString(",")
+		bb.WriteString(path[1])
+		bb.WriteString(" ")
+		bb.WriteString("from ")
+		bb.WriteString(path[2])
+		bb.WriteString(",")
+		bb.WriteString(path[3])
+		bb.WriteString(";")
+		*out = append(*out, bb.String())
+	} else {
+		for i := 0; i < len(A[cur]); i++ {
+			path[cur] = A[cur][i]
+			genRevokeCases1(A, path, cur+1, exists, out)
+		}
+	}
+}
+
+func Test_genRevokeCases(t *testing.T) {
+	A := [][]string{
+		{"r1", "role_r1"},
+		{"r2", "role_r2"},
+		{"u1", "role_u1"},
+		{"u2", "role_u2"},
+	}
+	Path := []string{"", "", "", ""}
+	Out := []string{}
+	genRevokeCases1(A, Path, 0, true, &Out)
+	genRevokeCases1(A, Path, 0, false, &Out)
+	for _, s := range Out {
+		fmt.Println(s)
+	}
+}
+
+func newSes(priv *privilege, ctrl *gomock.Controller) *Session {
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+	pu.SV.SetDefaultValues()
+	setGlobalPu(pu)
+
+	ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+	ctx = defines.AttachAccountId(ctx, 0)
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+
+	ses := NewSession(ctx, proto, nil)
+	tenant := &TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		UserID:        rootID,
+		DefaultRoleID: moAdminRoleID,
+	}
+	ses.SetTenantInfo(tenant)
+	ses.priv = priv
+
+	stubs := gostub.StubFunc(&ExeSqlInBgSes, nil, nil)
+	defer stubs.Reset()
+
+	_ = ses.InitSystemVariables(ctx)
+
+	rm, _ := NewRoutineManager(ctx)
+	rm.baseService = new(MockBaseService)
+	ses.rm = rm
+
+	return ses
+}
+
+var _ BaseService = &MockBaseService{}
+
+type MockBaseService struct {
+}
+
+func (m *MockBaseService) ID() string {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *MockBaseService) SQLAddress() string {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *MockBaseService) SessionMgr() *queryservice.SessionManager {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *MockBaseService) CheckTenantUpgrade(ctx context.Context, tenantID int64) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m *MockBaseService) GetFinalVersion() string {
+	return "1.2.0"
+}
+
+func (s *MockBaseService) UpgradeTenant(ctx context.Context, tenantName string, retryCount uint32, isALLAccount bool) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func newBh(ctrl *gomock.Controller, sql2result map[string]ExecResult) BackgroundExec {
+	var currentSql string
+	bh := mock_frontend.NewMockBackgroundExec(ctrl)
+	bh.EXPECT().ClearExecResultSet().AnyTimes()
+	bh.EXPECT().Close().Return().AnyTimes()
+	bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+		currentSql = sql
+		return nil
+	}).AnyTimes()
+	bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+		return []interface{}{sql2result[currentSql]}
+	}).AnyTimes()
+	return bh
+}
+
+// newBhWithExecutedSqls is same as the newBh. It also records the executed sqls.
+func newBhWithExecutedSqls(ctrl *gomock.Controller, sql2result map[string]ExecResult, executed *[]string) BackgroundExec {
+	var currentSql string
+	bh := mock_frontend.NewMockBackgroundExec(ctrl)
+	bh.EXPECT().ClearExecResultSet().AnyTimes()
+	bh.EXPECT().Close().Return().AnyTimes()
+	bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+		currentSql = sql
+		*executed = append(*executed, sql)
+		return nil
+	}).AnyTimes()
+	bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+		return []interface{}{sql2result[currentSql]}
+	}).AnyTimes()
+	return bh
+}
+
+type backgroundExecTest struct {
+	currentSql string
+	sql2result map[string]ExecResult
+}
+
+func (bt *backgroundExecTest) ExecStmt(ctx context.Context, statement tree.Statement) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (bt *backgroundExecTest) GetExecResultBatches() []*batch.Batch {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (bt *backgroundExecTest) ClearExecResultBatches() {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (bt *backgroundExecTest) init() {
+	bt.sql2result = make(map[string]ExecResult)
+}
+
+func (bt *backgroundExecTest) Close() {
+}
+
+func (bt *backgroundExecTest) Clear() {}
+
+func (bt *backgroundExecTest) Exec(ctx context.Context, s string) error {
+	bt.currentSql = s
+	return nil
+}
+
+func (bt *backgroundExecTest) ExecRestore(context.Context, string, uint32, uint32) error {
+	panic("unimplement")
+}
+
+func (bt *backgroundExecTest) GetExecResultSet() []interface{} {
+	return []interface{}{bt.sql2result[bt.currentSql]}
+}
+
+func (bt *backgroundExecTest) ClearExecResultSet() {
+	//bt.init()
+}
+
+var _ BackgroundExec = &backgroundExecTest{}
+
+func newMrsForSqlForShowDatabases(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("Database")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForSqlForCheckUserHasRole(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("user_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("role_id")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForRoleIdOfRole(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("role_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForRoleIdOfUserId(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("role_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("with_grant_option")
+	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
 }
 
-func genRevokeCases1(A [][]string, path []string, cur int, exists bool, out *[]string) {
-	if cur == len(A) {
-		bb := bytes.Buffer{}
-		bb.WriteString("revoke ")
-		if exists {
-			bb.WriteString("if exists ")
-		}
-		bb.WriteString(path[0])
-		bb.WriteString(",")
-		bb.WriteString(path[1])
-		bb.WriteString(" ")
-		bb.WriteString("from ")
-		bb.WriteString(path[2])
-		bb.WriteString(",")
-		bb.WriteString(path[3])
-		bb.WriteString(";")
-		*out = append(*out, bb.String())
-	} else {
-		for i := 0; i < len(A[cur]); i++ {
-			path[cur] = A[cur][i]
-			genRevokeCases1(A, path, cur+1, exists, out)
+func newMrsForCheckRoleHasPrivilege(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("role_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("with_grant_option")
+	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForInheritedRoleIdOfRoleId(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("granted_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("with_grant_option")
+	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForGetAllStuffRoleGrant(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("granted_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("grantee_id")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("with_grant_option")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForCheckRoleGrant(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("granted_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("grantee_id")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("with_grant_option")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col4 := &MysqlColumn{}
+	col4.SetName("expiry_time is not null")
+	col4.SetColumnType(defines.MYSQL_TYPE_BOOL)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddColumn(col4)
+
+	for _, row := range rows {
+		//the grant is permanent by default
+		if len(row) == 3 {
+			row = append(row, false)
 		}
+		mrs.AddRow(row)
 	}
+
+	return mrs
 }
 
-func Test_genRevokeCases(t *testing.T) {
-	A := [][]string{
-		{"r1", "role_r1"},
-		{"r2", "role_r2"},
-		{"u1", "role_u1"},
-		{"u2", "role_u2"},
+func newMrsForPasswordLifetimeOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("password_lifetime")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
 	}
-	Path := []string{"", "", "", ""}
-	Out := []string{}
-	genRevokeCases1(A, Path, 0, true, &Out)
-	genRevokeCases1(A, Path, 0, false, &Out)
-	for _, s := range Out {
-		fmt.Println(s)
+
+	return mrs
+}
+
+func newMrsForPasswordOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("user_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("authentication_string")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("default_role")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForCommentOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("comment")
+	col1.SetColumnType(defines.MYSQL_TYPE_TEXT)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("attribute")
+	col2.SetColumnType(defines.MYSQL_TYPE_TEXT)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForPasswordHistoryOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("history_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("authentication_string")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("in_window")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForCheckUserGrant(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("role_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("user_id")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("with_grant_option")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col4 := &MysqlColumn{}
+	col4.SetName("expiry_time is not null")
+	col4.SetColumnType(defines.MYSQL_TYPE_BOOL)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddColumn(col4)
+
+	for _, row := range rows {
+		//the grant is permanent by default
+		if len(row) == 3 {
+			row = append(row, false)
+		}
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForRoleOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("role_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
 	}
+
+	return mrs
 }
 
-func newSes(priv *privilege, ctrl *gomock.Controller) *Session {
-	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-	pu.SV.SetDefaultValues()
-	setGlobalPu(pu)
+func newMrsForCheckDatabase(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
 
-	ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
-	ctx = defines.AttachAccountId(ctx, 0)
-	ioses := mock_frontend.NewMockIOSession(ctrl)
-	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
-	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
-	ioses.EXPECT().Ref().AnyTimes()
-	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	col1 := &MysqlColumn{}
+	col1.SetName("dat_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
-	ses := NewSession(ctx, proto, nil)
-	tenant := &TenantInfo{
-		Tenant:        sysAccountName,
-		User:          rootName,
-		DefaultRole:   moAdminRoleName,
-		TenantID:      sysAccountID,
-		UserID:        rootID,
-		DefaultRoleID: moAdminRoleID,
+	col2 := &MysqlColumn{}
+	col2.SetName("account_id")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONG)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
 	}
-	ses.SetTenantInfo(tenant)
-	ses.priv = priv
 
-	stubs := gostub.StubFunc(&ExeSqlInBgSes, nil, nil)
-	defer stubs.Reset()
+	return mrs
+}
 
-	_ = ses.InitSystemVariables(ctx)
+func newMrsForCheckDatabaseTable(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
 
-	rm, _ := NewRoutineManager(ctx)
-	rm.baseService = new(MockBaseService)
-	ses.rm = rm
+	col1 := &MysqlColumn{}
+	col1.SetName("rel_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
-	return ses
-}
+	col2 := &MysqlColumn{}
+	col2.SetName("account_id")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONG)
 
-var _ BaseService = &MockBaseService{}
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
 
-type MockBaseService struct {
-}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
 
-func (m *MockBaseService) ID() string {
-	//TODO implement me
-	panic("implement me")
+	return mrs
 }
 
-func (m *MockBaseService) SQLAddress() string {
-	//TODO implement me
-	panic("implement me")
-}
+func newMrsForCheckTenant(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
 
-func (m *MockBaseService) SessionMgr() *queryservice.SessionManager {
-	//TODO implement me
-	panic("implement me")
-}
+	col1 := &MysqlColumn{}
+	col1.SetName("account_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
-func (m *MockBaseService) CheckTenantUpgrade(ctx context.Context, tenantID int64) error {
-	//TODO implement me
-	panic("implement me")
-}
+	col2 := &MysqlColumn{}
+	col2.SetName("account_name")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
-func (m *MockBaseService) GetFinalVersion() string {
-	return "1.2.0"
-}
+	col3 := &MysqlColumn{}
+	col3.SetName("status")
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
-func (s *MockBaseService) UpgradeTenant(ctx context.Context, tenantName string, retryCount uint32, isALLAccount bool) error {
-	//TODO implement me
-	panic("implement me")
-}
+	col4 := &MysqlColumn{}
+	col4.SetName("version")
+	col4.SetColumnType(defines.MYSQL_TYPE_LONG)
 
-func newBh(ctrl *gomock.Controller, sql2result map[string]ExecResult) BackgroundExec {
-	var currentSql string
-	bh := mock_frontend.NewMockBackgroundExec(ctrl)
-	bh.EXPECT().ClearExecResultSet().AnyTimes()
-	bh.EXPECT().Close().Return().AnyTimes()
-	bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
-		currentSql = sql
-		return nil
-	}).AnyTimes()
-	bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
-		return []interface{}{sql2result[currentSql]}
-	}).AnyTimes()
-	return bh
-}
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddColumn(col4)
 
-type backgroundExecTest struct {
-	currentSql string
-	sql2result map[string]ExecResult
-}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
 
-func (bt *backgroundExecTest) ExecStmt(ctx context.Context, statement tree.Statement) error {
-	//TODO implement me
-	panic("implement me")
+	return mrs
 }
 
-func (bt *backgroundExecTest) GetExecResultBatches() []*batch.Batch {
-	//TODO implement me
-	panic("implement me")
+func makeRowsOfMoRole(sql2result map[string]ExecResult, roleNames []string, rows [][][]interface{}) {
+	for i, name := range roleNames {
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), name)
+		sql2result[sql] = newMrsForRoleIdOfRole(rows[i])
+	}
 }
 
-func (bt *backgroundExecTest) ClearExecResultBatches() {
-	//TODO implement me
-	panic("implement me")
+func makeRowsOfCheckTenant(sql2result map[string]ExecResult, tenant string, status string) {
+	sql, _ := getSqlForCheckTenant(context.TODO(), tenant)
+	sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+		{sysAccountID, tenant, status, 0},
+	})
 }
 
-func (bt *backgroundExecTest) init() {
-	bt.sql2result = make(map[string]ExecResult)
+func makeRowsOfMoUserGrant(sql2result map[string]ExecResult, userId int, rows [][]interface{}) {
+	sql2result[getSqlForRoleIdOfUserId(userId)] = newMrsForRoleIdOfUserId(rows)
 }
 
-func (bt *backgroundExecTest) Close() {
+func makeRowsOfMoRolePrivs(sql2result map[string]ExecResult, roleIds []int, entries []privilegeEntry, rowsOfMoRolePrivs [][]interface{}) {
+	for _, roleId := range roleIds {
+		for _, entry := range entries {
+			sql, _ := getSqlFromPrivilegeEntry(context.TODO(), int64(roleId), entry)
+			sql2result[sql] = newMrsForCheckRoleHasPrivilege(rowsOfMoRolePrivs)
+		}
+	}
+	makeRowsOfMoRolePrivsOfRoleSets(sql2result, roleIds, entries, func(i, j int) bool {
+		return len(rowsOfMoRolePrivs) != 0
+	})
 }
 
-func (bt *backgroundExecTest) Clear() {}
+// makeRowsOfMoRolePrivsOfRoleSets fills the results of the privilege checks
+// that check multiple roles together with one sql.
+// The hasPrivilege tells the roleIds[i] has the entries[j] or not.
+func makeRowsOfMoRolePrivsOfRoleSets(sql2result map[string]ExecResult, roleIds []int, entries []privilegeEntry, hasPrivilege func(i, j int) bool) {
+	sorted := make([]int, len(roleIds))
+	copy(sorted, roleIds)
+	sort.Ints(sorted)
+	index := make(map[int]int, len(roleIds))
+	for i, roleId := range roleIds {
+		index[roleId] = i
+	}
 
-func (bt *backgroundExecTest) Exec(ctx context.Context, s string) error {
-	bt.currentSql = s
-	return nil
+	for mask := 1; mask < 1<<len(sorted); mask++ {
+		var subset []int64
+		for k, roleId := range sorted {
+			if mask&(1<<k) != 0 {
+				subset = append(subset, int64(roleId))
+			}
+		}
+		if len(subset) < 2 {
+			continue
+		}
+		for j, entry := range entries {
+			pls, err := getPrivilegeLevelsOfObjectType(context.TODO(), entry.objType)
+			if err != nil {
+				continue
+			}
+			for _, pl := range pls {
+				sql, err := getSqlForPrivilegeOfRoles(context.TODO(), subset, entry, pl)
+				if err != nil {
+					continue
+				}
+				rows := [][]interface{}{}
+				if pl == entry.privilegeLevel {
+					for _, roleId := range subset {
+						if hasPrivilege(index[int(roleId)], j) {
+							rows = append(rows, []interface{}{roleId, true})
+						}
+					}
+				}
+				sql2result[sql] = newMrsForCheckRoleHasPrivilege(rows)
+			}
+		}
+	}
 }
 
-func (bt *backgroundExecTest) ExecRestore(context.Context, string, uint32, uint32) error {
-	panic("unimplement")
+func makeRowsOfMoRoleGrant(sql2result map[string]ExecResult, roleIds []int, rowsOfMoRoleGrant [][]interface{}) {
+	for _, roleId := range roleIds {
+		sql := getSqlForInheritedRoleIdOfRoleId(int64(roleId))
+		sql2result[sql] = newMrsForInheritedRoleIdOfRoleId(rowsOfMoRoleGrant)
+	}
 }
 
-func (bt *backgroundExecTest) GetExecResultSet() []interface{} {
-	return []interface{}{bt.sql2result[bt.currentSql]}
+func newMrsForWithGrantOptionPrivilege(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("privilege_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("with_grant_option")
+	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
 }
 
-func (bt *backgroundExecTest) ClearExecResultSet() {
-	//bt.init()
+func newMrsForRoleWGO(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+
+	col1 := &MysqlColumn{}
+	col1.SetName("grantee_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
 }
 
-var _ BackgroundExec = &backgroundExecTest{}
+func newMrsForPrivilegeWGO(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
 
-func newMrsForSqlForShowDatabases(rows [][]interface{}) *MysqlResultSet {
+	col1 := &MysqlColumn{}
+	col1.SetName("role_id")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+
+	mrs.AddColumn(col1)
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return mrs
+}
+
+func newMrsForSystemVariablesOfAccount(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
 	col1 := &MysqlColumn{}
-	col1.SetName("Database")
+	col1.SetName("variable_name")
 	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col2 := &MysqlColumn{}
+	col2.SetName("variable_value")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
 	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
 
 	for _, row := range rows {
 		mrs.AddRow(row)
@@ -7847,19 +12767,24 @@ func newMrsForSqlForShowDatabases(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
-func newMrsForSqlForCheckUserHasRole(rows [][]interface{}) *MysqlResultSet {
+func newMrsForDatabaseVariablesOfAccount(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
 	col1 := &MysqlColumn{}
-	col1.SetName("user_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col1.SetName("dat_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
 	col2 := &MysqlColumn{}
-	col2.SetName("role_id")
-	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col2.SetName("variable_name")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+
+	col3 := &MysqlColumn{}
+	col3.SetName("variable_value")
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
 	mrs.AddColumn(col1)
 	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
 
 	for _, row := range rows {
 		mrs.AddRow(row)
@@ -7868,12 +12793,12 @@ func newMrsForSqlForCheckUserHasRole(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
-func newMrsForRoleIdOfRole(rows [][]interface{}) *MysqlResultSet {
+func newMrsForSystemVariableNameOfAccount(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
 	col1 := &MysqlColumn{}
-	col1.SetName("role_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col1.SetName("variable_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
 	mrs.AddColumn(col1)
 
@@ -7884,40 +12809,101 @@ func newMrsForRoleIdOfRole(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
-func newMrsForRoleIdOfUserId(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+func makeRowsOfWithGrantOptionPrivilege(sql2result map[string]ExecResult, sql string, rows [][]interface{}) {
+	sql2result[sql] = newMrsForWithGrantOptionPrivilege(rows)
+}
 
-	col1 := &MysqlColumn{}
-	col1.SetName("role_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+func makeSql2ExecResult(userId int,
+	rowsOfMoUserGrant [][]interface{},
+	roleIdsInMoRolePrivs []int, entries []privilegeEntry, rowsOfMoRolePrivs [][]interface{},
+	roleIdsInMoRoleGrant []int, rowsOfMoRoleGrant [][]interface{}) map[string]ExecResult {
+	sql2result := make(map[string]ExecResult)
+	makeRowsOfMoUserGrant(sql2result, userId, rowsOfMoUserGrant)
+	makeRowsOfMoRolePrivs(sql2result, roleIdsInMoRolePrivs, entries, rowsOfMoRolePrivs)
+	makeRowsOfMoRoleGrant(sql2result, roleIdsInMoRoleGrant, rowsOfMoRoleGrant)
+	return sql2result
+}
 
-	col2 := &MysqlColumn{}
-	col2.SetName("with_grant_option")
-	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+func makeSql2ExecResult2(userId int, rowsOfMoUserGrant [][]interface{}, roleIdsInMoRolePrivs []int, entries []privilegeEntry, rowsOfMoRolePrivs [][][][]interface{}, roleIdsInMoRoleGrant []int, rowsOfMoRoleGrant [][][]interface{}, grantedIds []int, granteeRows [][][]interface{}) map[string]ExecResult {
+	sql2result := make(map[string]ExecResult)
+	makeRowsOfMoUserGrant(sql2result, userId, rowsOfMoUserGrant)
+	for i, roleId := range roleIdsInMoRolePrivs {
+		for j, entry := range entries {
+			sql, _ := getSqlFromPrivilegeEntry(context.TODO(), int64(roleId), entry)
+			sql2result[sql] = newMrsForCheckRoleHasPrivilege(rowsOfMoRolePrivs[i][j])
+		}
+	}
+	makeRowsOfMoRolePrivsOfRoleSets(sql2result, roleIdsInMoRolePrivs, entries, func(i, j int) bool {
+		return len(rowsOfMoRolePrivs[i][j]) != 0
+	})
 
-	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
+	for i, roleId := range roleIdsInMoRoleGrant {
+		sql := getSqlForInheritedRoleIdOfRoleId(int64(roleId))
+		sql2result[sql] = newMrsForInheritedRoleIdOfRoleId(rowsOfMoRoleGrant[i])
+	}
 
-	for _, row := range rows {
-		mrs.AddRow(row)
+	for i, id := range grantedIds {
+		sql := getSqlForCheckRoleGrantWGO(int64(id))
+		sql2result[sql] = newMrsForRoleWGO(granteeRows[i])
 	}
 
-	return mrs
+	return sql2result
 }
 
-func newMrsForCheckRoleHasPrivilege(rows [][]interface{}) *MysqlResultSet {
+func Test_describePrivilegesOfStatement(t *testing.T) {
+	type arg struct {
+		stmt tree.Statement
+		p    *plan2.Plan
+		want []string
+	}
+
+	args := []arg{
+		{
+			stmt: &tree.DropUser{},
+			want: []string{"drop user", "account all"},
+		},
+		{
+			stmt: &tree.CreateDatabase{},
+			want: []string{"create database", "account all"},
+		},
+		{
+			stmt: &tree.UpgradeStatement{},
+			want: []string{"role moadmin or accountadmin"},
+		},
+		{
+			stmt: &tree.EmptyStmt{},
+			want: nil,
+		},
+		{
+			stmt: &tree.Select{},
+			p: &plan2.Plan{
+				Plan: &plan2.Plan_Query{
+					Query: &plan2.Query{
+						Nodes: []*plan2.Node{
+							{NodeType: plan.Node_TABLE_SCAN, ObjRef: &plan2.ObjectRef{SchemaName: "t", ObjName: "a"}},
+						},
+					},
+				},
+			},
+			want: []string{"select on t.a", "table all on t.a", "table ownership on t.a"},
+		},
+	}
+
+	convey.Convey("describe privileges of statement", t, func() {
+		for _, a := range args {
+			convey.So(describePrivilegesOfStatement(a.stmt, a.p), convey.ShouldResemble, a.want)
+		}
+	})
+}
+
+func newMrsForCount(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
 	col1 := &MysqlColumn{}
-	col1.SetName("role_id")
+	col1.SetName("count(*)")
 	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
-	col2 := &MysqlColumn{}
-	col2.SetName("with_grant_option")
-	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
-
 	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
 
 	for _, row := range rows {
 		mrs.AddRow(row)
@@ -7926,16 +12912,16 @@ func newMrsForCheckRoleHasPrivilege(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
-func newMrsForInheritedRoleIdOfRoleId(rows [][]interface{}) *MysqlResultSet {
+func newMrsForMostGrantedRole(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
 	col1 := &MysqlColumn{}
-	col1.SetName("granted_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col1.SetName("role_name")
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
 	col2 := &MysqlColumn{}
-	col2.SetName("with_grant_option")
-	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+	col2.SetName("cnt")
+	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
 	mrs.AddColumn(col1)
 	mrs.AddColumn(col2)
@@ -7947,50 +12933,89 @@ func newMrsForInheritedRoleIdOfRoleId(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
-func newMrsForGetAllStuffRoleGrant(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+func Test_getRoleStatisticsOfAccount(t *testing.T) {
+	convey.Convey("get role statistics succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	col1 := &MysqlColumn{}
-	col1.SetName("granted_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		ses := newSes(nil, ctrl)
 
-	col2 := &MysqlColumn{}
-	col2.SetName("grantee_id")
-	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		sql2result := make(map[string]ExecResult)
+		sql2result[getCountOfRolesSql] = newMrsForCount([][]interface{}{
+			{4},
+		})
+		sql2result[getCountOfRolePrivsSql] = newMrsForCount([][]interface{}{
+			{10},
+		})
+		sql2result[getSqlForMostGrantedRole()] = newMrsForMostGrantedRole([][]interface{}{
+			{"r1", 3},
+		})
+		sql2result[getSqlForCountOfUsersWithoutNonPublicRoles()] = newMrsForCount([][]interface{}{
+			{2},
+		})
 
-	col3 := &MysqlColumn{}
-	col3.SetName("with_grant_option")
-	col3.SetColumnType(defines.MYSQL_TYPE_BOOL)
+		bh := newBh(ctrl, sql2result)
 
-	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
-	mrs.AddColumn(col3)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		stats, err := getRoleStatisticsOfAccount(ses.GetTxnHandler().GetTxnCtx(), ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(stats.roleCount, convey.ShouldEqual, 4)
+		convey.So(stats.privilegeCount, convey.ShouldEqual, 10)
+		convey.So(stats.avgPrivilegesPerRole, convey.ShouldEqual, 2.5)
+		convey.So(stats.mostGrantedRole, convey.ShouldEqual, "r1")
+		convey.So(stats.mostGrantedRoleCount, convey.ShouldEqual, 3)
+		convey.So(stats.usersWithoutNonPublicRoles, convey.ShouldEqual, 2)
+	})
 
-	return mrs
+	convey.Convey("get role statistics fail (not admin)", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.GetTenantInfo().SetDefaultRole("r1")
+
+		stats, err := getRoleStatisticsOfAccount(ses.GetTxnHandler().GetTxnCtx(), ses)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(stats, convey.ShouldBeNil)
+	})
 }
 
-func newMrsForCheckRoleGrant(rows [][]interface{}) *MysqlResultSet {
+func newMrsForPrivilegesOfRole(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
-	col1 := &MysqlColumn{}
-	col1.SetName("granted_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	names := []string{"obj_type", "obj_id", "privilege_id", "privilege_name", "privilege_level", "with_grant_option"}
+	colTypes := []defines.MysqlType{
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_LONGLONG,
+		defines.MYSQL_TYPE_LONGLONG,
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_BOOL,
+	}
+	for i, name := range names {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(colTypes[i])
+		mrs.AddColumn(col)
+	}
 
-	col2 := &MysqlColumn{}
-	col2.SetName("grantee_id")
-	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
 
-	col3 := &MysqlColumn{}
-	col3.SetName("with_grant_option")
-	col3.SetColumnType(defines.MYSQL_TYPE_BOOL)
+	return mrs
+}
 
-	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
-	mrs.AddColumn(col3)
+func newMrsForStrings(names []string, rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range names {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
 
 	for _, row := range rows {
 		mrs.AddRow(row)
@@ -7999,129 +13024,382 @@ func newMrsForCheckRoleGrant(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
-func newMrsForPasswordOfUser(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+func Test_getGrantsWGOOfAccount(t *testing.T) {
+	convey.Convey("list the grants with grant option", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	col1 := &MysqlColumn{}
-	col1.SetName("user_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		ses := newSes(nil, ctrl)
 
-	col2 := &MysqlColumn{}
-	col2.SetName("authentication_string")
-	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		//only the grants with grant option are selected
+		for _, sql := range []string{getPrivilegesWGOOfAccountSql, getUserGrantsWGOOfAccountSql, getRoleGrantsWGOOfAccountSql} {
+			convey.So(sql, convey.ShouldContainSubstring, "with_grant_option = true")
+		}
 
-	col3 := &MysqlColumn{}
-	col3.SetName("default_role")
-	col3.SetColumnType(defines.MYSQL_TYPE_BOOL)
+		sql2result := make(map[string]ExecResult)
+		sql2result[getPrivilegesWGOOfAccountSql] = newMrsForStrings(
+			[]string{"role_name", "obj_type", "privilege_name", "privilege_level", "datname", "reldatabase", "relname"},
+			[][]interface{}{
+				{"r1", "table", "select", "d.t", "", "db1", "t1"},
+				{"r1", "database", "show tables", "d", "db2", "", ""},
+				{"r2", "account", "create database", "*", "", "", ""},
+			})
+		sql2result[getUserGrantsWGOOfAccountSql] = newMrsForStrings(
+			[]string{"user_name", "role_name"},
+			[][]interface{}{
+				{"u1", "r1"},
+			})
+		sql2result[getRoleGrantsWGOOfAccountSql] = newMrsForStrings(
+			[]string{"grantee", "granted"},
+			[][]interface{}{
+				{"r2", "r1"},
+			})
 
-	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
-	mrs.AddColumn(col3)
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		grants, err := getGrantsWGOOfAccount(ses.GetTxnHandler().GetTxnCtx(), ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(grants), convey.ShouldEqual, 5)
+		convey.So(*grants[0], convey.ShouldResemble, grantWGO{kind: grantWGOKindPrivilege, grantee: "r1", granted: "select", objType: "table", objName: "db1.t1", privilegeLevel: "d.t"})
+		convey.So(grants[1].objName, convey.ShouldEqual, "db2")
+		convey.So(grants[2].objName, convey.ShouldEqual, "*")
+		convey.So(*grants[3], convey.ShouldResemble, grantWGO{kind: grantWGOKindRoleToUser, grantee: "u1", granted: "r1"})
+		convey.So(*grants[4], convey.ShouldResemble, grantWGO{kind: grantWGOKindRoleToRole, grantee: "r2", granted: "r1"})
+		convey.So(executed, convey.ShouldContain, "commit;")
+	})
 
-	return mrs
+	convey.Convey("list the grants with grant option by the non-admin role", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.GetTenantInfo().DefaultRole = "r1"
+		ses.GetTenantInfo().DefaultRoleID = 10
+
+		_, err := getGrantsWGOOfAccount(ses.GetTxnHandler().GetTxnCtx(), ses)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
 
-func newMrsForCheckUserGrant(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+func Test_doMergeRoles(t *testing.T) {
+	convey.Convey("merge roles with conflicting grant options succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+		})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "r2")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{11},
+		})
+
+		//r1: select with grant option, insert without it, update, create table
+		sql2result[getSqlForPrivilegesOfRole(10)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", true},
+			{"table", 0, int64(PrivilegeTypeInsert), "insert", "*.*", false},
+			{"table", 0, int64(PrivilegeTypeUpdate), "update", "*.*", true},
+			{"database", 0, int64(PrivilegeTypeCreateTable), "create table", "*", false},
+		})
+		//r2: select without grant option, insert with it, update with it
+		sql2result[getSqlForPrivilegesOfRole(11)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+			{"table", 0, int64(PrivilegeTypeInsert), "insert", "*.*", true},
+			{"table", 0, int64(PrivilegeTypeUpdate), "update", "*.*", true},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		conflicts, err := doMergeRoles(ses.GetTxnHandler().GetTxnCtx(), ses, "r1", "r2")
+		convey.So(err, convey.ShouldBeNil)
+
+		//the with grant option wins
+		convey.So(len(conflicts), convey.ShouldEqual, 2)
+		convey.So(conflicts[0].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeSelect))
+		convey.So(conflicts[0].sourceWithGrantOption, convey.ShouldBeTrue)
+		convey.So(conflicts[0].targetWithGrantOption, convey.ShouldBeFalse)
+		convey.So(conflicts[0].withGrantOption, convey.ShouldBeTrue)
+		convey.So(conflicts[1].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeInsert))
+		convey.So(conflicts[1].sourceWithGrantOption, convey.ShouldBeFalse)
+		convey.So(conflicts[1].targetWithGrantOption, convey.ShouldBeTrue)
+		convey.So(conflicts[1].withGrantOption, convey.ShouldBeTrue)
+
+		var updates, inserts []string
+		for _, sqlx := range executed {
+			if strings.HasPrefix(sqlx, "update mo_catalog.mo_role_privs") {
+				updates = append(updates, sqlx)
+			} else if strings.HasPrefix(sqlx, "insert into mo_catalog.mo_role_privs") {
+				inserts = append(inserts, sqlx)
+			}
+		}
+		//only the select of the r2 is upgraded to the with grant option
+		convey.So(len(updates), convey.ShouldEqual, 1)
+		convey.So(updates[0], convey.ShouldContainSubstring, "with_grant_option = true where role_id = 11")
+		convey.So(updates[0], convey.ShouldContainSubstring, fmt.Sprintf("privilege_id = %d;", PrivilegeTypeSelect))
+		//the create table is granted to the r2
+		convey.So(len(inserts), convey.ShouldEqual, 1)
+		convey.So(inserts[0], convey.ShouldContainSubstring, fmt.Sprintf(`(11,"r2","database",0,%d,"create table","*"`, PrivilegeTypeCreateTable))
+	})
+
+	convey.Convey("merge roles fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+
+		//into itself
+		_, err := doMergeRoles(ses.GetTxnHandler().GetTxnCtx(), ses, "r1", "r1")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//not admin
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		_, err = doMergeRoles(ses.GetTxnHandler().GetTxnCtx(), ses, "r1", "r2")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_getEffectivePrivilegesOfUser(t *testing.T) {
+	convey.Convey("view the effective privileges of the user succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		adminTenant := ses.GetTenantInfo().String()
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", 10},
+		})
+		//the roles 10, 11 are granted to the u1. the role 12 is granted to the role 11
+		sql2result[getSqlForGetRolesOfCurrentUser(5)] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+			{11},
+		})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(10)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(11)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+			{12, false},
+		})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(12)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForPrivilegesOfRole(10)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(11)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"database", 0, int64(PrivilegeTypeCreateTable), "create table", "*", false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(12)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", true},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		var audited string
+		auditStub := gostub.Stub(&auditImpersonationView, func(ctx context.Context, ses *Session, target string, roleIds []int64) {
+			audited = target
+		})
+		defer auditStub.Reset()
+
+		privs, err := getEffectivePrivilegesOfUser(ses.GetTxnHandler().GetTxnCtx(), ses, "u1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(audited, convey.ShouldEqual, "u1")
+
+		//the privileges of the role closure. the select is merged with the grant option
+		convey.So(len(privs), convey.ShouldEqual, 2)
+		convey.So(privs[0].objType, convey.ShouldEqual, "database")
+		convey.So(privs[0].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeCreateTable))
+		convey.So(privs[1].objType, convey.ShouldEqual, "table")
+		convey.So(privs[1].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeSelect))
+		convey.So(privs[1].withGrantOption, convey.ShouldBeTrue)
+
+		//it is the same role set that the u1 sees with all the secondary roles
+		u1 := &TenantInfo{Tenant: sysAccountName, User: "u1", UserID: 5, DefaultRoleID: 10}
+		u1.SetUseSecondaryRole(true)
+		roleIds, err := getEffectiveRolesOfTenant(context.TODO(), bh, u1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(roleIds, convey.ShouldResemble, []int64{10, 11, 12})
+
+		//nothing is changed
+		convey.So(ses.GetTenantInfo().String(), convey.ShouldEqual, adminTenant)
+		for _, sqlx := range executed {
+			convey.So(strings.HasPrefix(sqlx, "select") || sqlx == "begin;" || sqlx == "commit;", convey.ShouldBeTrue)
+		}
+	})
 
-	col1 := &MysqlColumn{}
-	col1.SetName("role_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	convey.Convey("view the effective privileges of the user fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	col2 := &MysqlColumn{}
-	col2.SetName("user_id")
-	col2.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		ses := newSes(nil, ctrl)
 
-	col3 := &MysqlColumn{}
-	col3.SetName("with_grant_option")
-	col3.SetColumnType(defines.MYSQL_TYPE_BOOL)
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u2")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
 
-	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
-	mrs.AddColumn(col3)
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		//no such user
+		_, err := getEffectivePrivilegesOfUser(ses.GetTxnHandler().GetTxnCtx(), ses, "u2")
+		convey.So(err, convey.ShouldNotBeNil)
 
-	return mrs
+		//not admin
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		_, err = getEffectivePrivilegesOfUser(ses.GetTxnHandler().GetTxnCtx(), ses, "u1")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
 
-func newMrsForRoleOfUser(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
-
-	col1 := &MysqlColumn{}
-	col1.SetName("role_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+func Test_simulatePrivilegeChanges(t *testing.T) {
+	convey.Convey("simulate the grant of the role to the user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	mrs.AddColumn(col1)
+		ses := newSes(nil, ctrl)
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{20},
+		})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "u1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", 10},
+		})
+		sql, _ = getSqlForRoleOfUser(context.TODO(), 5, moAdminRoleName)
+		sql2result[sql] = newMrsForRoleOfUser([][]interface{}{})
+		sql2result[getSqlForGetAllStuffRoleGrantFormat()] = newMrsForGetAllStuffRoleGrant([][]interface{}{})
+		sql2result[getSqlForCheckRoleGrant(20, 5)] = newMrsForCheckRoleGrant([][]interface{}{})
+		sql2result[getSqlForCheckUserGrant(20, 5)] = newMrsForCheckUserGrant([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(10)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(20)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForPrivilegesOfRole(10)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(20)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"database", 0, int64(PrivilegeTypeCreateTable), "create table", "*", false},
+		})
 
-	return mrs
-}
+		//the mo_user_grant changes until the rollback
+		var executed []string
+		var currentSql string
+		var granted bool
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			currentSql = sql
+			executed = append(executed, sql)
+			if strings.HasPrefix(sql, "insert into mo_catalog.mo_user_grant") {
+				granted = true
+			} else if sql == "rollback;" {
+				granted = false
+			}
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			if currentSql == getSqlForGetRolesOfCurrentUser(5) {
+				rows := [][]interface{}{{10}}
+				if granted {
+					rows = append(rows, []interface{}{20})
+				}
+				return []interface{}{newMrsForRoleIdOfRole(rows)}
+			}
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-func newMrsForCheckDatabase(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+		auditStub := gostub.Stub(&auditImpersonationView, func(ctx context.Context, ses *Session, target string, roleIds []int64) {})
+		defer auditStub.Reset()
 
-	col1 := &MysqlColumn{}
-	col1.SetName("dat_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		ops := []tree.Statement{
+			&tree.Grant{
+				Typ: tree.GrantTypeRole,
+				GrantRole: tree.GrantRole{
+					Roles: []*tree.Role{{UserName: "r1"}},
+					Users: []*tree.User{{Username: "u1"}},
+				},
+			},
+		}
+		ret, err := simulatePrivilegeChanges(ses.GetTxnHandler().GetTxnCtx(), ses, ops, []string{"u1"})
+		convey.So(err, convey.ShouldBeNil)
 
-	mrs.AddColumn(col1)
+		//the simulated effect
+		privs := ret["u1"]
+		convey.So(len(privs), convey.ShouldEqual, 2)
+		convey.So(privs[0].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeCreateTable))
+		convey.So(privs[1].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeSelect))
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		//always rolled back
+		convey.So(executed, convey.ShouldNotContain, "commit;")
+		convey.So(executed[len(executed)-1], convey.ShouldEqual, "rollback;")
 
-	return mrs
-}
+		//no persistent change
+		privs, err = getEffectivePrivilegesOfUser(ses.GetTxnHandler().GetTxnCtx(), ses, "u1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(privs), convey.ShouldEqual, 1)
+		convey.So(privs[0].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeSelect))
+	})
 
-func newMrsForCheckDatabaseTable(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+	convey.Convey("simulate the unsupported statement", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	col1 := &MysqlColumn{}
-	col1.SetName("rel_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		ses := newSes(nil, ctrl)
 
-	mrs.AddColumn(col1)
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, map[string]ExecResult{}, &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		_, err := simulatePrivilegeChanges(ses.GetTxnHandler().GetTxnCtx(), ses, []tree.Statement{&tree.DropRole{}}, []string{"u1"})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldResemble, []string{"begin;", "rollback;"})
 
-	return mrs
+		//not admin
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		_, err = simulatePrivilegeChanges(ses.GetTxnHandler().GetTxnCtx(), ses, nil, []string{"u1"})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
 
-func newMrsForCheckTenant(rows [][]interface{}) *MysqlResultSet {
+func newMrsForUsersOfAccount(rows [][]interface{}) *MysqlResultSet {
 	mrs := &MysqlResultSet{}
 
 	col1 := &MysqlColumn{}
-	col1.SetName("account_id")
+	col1.SetName("user_id")
 	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
 	col2 := &MysqlColumn{}
-	col2.SetName("account_name")
+	col2.SetName("user_name")
 	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
 	col3 := &MysqlColumn{}
-	col3.SetName("status")
-	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
-
-	col4 := &MysqlColumn{}
-	col4.SetName("version")
-	col4.SetColumnType(defines.MYSQL_TYPE_LONG)
+	col3.SetName("default_role")
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 
 	mrs.AddColumn(col1)
 	mrs.AddColumn(col2)
 	mrs.AddColumn(col3)
-	mrs.AddColumn(col4)
 
 	for _, row := range rows {
 		mrs.AddRow(row)
@@ -8130,159 +13408,232 @@ func newMrsForCheckTenant(rows [][]interface{}) *MysqlResultSet {
 	return mrs
 }
 
-func makeRowsOfMoRole(sql2result map[string]ExecResult, roleNames []string, rows [][][]interface{}) {
-	for i, name := range roleNames {
-		sql, _ := getSqlForRoleIdOfRole(context.TODO(), name)
-		sql2result[sql] = newMrsForRoleIdOfRole(rows[i])
-	}
-}
+func Test_getImpactOfDroppingRole(t *testing.T) {
+	convey.Convey("report the impact of dropping the role succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-func makeRowsOfMoUserGrant(sql2result map[string]ExecResult, userId int, rows [][]interface{}) {
-	sql2result[getSqlForRoleIdOfUserId(userId)] = newMrsForRoleIdOfUserId(rows)
-}
+		ses := newSes(nil, ctrl)
 
-func makeRowsOfMoRolePrivs(sql2result map[string]ExecResult, roleIds []int, entries []privilegeEntry, rowsOfMoRolePrivs [][]interface{}) {
-	for _, roleId := range roleIds {
-		for _, entry := range entries {
-			sql, _ := getSqlFromPrivilegeEntry(context.TODO(), int64(roleId), entry)
-			sql2result[sql] = newMrsForCheckRoleHasPrivilege(rowsOfMoRolePrivs)
-		}
-	}
-}
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r10")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+		})
+		sql2result[getSqlForUsersOfAccount()] = newMrsForUsersOfAccount([][]interface{}{
+			{5, "u1", 10},
+			{6, "u2", 10},
+			{7, "u3", 12},
+			{8, "u4", 11},
+		})
 
-func makeRowsOfMoRoleGrant(sql2result map[string]ExecResult, roleIds []int, rowsOfMoRoleGrant [][]interface{}) {
-	for _, roleId := range roleIds {
-		sql := getSqlForInheritedRoleIdOfRoleId(int64(roleId))
-		sql2result[sql] = newMrsForInheritedRoleIdOfRoleId(rowsOfMoRoleGrant)
-	}
-}
+		//u1 has the role 10 only.
+		//u2 has the role 10 and the role 11 that has the select too.
+		//u3 has the role 12 that inherits the role 10 and has the same privileges.
+		//u4 does not have the role 10.
+		sql2result[getSqlForGetRolesOfCurrentUser(5)] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+		})
+		sql2result[getSqlForGetRolesOfCurrentUser(6)] = newMrsForRoleIdOfRole([][]interface{}{
+			{10},
+			{11},
+		})
+		sql2result[getSqlForGetRolesOfCurrentUser(7)] = newMrsForRoleIdOfRole([][]interface{}{
+			{12},
+		})
+		sql2result[getSqlForGetRolesOfCurrentUser(8)] = newMrsForRoleIdOfRole([][]interface{}{
+			{11},
+		})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(10)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(11)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(12)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{
+			{10, false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(10)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+			{"database", 0, int64(PrivilegeTypeCreateTable), "create table", "*", false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(11)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(12)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+			{"database", 0, int64(PrivilegeTypeCreateTable), "create table", "*", false},
+		})
 
-func newMrsForWithGrantOptionPrivilege(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
 
-	col1 := &MysqlColumn{}
-	col1.SetName("privilege_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	col2 := &MysqlColumn{}
-	col2.SetName("with_grant_option")
-	col2.SetColumnType(defines.MYSQL_TYPE_BOOL)
+		impacts, err := getImpactOfDroppingRole(ses.GetTxnHandler().GetTxnCtx(), ses, "r10")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(impacts), convey.ShouldEqual, 2)
 
-	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
+		//the role 10 uniquely provides all the privileges of the u1
+		convey.So(impacts[0].userName, convey.ShouldEqual, "u1")
+		convey.So(len(impacts[0].lost), convey.ShouldEqual, 2)
+		convey.So(impacts[0].lost[0].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeCreateTable))
+		convey.So(impacts[0].lost[1].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeSelect))
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		//the select of the u2 is shared with the role 11
+		convey.So(impacts[1].userName, convey.ShouldEqual, "u2")
+		convey.So(len(impacts[1].lost), convey.ShouldEqual, 1)
+		convey.So(impacts[1].lost[0].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeCreateTable))
 
-	return mrs
-}
+		//nothing is dropped
+		for _, sqlx := range executed {
+			convey.So(strings.HasPrefix(sqlx, "select") || sqlx == "begin;" || sqlx == "commit;", convey.ShouldBeTrue)
+		}
+	})
 
-func newMrsForRoleWGO(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+	convey.Convey("report the impact of dropping the role fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	col1 := &MysqlColumn{}
-	col1.SetName("grantee_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		ses := newSes(nil, ctrl)
 
-	mrs.AddColumn(col1)
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r20")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	return mrs
+		//no such role
+		_, err := getImpactOfDroppingRole(ses.GetTxnHandler().GetTxnCtx(), ses, "r20")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the role can not be dropped
+		_, err = getImpactOfDroppingRole(ses.GetTxnHandler().GetTxnCtx(), ses, publicRoleName)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//not admin
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		_, err = getImpactOfDroppingRole(ses.GetTxnHandler().GetTxnCtx(), ses, "r10")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
 
-func newMrsForPrivilegeWGO(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+func Test_roleTags(t *testing.T) {
+	alterTags := func(role string, tags ...string) *tree.AlterRole {
+		return &tree.AlterRole{Role: &tree.Role{UserName: role}, SetTags: true, Tags: tags}
+	}
 
-	col1 := &MysqlColumn{}
-	col1.SetName("role_id")
-	col1.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	convey.Convey("set, update and clear the tags of the role succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	mrs.AddColumn(col1)
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForOwnerOfRole(ctx, "r1")
+		sql2result[sql] = newMrsForStrings([]string{"role_id", "owner"}, [][]interface{}{
+			{10, 0},
+		})
 
-	return mrs
-}
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
 
-func newMrsForSystemVariablesOfAccount(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	col1 := &MysqlColumn{}
-	col1.SetName("variable_name")
-	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		//set
+		err := doAlterRole(ctx, ses, alterTags("r1", "Prod-Write", " pii ", "pii"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateTagsOfRole("pii,prod-write", 10))
 
-	col2 := &MysqlColumn{}
-	col2.SetName("variable_value")
-	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		//update
+		err = doAlterRole(ctx, ses, alterTags("r1", "audit"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateTagsOfRole("audit", 10))
 
-	mrs.AddColumn(col1)
-	mrs.AddColumn(col2)
+		//clear
+		err = doAlterRole(ctx, ses, alterTags("r1"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateTagsOfRole("", 10))
+		convey.So(executed, convey.ShouldNotContain, getSqlForUpdateCommentsOfRole("", 10))
+	})
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+	convey.Convey("create the role with the tags succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-	return mrs
-}
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
 
-func newMrsForSystemVariableNameOfAccount(rows [][]interface{}) *MysqlResultSet {
-	mrs := &MysqlResultSet{}
+		stmt, err := mysql.ParseOne(ctx, "create role r1 tags ('PII', 'prod-write')", 1)
+		convey.So(err, convey.ShouldBeNil)
 
-	col1 := &MysqlColumn{}
-	col1.SetName("variable_name")
-	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForPasswordOfUser(ctx, "r1")
+		sql2result[sql] = newMrsForPasswordOfUser(nil)
+		roleIdSql, _ := getSqlForRoleIdOfRole(ctx, "r1")
+
+		//the role is not found before it is inserted
+		var executed []string
+		var currentSql string
+		var inserted bool
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			currentSql = sql
+			executed = append(executed, sql)
+			inserted = inserted || strings.HasPrefix(sql, "insert into mo_catalog.mo_role(")
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			if currentSql == roleIdSql {
+				if inserted {
+					return []interface{}{newMrsForRoleIdOfRole([][]interface{}{{10}})}
+				}
+				return []interface{}{newMrsForRoleIdOfRole(nil)}
+			}
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
 
-	mrs.AddColumn(col1)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	for _, row := range rows {
-		mrs.AddRow(row)
-	}
+		err = InitRole(ctx, ses, ses.GetTenantInfo(), stmt.(*tree.CreateRole))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateTagsOfRole("pii,prod-write", 10))
+	})
 
-	return mrs
-}
+	convey.Convey("set the tags of the role fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-func makeRowsOfWithGrantOptionPrivilege(sql2result map[string]ExecResult, sql string, rows [][]interface{}) {
-	sql2result[sql] = newMrsForWithGrantOptionPrivilege(rows)
-}
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
 
-func makeSql2ExecResult(userId int,
-	rowsOfMoUserGrant [][]interface{},
-	roleIdsInMoRolePrivs []int, entries []privilegeEntry, rowsOfMoRolePrivs [][]interface{},
-	roleIdsInMoRoleGrant []int, rowsOfMoRoleGrant [][]interface{}) map[string]ExecResult {
-	sql2result := make(map[string]ExecResult)
-	makeRowsOfMoUserGrant(sql2result, userId, rowsOfMoUserGrant)
-	makeRowsOfMoRolePrivs(sql2result, roleIdsInMoRolePrivs, entries, rowsOfMoRolePrivs)
-	makeRowsOfMoRoleGrant(sql2result, roleIdsInMoRoleGrant, rowsOfMoRoleGrant)
-	return sql2result
-}
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForOwnerOfRole(ctx, "r1")
+		sql2result[sql] = newMrsForStrings([]string{"role_id", "owner"}, nil)
 
-func makeSql2ExecResult2(userId int, rowsOfMoUserGrant [][]interface{}, roleIdsInMoRolePrivs []int, entries []privilegeEntry, rowsOfMoRolePrivs [][][][]interface{}, roleIdsInMoRoleGrant []int, rowsOfMoRoleGrant [][][]interface{}, grantedIds []int, granteeRows [][][]interface{}) map[string]ExecResult {
-	sql2result := make(map[string]ExecResult)
-	makeRowsOfMoUserGrant(sql2result, userId, rowsOfMoUserGrant)
-	for i, roleId := range roleIdsInMoRolePrivs {
-		for j, entry := range entries {
-			sql, _ := getSqlFromPrivilegeEntry(context.TODO(), int64(roleId), entry)
-			sql2result[sql] = newMrsForCheckRoleHasPrivilege(rowsOfMoRolePrivs[i][j])
-		}
-	}
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
 
-	for i, roleId := range roleIdsInMoRoleGrant {
-		sql := getSqlForInheritedRoleIdOfRoleId(int64(roleId))
-		sql2result[sql] = newMrsForInheritedRoleIdOfRoleId(rowsOfMoRoleGrant[i])
-	}
+		//invalid tag
+		err := doAlterRole(ctx, ses, alterTags("r1", "pii,prod"))
+		convey.So(err, convey.ShouldNotBeNil)
 
-	for i, id := range grantedIds {
-		sql := getSqlForCheckRoleGrantWGO(int64(id))
-		sql2result[sql] = newMrsForRoleWGO(granteeRows[i])
-	}
+		//no such role
+		err = doAlterRole(ctx, ses, alterTags("r1", "pii"))
+		convey.So(err, convey.ShouldNotBeNil)
 
-	return sql2result
+		//invalid tag on the creation
+		err = InitRole(ctx, ses, ses.GetTenantInfo(), &tree.CreateRole{
+			Roles: []*tree.Role{{UserName: "r1"}},
+			Tags:  []string{"pii prod"},
+		})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
 }
 
 func Test_graph(t *testing.T) {
@@ -8309,6 +13660,25 @@ func Test_graph(t *testing.T) {
 		g2.addEdge(4, 1)
 		convey.So(g2.hasLoop(1), convey.ShouldBeTrue)
 	})
+
+	convey.Convey("find the loop", t, func() {
+		g := NewGraph()
+		g.addEdge(1, 2)
+		g.addEdge(2, 3)
+		g.addEdge(2, 5)
+		convey.So(g.findLoop(1), convey.ShouldBeEmpty)
+
+		//the branch 2 -> 5 is not on the loop
+		e1 := g.addEdge(3, 1)
+		convey.So(g.findLoop(1), convey.ShouldResemble, []int64{1, 2, 3, 1})
+		convey.So(g.findLoop(2), convey.ShouldResemble, []int64{2, 3, 1, 2})
+
+		//the loop does not pass the start
+		g.removeEdge(e1)
+		g.addEdge(3, 2)
+		convey.So(g.findLoop(1), convey.ShouldResemble, []int64{2, 3, 2})
+		convey.So(g.findLoop(5), convey.ShouldBeEmpty)
+	})
 }
 
 func Test_cache(t *testing.T) {
@@ -8404,6 +13774,226 @@ func Test_cache(t *testing.T) {
 	})
 }
 
+func Test_cacheTTL(t *testing.T) {
+	convey.Convey("no expiry", t, func() {
+		cache := &privilegeCache{}
+		cache.add(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables)
+		time.Sleep(time.Millisecond)
+		convey.So(cache.has(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeTrue)
+		convey.So(cache.total.Load(), convey.ShouldEqual, 1)
+		convey.So(cache.hit.Load(), convey.ShouldEqual, 1)
+	})
+
+	convey.Convey("expired is a miss", t, func() {
+		cache := &privilegeCache{}
+		cache.setTTL(time.Hour)
+		cache.add(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables)
+		convey.So(cache.has(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeTrue)
+
+		cache.setTTL(time.Nanosecond)
+		time.Sleep(time.Millisecond)
+		convey.So(cache.has(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeFalse)
+		convey.So(cache.total.Load(), convey.ShouldEqual, 2)
+		convey.So(cache.hit.Load(), convey.ShouldEqual, 1)
+
+		//added again
+		cache.setTTL(time.Hour)
+		cache.add(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables)
+		convey.So(cache.has(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("ttl from the system variable", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ttl, err := privilegeCacheTTL(ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ttl, convey.ShouldEqual, time.Duration(0))
+
+		err = ses.SetSessionSysVar(context.TODO(), "privilege_cache_ttl", uint64(30))
+		convey.So(err, convey.ShouldBeNil)
+		ttl, err = privilegeCacheTTL(ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ttl, convey.ShouldEqual, 30*time.Second)
+	})
+}
+
+func Test_deniedPrivilegeCache(t *testing.T) {
+	convey.Convey("denied and invalidated", t, func() {
+		cache := &privilegeCache{}
+		convey.So(cache.isDenied(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeFalse)
+
+		cache.deny(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables)
+		convey.So(cache.isDenied(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeTrue)
+		convey.So(cache.isDenied(objectTypeDatabase, privilegeLevelDatabase, "db2", "", PrivilegeTypeShowTables), convey.ShouldBeFalse)
+		//the denied one is not granted
+		convey.So(cache.has(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeFalse)
+
+		cache.invalidate()
+		convey.So(cache.isDenied(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("the ttl of the denied is not longer than the cache", t, func() {
+		cache := &privilegeCache{}
+		cache.setTTL(time.Nanosecond)
+		cache.deny(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables)
+		time.Sleep(time.Millisecond)
+		convey.So(cache.isDenied(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("the denial is cleared by the grant of the other session", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ses.GetPrivilegeCache().deny(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables)
+		convey.So(ses.GetPrivilegeCache().isDenied(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeTrue)
+
+		//the GRANT of the other session marks the cache stale after it commits
+		ses.markPrivilegeCacheStale()
+		convey.So(ses.GetPrivilegeCache().isDenied(objectTypeDatabase, privilegeLevelDatabase, "db", "", PrivilegeTypeShowTables), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("the grant after the denial", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.CreateAccount{}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		//the role 0 does not have the privilege and inherits nothing
+		sql2result := makeSql2ExecResult2(0, rowsOfMoUserGrant,
+			[]int{0}, priv.entries, [][][][]interface{}{{{}}},
+			[]int{0}, [][][]interface{}{{}}, nil, nil)
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		ok, err := determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		//the denial is served from the cache without any sql
+		var executed []string
+		bhStub.StubFunc(&NewBackgroundExec, newBhWithExecutedSqls(ctrl, map[string]ExecResult{}, &executed))
+		ok, err = determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeFalse)
+		convey.So(executed, convey.ShouldBeEmpty)
+
+		//the grant invalidates the cache. the new privilege is found at once.
+		ses.InvalidatePrivilegeCache()
+		sql2result = makeSql2ExecResult(0, rowsOfMoUserGrant,
+			[]int{0}, priv.entries, [][]interface{}{{0, true}},
+			nil, nil)
+		bhStub.StubFunc(&NewBackgroundExec, newBh(ctrl, sql2result))
+		ok, err = determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+	})
+}
+
+func Test_roleGrantCache(t *testing.T) {
+	convey.Convey("the mo_role_grant is queried once across the statements", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stmt := &tree.CreateAccount{}
+		priv := determinePrivilegeSetOfStatement(stmt)
+		ses := newSes(priv, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		rowsOfMoUserGrant := [][]interface{}{
+			{0, false},
+		}
+		//the role 0 inherits the role 1. the role 1 inherits the role 2.
+		//no role has the privilege.
+		sql2result := makeSql2ExecResult2(0, rowsOfMoUserGrant,
+			[]int{0, 1, 2}, priv.entries, [][][][]interface{}{{{}}, {{}}, {{}}},
+			[]int{0, 1, 2}, [][][]interface{}{{{1, true}}, {{2, true}}, {}}, nil, nil)
+
+		queriesOfInheritedRoles := func() int {
+			var executed []string
+			bhStub := gostub.StubFunc(&NewBackgroundExec, newBhWithExecutedSqls(ctrl, sql2result, &executed))
+			defer bhStub.Reset()
+
+			//the privilege cache is out of the measurement
+			ses.InvalidatePrivilegeCache()
+			ok, err := determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeFalse)
+
+			cnt := 0
+			for _, id := range []int64{0, 1, 2} {
+				for _, sql := range executed {
+					if sql == getSqlForInheritedRoleIdOfRoleId(id) {
+						cnt++
+					}
+				}
+			}
+			return cnt
+		}
+
+		//the first statement queries every role in the hierarchy
+		convey.So(queriesOfInheritedRoles(), convey.ShouldEqual, 3)
+		//the later statements are served by the cache
+		convey.So(queriesOfInheritedRoles(), convey.ShouldEqual, 0)
+		convey.So(queriesOfInheritedRoles(), convey.ShouldEqual, 0)
+		cache := ses.GetRoleGrantCache()
+		convey.So(cache.total.Load(), convey.ShouldEqual, 9)
+		convey.So(cache.hit.Load(), convey.ShouldEqual, 6)
+
+		//the grant role invalidates the cache
+		ses.InvalidateRoleGrantCache()
+		convey.So(queriesOfInheritedRoles(), convey.ShouldEqual, 3)
+	})
+
+	convey.Convey("the grant role reuses the mo_role_grant", t, func() {
+		cache := &roleGrantCache{}
+		ctx := defines.AttachAccountId(context.TODO(), 1)
+
+		var executed []string
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		sql2result := map[string]ExecResult{
+			getSqlForGetAllStuffRoleGrantFormat(): newMrsForGetAllStuffRoleGrant([][]interface{}{
+				{1, 2, true},
+				{2, 3, false},
+			}),
+		}
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		edges, err := getAllRoleGrants(ctx, bh, cache)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(edges, convey.ShouldResemble, []roleGrantEdge{
+			{grantedId: 1, granteeId: 2, withGrantOption: true},
+			{grantedId: 2, granteeId: 3},
+		})
+		convey.So(len(executed), convey.ShouldEqual, 1)
+
+		edges, err = getAllRoleGrants(ctx, bh, cache)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(edges), convey.ShouldEqual, 2)
+		convey.So(len(executed), convey.ShouldEqual, 1)
+
+		//other account does not share it
+		_, err = getAllRoleGrants(defines.AttachAccountId(context.TODO(), 2), bh, cache)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(executed), convey.ShouldEqual, 2)
+
+		cache.invalidate()
+		_, err = getAllRoleGrants(ctx, bh, cache)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(executed), convey.ShouldEqual, 3)
+	})
+}
+
 func Test_DropDatabaseOfAccount(t *testing.T) {
 	convey.Convey("drop account", t, func() {
 		var db string
@@ -8496,13 +14086,195 @@ func TestGetSqlForInsertIntoMoPubs(t *testing.T) {
 			err:          true,
 		},
 	}
-	for _, k := range kases {
-		_, err := getSqlForInsertIntoMoPubs(ctx, k.pubName, k.databaseName, 0, false, "", "", 1, 1, "", true)
-		require.Equal(t, k.err, err != nil)
+	for _, k := range kases {
+		_, err := getSqlForInsertIntoMoPubs(ctx, k.pubName, k.databaseName, 0, false, "", "", 1, 1, "", true)
+		require.Equal(t, k.err, err != nil)
+	}
+}
+
+func TestDoCreatePublication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	tenant := &TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		UserID:        rootID,
+		DefaultRoleID: moAdminRoleID,
+	}
+	ses.SetTenantInfo(tenant)
+
+	sa := &tree.CreatePublication{
+		Name:     "pub1",
+		Database: "db1",
+		Comment:  "124",
+		AccountsSet: &tree.AccountsSetOption{
+			SetAccounts: tree.IdentifierList{"a1", "a2"},
+		},
+	}
+	sql1, err := getSqlForGetDbIdAndType(ctx, string(sa.Database), true, 0)
+	require.NoError(t, err)
+	bh := &backgroundExecTest{}
+	bh.init()
+	sql2, err := getSqlForInsertIntoMoPubs(ctx, string(sa.Name), string(sa.Database), 0, true, "", "a1, a2", tenant.GetDefaultRoleID(), tenant.GetUserID(), sa.Comment, true)
+	require.NoError(t, err)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	bh.sql2result["begin;"] = nil
+	bh.sql2result[sql1] = &MysqlResultSet{
+		Columns: []Column{
+			&MysqlColumn{
+				ColumnImpl: ColumnImpl{
+					name:       "dat_id",
+					columnType: defines.MYSQL_TYPE_VARCHAR,
+				},
+			},
+			&MysqlColumn{
+				ColumnImpl: ColumnImpl{
+					name:       "dat_type",
+					columnType: defines.MYSQL_TYPE_VARCHAR,
+				},
+			},
+		},
+		Data: [][]interface{}{{0, ""}},
+	}
+	bh.sql2result[sql2] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	err = doCreatePublication(ctx, ses, sa)
+	require.NoError(t, err)
+}
+
+func TestDoCreatePublicationWithTables(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	tenant := &TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		UserID:        rootID,
+		DefaultRoleID: moAdminRoleID,
+	}
+	ses.SetTenantInfo(tenant)
+
+	cp := &tree.CreatePublication{
+		Name:     "pub1",
+		Database: "db1",
+		Tables:   tree.IdentifierList{"t2", "t1", "t2"},
+		AccountsSet: &tree.AccountsSetOption{
+			SetAccounts: tree.IdentifierList{"a1"},
+		},
+	}
+
+	sql2result := make(map[string]ExecResult)
+	sql, err := getSqlForGetDbIdAndType(ctx, "db1", true, uint64(sysAccountID))
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"dat_id", "dat_type"}, [][]interface{}{{100, ""}})
+	sql, err = getSqlForCheckDatabaseTable(ctx, "db1", "t1")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"rel_id", "account_id"}, [][]interface{}{{1001, sysAccountID}})
+	sql, err = getSqlForCheckDatabaseTable(ctx, "db1", "t2")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"rel_id", "account_id"}, [][]interface{}{{1002, sysAccountID}})
+	sql, err = getSqlForCheckDatabaseTable(ctx, "db1", "t3")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"rel_id", "account_id"}, nil)
+
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	// the table list is deduplicated and sorted
+	err = doCreatePublication(ctx, ses, cp)
+	require.NoError(t, err)
+	insertSql, err := getSqlForInsertIntoMoPubs(ctx, "pub1", "db1", 100, false, "t1,t2", "a1", tenant.GetDefaultRoleID(), tenant.GetUserID(), "", true)
+	require.NoError(t, err)
+	require.Contains(t, executed, insertSql)
+
+	// the table does not exist
+	executed = nil
+	cp.Tables = tree.IdentifierList{"t1", "t3"}
+	err = doCreatePublication(ctx, ses, cp)
+	require.Error(t, err)
+	for _, s := range executed {
+		require.NotContains(t, s, "insert into mo_catalog.mo_pubs")
+	}
+}
+
+func TestDoCreatePublicationStrictAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	tenant := &TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		UserID:        rootID,
+		DefaultRoleID: moAdminRoleID,
+	}
+	ses.SetTenantInfo(tenant)
+
+	cp := &tree.CreatePublication{
+		Name:     "pub1",
+		Database: "db1",
+		AccountsSet: &tree.AccountsSetOption{
+			SetAccounts: tree.IdentifierList{"a1", "a2", "a3"},
+		},
+	}
+
+	sql2result := make(map[string]ExecResult)
+	sql, err := getSqlForGetDbIdAndType(ctx, "db1", true, uint64(sysAccountID))
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"dat_id", "dat_type"}, [][]interface{}{{100, ""}})
+	sql, err = getSqlForAccountIdAndStatus(ctx, "a1", true)
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"account_id", "status"}, [][]interface{}{{1, "open"}})
+	for _, accName := range []string{"a2", "a3"} {
+		sql, err = getSqlForAccountIdAndStatus(ctx, accName, true)
+		require.NoError(t, err)
+		sql2result[sql] = newMrsForStrings([]string{"account_id", "status"}, nil)
 	}
+	insertSql, err := getSqlForInsertIntoMoPubs(ctx, "pub1", "db1", 100, true, "", "a1,a2,a3", tenant.GetDefaultRoleID(), tenant.GetUserID(), "", true)
+	require.NoError(t, err)
+
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	// the unknown accounts are allowed by default
+	err = doCreatePublication(ctx, ses, cp)
+	require.NoError(t, err)
+	require.Contains(t, executed, insertSql)
+
+	// the unknown accounts are rejected in the strict mode
+	ses.gSysVars = ses.gSysVars.Clone()
+	ses.gSysVars.Set(StrictPublicationAccounts, int64(1))
+	executed = nil
+	err = doCreatePublication(ctx, ses, cp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a2,a3")
+	require.NotContains(t, executed, insertSql)
 }
 
-func TestDoCreatePublication(t *testing.T) {
+func TestDoCreatePublicationWithAccountPattern(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	ctx := context.Background()
@@ -8519,47 +14291,35 @@ func TestDoCreatePublication(t *testing.T) {
 	}
 	ses.SetTenantInfo(tenant)
 
-	sa := &tree.CreatePublication{
+	cp := &tree.CreatePublication{
 		Name:     "pub1",
 		Database: "db1",
-		Comment:  "124",
 		AccountsSet: &tree.AccountsSetOption{
-			SetAccounts: tree.IdentifierList{"a1", "a2"},
+			SetAccounts: tree.IdentifierList{"a2", "a1"},
+			LikePattern: "Team_%",
 		},
 	}
-	sql1, err := getSqlForGetDbIdAndType(ctx, string(sa.Database), true, 0)
+
+	sql2result := make(map[string]ExecResult)
+	sql, err := getSqlForGetDbIdAndType(ctx, "db1", true, uint64(sysAccountID))
 	require.NoError(t, err)
-	bh := &backgroundExecTest{}
-	bh.init()
-	sql2, err := getSqlForInsertIntoMoPubs(ctx, string(sa.Name), string(sa.Database), 0, true, "", "a1, a2", tenant.GetDefaultRoleID(), tenant.GetUserID(), sa.Comment, true)
+	sql2result[sql] = newMrsForStrings([]string{"dat_id", "dat_type"}, [][]interface{}{{100, ""}})
+	insertSql, err := getSqlForInsertIntoMoPubs(ctx, "pub1", "db1", 100, true, "", "a1,a2,like:team_%", tenant.GetDefaultRoleID(), tenant.GetUserID(), "", true)
 	require.NoError(t, err)
+
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
 	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 	defer bhStub.Reset()
 
-	bh.sql2result["begin;"] = nil
-	bh.sql2result[sql1] = &MysqlResultSet{
-		Columns: []Column{
-			&MysqlColumn{
-				ColumnImpl: ColumnImpl{
-					name:       "dat_id",
-					columnType: defines.MYSQL_TYPE_VARCHAR,
-				},
-			},
-			&MysqlColumn{
-				ColumnImpl: ColumnImpl{
-					name:       "dat_type",
-					columnType: defines.MYSQL_TYPE_VARCHAR,
-				},
-			},
-		},
-		Data: [][]interface{}{{0, ""}},
-	}
-	bh.sql2result[sql2] = nil
-	bh.sql2result["commit;"] = nil
-	bh.sql2result["rollback;"] = nil
-
-	err = doCreatePublication(ctx, ses, sa)
+	err = doCreatePublication(ctx, ses, cp)
 	require.NoError(t, err)
+	require.Contains(t, executed, insertSql)
+
+	// the pattern only accepts the characters of the account name and the '%'
+	cp.AccountsSet.LikePattern = "team*"
+	err = doCreatePublication(ctx, ses, cp)
+	require.Error(t, err)
 }
 
 func TestDoDropPublication(t *testing.T) {
@@ -8741,6 +14501,15 @@ func TestDoAlterPublication(t *testing.T) {
 			data:        [][]any{{"a0", "121", "db1", 1}},
 			err:         false,
 		},
+		{
+			pubName: "pub1",
+			comment: "124",
+			dbName:  "mo_catalog",
+			dbId:    3,
+			dbType:  "",
+			data:    [][]any{{"all", "121", "db1", 1}},
+			err:     true,
+		},
 	}
 
 	for _, kase := range kases {
@@ -8787,6 +14556,176 @@ func TestDoAlterPublication(t *testing.T) {
 
 }
 
+func TestGetSubscribersOfPublication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	tenant := &TenantInfo{
+		Tenant:        "acc1",
+		User:          "admin",
+		DefaultRole:   accountAdminRoleName,
+		TenantID:      1,
+		UserID:        2,
+		DefaultRoleID: accountAdminRoleID,
+	}
+	ses.SetTenantInfo(tenant)
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	newMrsForAccountNames := func(names ...string) *MysqlResultSet {
+		mrs := &MysqlResultSet{}
+		col := &MysqlColumn{}
+		col.SetName("account_name")
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+		for _, name := range names {
+			mrs.AddRow([]interface{}{name})
+		}
+		return mrs
+	}
+	setPubAccountList := func(accountList string) {
+		sql, err := getSqlForGetPubInfo(ctx, "pub1", true)
+		require.NoError(t, err)
+		mrs := newMrsForAccountNames(accountList)
+		mrs.Columns[0].SetName("account_list")
+		bh.sql2result[sql] = mrs
+	}
+	setAccountExists := func(name string, exists bool) {
+		sql, err := getSqlForAccountIdAndStatus(ctx, name, true)
+		require.NoError(t, err)
+		if exists {
+			bh.sql2result[sql] = &MysqlResultSet{
+				Data: [][]any{{10, "open"}},
+			}
+		} else {
+			bh.sql2result[sql] = &MysqlResultSet{}
+		}
+	}
+
+	// the explicit account list
+	setPubAccountList("acc2,acc3")
+	setAccountExists("acc2", true)
+	setAccountExists("acc3", true)
+	accounts, err := getSubscribersOfPublication(ctx, ses, "pub1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"acc2", "acc3"}, accounts)
+
+	// the account in the list was dropped
+	setAccountExists("acc3", false)
+	_, err = getSubscribersOfPublication(ctx, ses, "pub1")
+	require.Error(t, err)
+
+	// all accounts
+	setPubAccountList("all")
+	bh.sql2result[getSqlForNonSysAccountNames()] = newMrsForAccountNames("acc1", "acc2")
+	accounts, err = getSubscribersOfPublication(ctx, ses, "pub1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"acc1", "acc2"}, accounts)
+
+	// the new account is in the all accounts
+	bh.sql2result[getSqlForNonSysAccountNames()] = newMrsForAccountNames("acc1", "acc2", "acc4")
+	accounts, err = getSubscribersOfPublication(ctx, ses, "pub1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"acc1", "acc2", "acc4"}, accounts)
+
+	// the publication does not exist
+	sql, err := getSqlForGetPubInfo(ctx, "pub2", true)
+	require.NoError(t, err)
+	bh.sql2result[sql] = &MysqlResultSet{}
+	_, err = getSubscribersOfPublication(ctx, ses, "pub2")
+	require.Error(t, err)
+
+	// only the admin
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        "acc1",
+		User:          "u1",
+		DefaultRole:   "r1",
+		TenantID:      1,
+		UserID:        3,
+		DefaultRoleID: 5,
+	})
+	_, err = getSubscribersOfPublication(ctx, ses, "pub1")
+	require.Error(t, err)
+}
+
+func TestGetExposedTablesOfPublication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	tenant := &TenantInfo{
+		Tenant:        "acc1",
+		User:          "admin",
+		DefaultRole:   accountAdminRoleName,
+		TenantID:      1,
+		UserID:        2,
+		DefaultRoleID: accountAdminRoleID,
+	}
+	ses.SetTenantInfo(tenant)
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	setPubTables := func(allTable bool, tableList string) {
+		sql, err := getSqlForGetPubTables(ctx, "pub1", true)
+		require.NoError(t, err)
+		bh.sql2result[sql] = &MysqlResultSet{
+			Data: [][]any{{"db1", allTable, tableList}},
+		}
+	}
+	sql, err := getSqlForGetTablesOfDatabase(ctx, "db1", 1)
+	require.NoError(t, err)
+	bh.sql2result[sql] = &MysqlResultSet{
+		Data: [][]any{{1001, "t1"}, {1002, "t2"}, {1003, "t3"}},
+	}
+
+	// the whole database
+	setPubTables(true, "")
+	tables, missing, err := getExposedTablesOfPublication(ctx, ses, "pub1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t1", "t2", "t3"}, tables)
+	require.Empty(t, missing)
+
+	// the subset of the tables
+	setPubTables(false, "t1,t3")
+	tables, missing, err = getExposedTablesOfPublication(ctx, ses, "pub1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t1", "t3"}, tables)
+	require.Empty(t, missing)
+
+	// the table in the subset was dropped
+	setPubTables(false, "t1,t4")
+	tables, missing, err = getExposedTablesOfPublication(ctx, ses, "pub1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t1"}, tables)
+	require.Equal(t, []string{"t4"}, missing)
+
+	// the publication does not exist
+	sql, err = getSqlForGetPubTables(ctx, "pub2", true)
+	require.NoError(t, err)
+	bh.sql2result[sql] = &MysqlResultSet{}
+	_, _, err = getExposedTablesOfPublication(ctx, ses, "pub2")
+	require.Error(t, err)
+}
+
 func TestCheckSubscriptionValid(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -8966,56 +14905,219 @@ func TestCheckSubscriptionValid(t *testing.T) {
 			databaseName: "t1",
 			accountList:  "sys",
 
-			sqls: []string{},
-			err:  true,
+			sqls: []string{},
+			err:  true,
+		},
+	}
+
+	initData := func(idx int) {
+		sql1, _ := getSqlForAccountIdAndStatus(ctx, kases[idx].accName, true)
+		sql2, _ := getSqlForPubInfoForSub(ctx, kases[idx].pubName, true)
+		kases[idx].sqls = []string{
+			sql1, sql2,
+		}
+		kases[idx].datas = [][][]interface{}{
+			{{kases[idx].accId, kases[idx].accStatus}},
+			{{kases[idx].databaseName, kases[idx].accountList, "true", ""}},
+		}
+
+		if !kases[idx].accExists {
+			kases[idx].datas[0] = nil
+		}
+		if !kases[idx].pubExists {
+			kases[idx].datas[1] = nil
+		}
+	}
+
+	for idx := range kases {
+		initData(idx)
+
+		bh := &backgroundExecTest{}
+		bh.init()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+		for i := range kases[idx].sqls {
+			bh.sql2result[kases[idx].sqls[i]] = &MysqlResultSet{
+				Data:    kases[idx].datas[i],
+				Columns: columns[i],
+			}
+		}
+
+		_, err := checkSubscriptionValid(ctx, ses, kases[idx].createSql)
+		if kases[idx].err {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+}
+
+func TestCheckSubscriptionValidWithTables(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        "acc1",
+		User:          "admin",
+		DefaultRole:   accountAdminRoleName,
+		TenantID:      2,
+		UserID:        2,
+		DefaultRoleID: accountAdminRoleID,
+	})
+
+	sql2result := make(map[string]ExecResult)
+	sql, err := getSqlForAccountIdAndStatus(ctx, "acc0", true)
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"account_id", "status"}, [][]interface{}{{1, "open"}})
+	pubInfoForSubSql, err := getSqlForPubInfoForSub(ctx, "pub1", true)
+	require.NoError(t, err)
+
+	var executed []string
+	bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	// the publication of the tables
+	sql2result[pubInfoForSubSql] = newMrsForStrings([]string{"database_name", "account_list", "all_table", "table_list"}, [][]interface{}{{"db1", "all", "false", "t1,t2"}})
+	sub, err := checkSubscriptionValidCommon(ctx, ses, "sub1", "acc0", "pub1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t1", "t2"}, sub.Tables)
+
+	// the publication of the whole database
+	sql2result[pubInfoForSubSql] = newMrsForStrings([]string{"database_name", "account_list", "all_table", "table_list"}, [][]interface{}{{"db1", "all", "true", ""}})
+	sub, err = checkSubscriptionValidCommon(ctx, ses, "sub1", "acc0", "pub1")
+	require.NoError(t, err)
+	require.Empty(t, sub.Tables)
+}
+
+func TestGetSubscriptionMetaAfterAlterPublication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := defines.AttachAccountId(context.TODO(), 2)
+	ses := newTestSession(t, ctrl)
+	_ = ses.SetGlobalSysVar(context.TODO(), "lower_case_table_names", int64(1))
+	defer ses.Close()
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        "acc1",
+		User:          "admin",
+		DefaultRole:   accountAdminRoleName,
+		TenantID:      2,
+		UserID:        2,
+		DefaultRoleID: accountAdminRoleID,
+	})
+
+	// the publisher
+	pubSes := newTestSession(t, ctrl)
+	defer pubSes.Close()
+	pubSes.SetTenantInfo(&TenantInfo{
+		Tenant:        "acc0",
+		User:          "admin",
+		DefaultRole:   accountAdminRoleName,
+		TenantID:      1,
+		UserID:        2,
+		DefaultRoleID: accountAdminRoleID,
+	})
+
+	dbMeta := mock_frontend.NewMockDatabase(ctrl)
+	dbMeta.EXPECT().IsSubscription(gomock.Any()).Return(true).AnyTimes()
+	dbMeta.EXPECT().GetCreateSql(gomock.Any()).Return("create database sub1 from acc0 publication pub1").AnyTimes()
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().Database(gomock.Any(), gomock.Any(), gomock.Any()).Return(dbMeta, nil).AnyTimes()
+	oldEng := getGlobalPu().StorageEngine
+	getGlobalPu().StorageEngine = eng
+	defer func() {
+		getGlobalPu().StorageEngine = oldEng
+	}()
+
+	txnOp := mock_frontend.NewMockTxnOperator(ctrl)
+	txnOp.EXPECT().Txn().Return(txn.TxnMeta{ID: []byte("txn1")}).AnyTimes()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	sql, err := getSqlForAccountIdAndStatus(ctx, "acc0", true)
+	require.NoError(t, err)
+	bh.sql2result[sql] = &MysqlResultSet{
+		Columns: []Column{
+			&MysqlColumn{ColumnImpl: ColumnImpl{name: "account_id", columnType: defines.MYSQL_TYPE_LONGLONG}},
+			&MysqlColumn{ColumnImpl: ColumnImpl{name: "status", columnType: defines.MYSQL_TYPE_VARCHAR}},
 		},
+		Data: [][]interface{}{{1, "open"}},
 	}
-
-	initData := func(idx int) {
-		sql1, _ := getSqlForAccountIdAndStatus(ctx, kases[idx].accName, true)
-		sql2, _ := getSqlForPubInfoForSub(ctx, kases[idx].pubName, true)
-		kases[idx].sqls = []string{
-			sql1, sql2,
-		}
-		kases[idx].datas = [][][]interface{}{
-			{{kases[idx].accId, kases[idx].accStatus}},
-			{{kases[idx].databaseName, kases[idx].accountList}},
-		}
-
-		if !kases[idx].accExists {
-			kases[idx].datas[0] = nil
+	pubInfoForSubSql, err := getSqlForPubInfoForSub(ctx, "pub1", true)
+	require.NoError(t, err)
+	setAccountList := func(accountList string) {
+		bh.sql2result[pubInfoForSubSql] = &MysqlResultSet{
+			Columns: []Column{
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "database_name", columnType: defines.MYSQL_TYPE_VARCHAR}},
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "account_list", columnType: defines.MYSQL_TYPE_VARCHAR}},
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "all_table", columnType: defines.MYSQL_TYPE_BOOL}},
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "table_list", columnType: defines.MYSQL_TYPE_VARCHAR}},
+			},
+			Data: [][]interface{}{{"db1", accountList, true, ""}},
 		}
-		if !kases[idx].pubExists {
-			kases[idx].datas[1] = nil
+		sql, err := getSqlForGetPubInfo(ctx, "pub1", true)
+		require.NoError(t, err)
+		bh.sql2result[sql] = &MysqlResultSet{
+			Columns: []Column{
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "account_list", columnType: defines.MYSQL_TYPE_VARCHAR}},
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "comment", columnType: defines.MYSQL_TYPE_VARCHAR}},
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "database_name", columnType: defines.MYSQL_TYPE_VARCHAR}},
+				&MysqlColumn{ColumnImpl: ColumnImpl{name: "database_id", columnType: defines.MYSQL_TYPE_LONGLONG}},
+			},
+			Data: [][]interface{}{{accountList, "", "db1", 1}},
 		}
 	}
+	setAccountList("acc1,acc2")
 
-	for idx := range kases {
-		initData(idx)
+	sub, err := getSubscriptionMeta(ctx, "sub1", ses, txnOp)
+	require.NoError(t, err)
+	require.Equal(t, "db1", sub.DbName)
 
-		bh := &backgroundExecTest{}
-		bh.init()
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
+	// the meta is cached in the txn
+	setAccountList("acc2")
+	sub, err = getSubscriptionMeta(ctx, "sub1", ses, txnOp)
+	require.NoError(t, err)
+	require.Equal(t, "db1", sub.DbName)
 
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
-		for i := range kases[idx].sqls {
-			bh.sql2result[kases[idx].sqls[i]] = &MysqlResultSet{
-				Data:    kases[idx].datas[i],
-				Columns: columns[i],
-			}
-		}
+	// the publisher removes the subscriber from the account list
+	setAccountList("acc1,acc2")
+	err = doAlterPublication(ctx, pubSes, &tree.AlterPublication{
+		Name: "pub1",
+		AccountsSet: &tree.AccountsSetOption{
+			DropAccounts: tree.IdentifierList{tree.Identifier("acc1")},
+		},
+	})
+	require.NoError(t, err)
+	setAccountList("acc2")
 
-		_, err := checkSubscriptionValid(ctx, ses, kases[idx].createSql)
-		if kases[idx].err {
-			require.Error(t, err)
-		} else {
-			require.NoError(t, err)
-		}
-	}
+	// the next check of the subscriber reflects the removal
+	_, err = getSubscriptionMeta(ctx, "sub1", ses, txnOp)
+	require.Error(t, err)
 
+	// the publisher adds the subscriber back then drops the publication
+	setAccountList("acc1,acc2")
+	increasePublicationEpoch()
+	_, err = getSubscriptionMeta(ctx, "sub1", ses, txnOp)
+	require.NoError(t, err)
+	err = doDropPublication(ctx, pubSes, &tree.DropPublication{Name: "pub1"})
+	require.NoError(t, err)
+	bh.sql2result[pubInfoForSubSql] = &MysqlResultSet{}
+	_, err = getSubscriptionMeta(ctx, "sub1", ses, txnOp)
+	require.Error(t, err)
 }
 
 func TestDoCheckRole(t *testing.T) {
@@ -9103,6 +15205,9 @@ func TestCheckRoleWhetherTableOwner(t *testing.T) {
 		}
 		ses.SetTenantInfo(tenant)
 
+		//the ownership has never been granted
+		bh.sql2result[getSqlForGetGrantedOwnerOfTable("db1", "t1")] = newMrsForPasswordOfUser([][]interface{}{})
+
 		sql := getSqlForGetOwnerOfTable("db1", "t1")
 		mrs := newMrsForPasswordOfUser([][]interface{}{
 			{0},
@@ -9144,6 +15249,9 @@ func TestCheckRoleWhetherTableOwner(t *testing.T) {
 		}
 		ses.SetTenantInfo(tenant)
 
+		//the ownership has never been granted
+		bh.sql2result[getSqlForGetGrantedOwnerOfTable("db1", "t1")] = newMrsForPasswordOfUser([][]interface{}{})
+
 		sql := getSqlForGetOwnerOfTable("db1", "t1")
 		mrs := newMrsForPasswordOfUser([][]interface{}{
 			{0},
@@ -9191,6 +15299,9 @@ func TestCheckRoleWhetherTableOwner(t *testing.T) {
 		}
 		ses.SetTenantInfo(tenant)
 
+		//the ownership has never been granted
+		bh.sql2result[getSqlForGetGrantedOwnerOfTable("db1", "t1")] = newMrsForPasswordOfUser([][]interface{}{})
+
 		sql := getSqlForGetOwnerOfTable("db1", "t1")
 		mrs := newMrsForPasswordOfUser([][]interface{}{
 			{1},
@@ -9234,6 +15345,9 @@ func TestCheckRoleWhetherDatabaseOwner(t *testing.T) {
 		}
 		ses.SetTenantInfo(tenant)
 
+		//the ownership has never been granted
+		bh.sql2result[getSqlForGetGrantedOwnerOfDatabase("db1")] = newMrsForPasswordOfUser([][]interface{}{})
+
 		sql := getSqlForGetOwnerOfDatabase("db1")
 		mrs := newMrsForPasswordOfUser([][]interface{}{
 			{0},
@@ -9276,6 +15390,9 @@ func TestCheckRoleWhetherDatabaseOwner(t *testing.T) {
 		}
 		ses.SetTenantInfo(tenant)
 
+		//the ownership has never been granted
+		bh.sql2result[getSqlForGetGrantedOwnerOfDatabase("db1")] = newMrsForPasswordOfUser([][]interface{}{})
+
 		sql := getSqlForGetOwnerOfDatabase("db1")
 		mrs := newMrsForPasswordOfUser([][]interface{}{
 			{0},
@@ -9323,6 +15440,9 @@ func TestCheckRoleWhetherDatabaseOwner(t *testing.T) {
 		}
 		ses.SetTenantInfo(tenant)
 
+		//the ownership has never been granted
+		bh.sql2result[getSqlForGetGrantedOwnerOfDatabase("db1")] = newMrsForPasswordOfUser([][]interface{}{})
+
 		sql := getSqlForGetOwnerOfDatabase("db1")
 		mrs := newMrsForPasswordOfUser([][]interface{}{
 			{1},
@@ -9405,6 +15525,11 @@ func TestDoAlterDatabaseConfig(t *testing.T) {
 		})
 		bh.sql2result[sql] = mrs
 
+		sql, _ = getSqlForGetVariableRowsByDbNameAndAccountName(ctx, ses.GetTenantInfo().GetTenant(), ad.DbName, "version_compatibility")
+		bh.sql2result[sql] = newMrsForStrings([]string{"account_id", "account_name", "dat_name", "variable_value"}, [][]interface{}{
+			{sysAccountID, sysAccountName, ad.DbName, "0.8"},
+		})
+
 		sql, _ = getSqlForupdateConfigurationByDbNameAndAccountName(ctx, ad.UpdateConfig, ses.GetTenantInfo().GetTenant(), ad.DbName, "version_compatibility")
 		mrs = newMrsForPasswordOfUser([][]interface{}{{}})
 		bh.sql2result[sql] = mrs
@@ -9518,6 +15643,9 @@ func TestDoAlterAccountConfig(t *testing.T) {
 		})
 		bh.sql2result[sql] = mrs
 
+		sql, _ = getSqlForGetVariableRowsByAccountName(ctx, ad.AccountName, "version_compatibility")
+		bh.sql2result[sql] = newMrsForStrings([]string{"account_id", "account_name", "dat_name", "variable_value"}, nil)
+
 		sql, _ = getSqlForupdateConfigurationByAccount(ctx, ad.UpdateConfig, ses.GetTenantInfo().GetTenant(), "version_compatibility")
 		mrs = newMrsForPasswordOfUser([][]interface{}{{}})
 		bh.sql2result[sql] = mrs
@@ -11424,13 +17552,200 @@ func TestDoDropSnapshot(t *testing.T) {
 		mrs = newMrsForPasswordOfUser([][]interface{}{})
 		bh.sql2result[sql] = mrs
 
-		err := doDropSnapshot(ctx, ses, ds)
-		convey.So(err, convey.ShouldNotBeNil)
+		err := doDropSnapshot(ctx, ses, ds)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestDoCreateSnapshot(t *testing.T) {
+	convey.Convey("doCreateSnapshot success", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newTestSession(t, ctrl)
+		defer ses.Close()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		setGlobalPu(pu)
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		tenant := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      sysAccountID,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
+		}
+		ses.SetTenantInfo(tenant)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		timeStamp, _ := timestamp.ParseTimestamp("2021-01-01 00:00:00")
+		txnOperator.EXPECT().SnapshotTS().Return(timeStamp).AnyTimes()
+		// process.
+		ses.proc = testutil.NewProc()
+		ses.proc.Base.TxnOperator = txnOperator
+		cs := &tree.CreateSnapShot{
+			IfNotExists: false,
+			Name:        tree.Identifier("snapshot_test"),
+			Object: tree.ObjectInfo{
+				SLevel: tree.SnapshotLevelType{
+					Level: tree.SNAPSHOTLEVELCLUSTER,
+				},
+				ObjName: "",
+			},
+		}
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
+		mrs := newMrsForPasswordOfUser([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		err := doCreateSnapshot(ctx, ses, cs)
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	// non-system tenant can't create cluster level snapshot
+	convey.Convey("doCreateSnapshot fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newTestSession(t, ctrl)
+		defer ses.Close()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		setGlobalPu(pu)
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		tenant := &TenantInfo{
+			Tenant:        "test_account",
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      sysAccountID,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
+		}
+		ses.SetTenantInfo(tenant)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		timeStamp, _ := timestamp.ParseTimestamp("2021-01-01 00:00:00")
+		txnOperator.EXPECT().SnapshotTS().Return(timeStamp).AnyTimes()
+		// process.
+		ses.proc = testutil.NewProc()
+		ses.proc.Base.TxnOperator = txnOperator
+		cs := &tree.CreateSnapShot{
+			IfNotExists: false,
+			Name:        tree.Identifier("snapshot_test"),
+			Object: tree.ObjectInfo{
+				SLevel: tree.SnapshotLevelType{
+					Level: tree.SNAPSHOTLEVELCLUSTER,
+				},
+				ObjName: "",
+			},
+		}
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
+		mrs := newMrsForPasswordOfUser([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		err := doCreateSnapshot(ctx, ses, cs)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	// system tenant create account level snapshot for other tenant
+	convey.Convey("doCreateSnapshot success", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newTestSession(t, ctrl)
+		defer ses.Close()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		setGlobalPu(pu)
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		tenant := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      sysAccountID,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
+		}
+		ses.SetTenantInfo(tenant)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		timeStamp, _ := timestamp.ParseTimestamp("2021-01-01 00:00:00")
+		txnOperator.EXPECT().SnapshotTS().Return(timeStamp).AnyTimes()
+		// process.
+		ses.proc = testutil.NewProc()
+		ses.proc.Base.TxnOperator = txnOperator
+		cs := &tree.CreateSnapShot{
+			IfNotExists: false,
+			Name:        tree.Identifier("snapshot_test"),
+			Object: tree.ObjectInfo{
+				SLevel: tree.SnapshotLevelType{
+					Level: tree.SNAPSHOTLEVELACCOUNT,
+				},
+				ObjName: "acc1",
+			},
+		}
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
+		mrs := newMrsForPasswordOfUser([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
+		mrs2 := newMrsForPasswordOfUser([][]interface{}{{1}})
+		bh.sql2result[sql2] = mrs2
+
+		err := doCreateSnapshot(ctx, ses, cs)
+		convey.So(err, convey.ShouldBeNil)
 	})
-}
 
-func TestDoCreateSnapshot(t *testing.T) {
-	convey.Convey("doCreateSnapshot success", t, func() {
+	// non-system tenant can't create acccount level snapshot for other tenant
+	convey.Convey("doCreateSnapshot fail", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -11451,7 +17766,7 @@ func TestDoCreateSnapshot(t *testing.T) {
 		ses.rm = rm
 
 		tenant := &TenantInfo{
-			Tenant:        sysAccountName,
+			Tenant:        "test_account",
 			User:          rootName,
 			DefaultRole:   moAdminRoleName,
 			TenantID:      sysAccountID,
@@ -11471,9 +17786,9 @@ func TestDoCreateSnapshot(t *testing.T) {
 			Name:        tree.Identifier("snapshot_test"),
 			Object: tree.ObjectInfo{
 				SLevel: tree.SnapshotLevelType{
-					Level: tree.SNAPSHOTLEVELCLUSTER,
+					Level: tree.SNAPSHOTLEVELACCOUNT,
 				},
-				ObjName: "",
+				ObjName: "acc1",
 			},
 		}
 
@@ -11486,11 +17801,15 @@ func TestDoCreateSnapshot(t *testing.T) {
 		mrs := newMrsForPasswordOfUser([][]interface{}{})
 		bh.sql2result[sql] = mrs
 
+		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
+		mrs2 := newMrsForPasswordOfUser([][]interface{}{{1}})
+		bh.sql2result[sql2] = mrs2
+
 		err := doCreateSnapshot(ctx, ses, cs)
-		convey.So(err, convey.ShouldBeNil)
+		convey.So(err, convey.ShouldNotBeNil)
 	})
 
-	// non-system tenant can't create cluster level snapshot
+	// no-admin user can't create acccount level snapshot for himself tenant
 	convey.Convey("doCreateSnapshot fail", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -11514,7 +17833,7 @@ func TestDoCreateSnapshot(t *testing.T) {
 		tenant := &TenantInfo{
 			Tenant:        "test_account",
 			User:          rootName,
-			DefaultRole:   moAdminRoleName,
+			DefaultRole:   "test_role",
 			TenantID:      sysAccountID,
 			UserID:        rootID,
 			DefaultRoleID: moAdminRoleID,
@@ -11532,9 +17851,9 @@ func TestDoCreateSnapshot(t *testing.T) {
 			Name:        tree.Identifier("snapshot_test"),
 			Object: tree.ObjectInfo{
 				SLevel: tree.SnapshotLevelType{
-					Level: tree.SNAPSHOTLEVELCLUSTER,
+					Level: tree.SNAPSHOTLEVELACCOUNT,
 				},
-				ObjName: "",
+				ObjName: "test_account",
 			},
 		}
 
@@ -11547,12 +17866,17 @@ func TestDoCreateSnapshot(t *testing.T) {
 		mrs := newMrsForPasswordOfUser([][]interface{}{})
 		bh.sql2result[sql] = mrs
 
+		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
+		mrs2 := newMrsForPasswordOfUser([][]interface{}{{1}})
+		bh.sql2result[sql2] = mrs2
+
 		err := doCreateSnapshot(ctx, ses, cs)
 		convey.So(err, convey.ShouldNotBeNil)
 	})
 
-	// system tenant create account level snapshot for other tenant
-	convey.Convey("doCreateSnapshot success", t, func() {
+	// system tenant  create account level snapshot for other tenant
+	// but the snapshot name already exists
+	convey.Convey("doCreateSnapshot fail", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -11605,7 +17929,7 @@ func TestDoCreateSnapshot(t *testing.T) {
 		bh.sql2result["rollback;"] = nil
 
 		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
-		mrs := newMrsForPasswordOfUser([][]interface{}{})
+		mrs := newMrsForPasswordOfUser([][]interface{}{{1}})
 		bh.sql2result[sql] = mrs
 
 		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
@@ -11613,10 +17937,11 @@ func TestDoCreateSnapshot(t *testing.T) {
 		bh.sql2result[sql2] = mrs2
 
 		err := doCreateSnapshot(ctx, ses, cs)
-		convey.So(err, convey.ShouldBeNil)
+		convey.So(err, convey.ShouldNotBeNil)
 	})
 
-	// non-system tenant can't create acccount level snapshot for other tenant
+	// system tenant  create account level snapshot for other tenant
+	// but the account not exists
 	convey.Convey("doCreateSnapshot fail", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -11638,7 +17963,7 @@ func TestDoCreateSnapshot(t *testing.T) {
 		ses.rm = rm
 
 		tenant := &TenantInfo{
-			Tenant:        "test_account",
+			Tenant:        sysAccountName,
 			User:          rootName,
 			DefaultRole:   moAdminRoleName,
 			TenantID:      sysAccountID,
@@ -11670,7 +17995,7 @@ func TestDoCreateSnapshot(t *testing.T) {
 		bh.sql2result["rollback;"] = nil
 
 		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
-		mrs := newMrsForPasswordOfUser([][]interface{}{})
+		mrs := newMrsForPasswordOfUser([][]interface{}{{}})
 		bh.sql2result[sql] = mrs
 
 		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
@@ -11680,9 +18005,10 @@ func TestDoCreateSnapshot(t *testing.T) {
 		err := doCreateSnapshot(ctx, ses, cs)
 		convey.So(err, convey.ShouldNotBeNil)
 	})
+}
 
-	// no-admin user can't create acccount level snapshot for himself tenant
-	convey.Convey("doCreateSnapshot fail", t, func() {
+func TestDoResolveSnapshotTsWithSnapShotName(t *testing.T) {
+	convey.Convey("doResolveSnapshotWithSnapshotName success", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -11703,52 +18029,31 @@ func TestDoCreateSnapshot(t *testing.T) {
 		ses.rm = rm
 
 		tenant := &TenantInfo{
-			Tenant:        "test_account",
+			Tenant:        sysAccountName,
 			User:          rootName,
-			DefaultRole:   "test_role",
+			DefaultRole:   moAdminRoleName,
 			TenantID:      sysAccountID,
 			UserID:        rootID,
 			DefaultRoleID: moAdminRoleID,
 		}
 		ses.SetTenantInfo(tenant)
-		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
-		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
-		timeStamp, _ := timestamp.ParseTimestamp("2021-01-01 00:00:00")
-		txnOperator.EXPECT().SnapshotTS().Return(timeStamp).AnyTimes()
-		// process.
-		ses.proc = testutil.NewProc()
-		ses.proc.Base.TxnOperator = txnOperator
-		cs := &tree.CreateSnapShot{
-			IfNotExists: false,
-			Name:        tree.Identifier("snapshot_test"),
-			Object: tree.ObjectInfo{
-				SLevel: tree.SnapshotLevelType{
-					Level: tree.SNAPSHOTLEVELACCOUNT,
-				},
-				ObjName: "test_account",
-			},
-		}
 
 		//no result set
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
 
-		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
+		sql, _ := getSqlForGetSnapshotTsWithSnapshotName(ctx, "test_sp")
 		mrs := newMrsForPasswordOfUser([][]interface{}{})
 		bh.sql2result[sql] = mrs
 
-		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
-		mrs2 := newMrsForPasswordOfUser([][]interface{}{{1}})
-		bh.sql2result[sql2] = mrs2
-
-		err := doCreateSnapshot(ctx, ses, cs)
+		_, err := doResolveSnapshotWithSnapshotName(ctx, ses, "test_sp")
 		convey.So(err, convey.ShouldNotBeNil)
 	})
+}
 
-	// system tenant  create account level snapshot for other tenant
-	// but the snapshot name already exists
-	convey.Convey("doCreateSnapshot fail", t, func() {
+func TestCheckTimeStampValid(t *testing.T) {
+	convey.Convey("checkTimeStampValid success", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -11777,239 +18082,731 @@ func TestDoCreateSnapshot(t *testing.T) {
 			DefaultRoleID: moAdminRoleID,
 		}
 		ses.SetTenantInfo(tenant)
-		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
-		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
-		timeStamp, _ := timestamp.ParseTimestamp("2021-01-01 00:00:00")
-		txnOperator.EXPECT().SnapshotTS().Return(timeStamp).AnyTimes()
-		// process.
-		ses.proc = testutil.NewProc()
-		ses.proc.Base.TxnOperator = txnOperator
-		cs := &tree.CreateSnapShot{
-			IfNotExists: false,
-			Name:        tree.Identifier("snapshot_test"),
-			Object: tree.ObjectInfo{
-				SLevel: tree.SnapshotLevelType{
-					Level: tree.SNAPSHOTLEVELACCOUNT,
-				},
-				ObjName: "acc1",
-			},
+
+		//no result set
+		bh.sql2result["begin;"] = nil
+		bh.sql2result["commit;"] = nil
+		bh.sql2result["rollback;"] = nil
+
+		sql := getSqlForCheckSnapshotTs(1713235646865937000)
+		mrs := newMrsForPasswordOfUser([][]interface{}{})
+		bh.sql2result[sql] = mrs
+
+		valid, err := checkTimeStampValid(ctx, ses, 1713235646865937000)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(valid, convey.ShouldBeFalse)
+	})
+
+	convey.Convey("checkTimeStampValid success", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newTestSession(t, ctrl)
+		defer ses.Close()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+		pu.SV.SetDefaultValues()
+		setGlobalPu(pu)
+		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+		rm, _ := NewRoutineManager(ctx)
+		ses.rm = rm
+
+		tenant := &TenantInfo{
+			Tenant:        sysAccountName,
+			User:          rootName,
+			DefaultRole:   moAdminRoleName,
+			TenantID:      sysAccountID,
+			UserID:        rootID,
+			DefaultRoleID: moAdminRoleID,
 		}
+		ses.SetTenantInfo(tenant)
 
 		//no result set
 		bh.sql2result["begin;"] = nil
 		bh.sql2result["commit;"] = nil
 		bh.sql2result["rollback;"] = nil
 
-		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
-		mrs := newMrsForPasswordOfUser([][]interface{}{{1}})
+		sql := getSqlForCheckSnapshotTs(1713235646865937000)
+		mrs := newMrsForPasswordOfUser([][]interface{}{{"018ee4cd-5991-7caa-b75d-f9290144bd9f"}})
 		bh.sql2result[sql] = mrs
 
-		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
-		mrs2 := newMrsForPasswordOfUser([][]interface{}{{1}})
-		bh.sql2result[sql2] = mrs2
+		valid, err := checkTimeStampValid(ctx, ses, 1713235646865937000)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(valid, convey.ShouldBeTrue)
+	})
+}
+
+func Test_copyTemplateOfAccount(t *testing.T) {
+	convey.Convey("copy the template account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.TODO()
+		sql2result := make(map[string]ExecResult)
+
+		sql, _ := getSqlForCheckTenant(ctx, "golden")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+			{int64(5), "golden", "open", 0},
+		})
+		sql2result[getRolesOfAccountSql] = newMrsForStrings([]string{"role_id", "role_name", "comments"}, [][]interface{}{
+			{int64(publicRoleID), publicRoleName, ""},
+			{int64(accountAdminRoleID), accountAdminRoleName, ""},
+			{int64(10), "r1", "golden role"},
+		})
+		sql2result[getSqlForPrivilegesOfRole(10)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"account", 0, int64(PrivilegeTypeCreateUser), "create user", "*", false},
+			{"database", 100, int64(PrivilegeTypeCreateTable), "create table", "d", true},
+		})
+		sql, _ = getSqlForRoleIdOfRole(ctx, "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{int64(20)},
+		})
+		sql2result[getSqlForGetSystemVariablesWithAccount(5)] = newMrsForStrings([]string{"variable_name", "variable_value"}, [][]interface{}{
+			{"save_query_result", "on"},
+			{"time_zone", "+09:00"},
+			{"sql_mode", "ONLY_FULL_GROUP_BY"},
+		})
+		sql2result[getSqlForGetSysVarWithAccount(6, "save_query_result")] = newMrsForStrings([]string{"variable_value"}, [][]interface{}{
+			{"off"},
+		})
+		sql2result[getSqlForGetSysVarWithAccount(6, "sql_mode")] = newMrsForStrings([]string{"variable_value"}, [][]interface{}{})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		newTenant := &TenantInfo{
+			Tenant:        "test",
+			User:          "test_root",
+			DefaultRole:   accountAdminRoleName,
+			TenantID:      6,
+			UserID:        GetAdminUserId(),
+			DefaultRoleID: accountAdminRoleID,
+		}
+		ca := &createAccount{
+			Name:     "test",
+			Template: "golden",
+			Settings: tree.AccountSettings{{Name: "time_zone", Value: "+08:00"}},
+		}
+
+		err := copyTemplateOfAccount(ctx, bh, ca, ctx, newTenant)
+		convey.So(err, convey.ShouldBeNil)
+
+		var insertedRoles, insertedPrivs []string
+		for _, s := range executed {
+			if strings.HasPrefix(s, "insert into mo_catalog.mo_role(") {
+				insertedRoles = append(insertedRoles, s)
+			} else if strings.HasPrefix(s, "insert into mo_catalog.mo_role_privs") {
+				insertedPrivs = append(insertedPrivs, s)
+			}
+		}
+		//the predefined roles are skipped
+		convey.So(len(insertedRoles), convey.ShouldEqual, 1)
+		convey.So(insertedRoles[0], convey.ShouldContainSubstring, `"r1"`)
+		//the privilege on the database of the template is skipped
+		convey.So(len(insertedPrivs), convey.ShouldEqual, 1)
+		convey.So(insertedPrivs[0], convey.ShouldContainSubstring, "create user")
+
+		convey.So(executed, convey.ShouldContain, getSqlForUpdateSysVarValue("on", 6, "save_query_result"))
+		convey.So(executed, convey.ShouldContain, getSqlForInsertSysVarWithAccount(6, "test", "sql_mode", "ONLY_FULL_GROUP_BY"))
+		//the settings of the new account take precedence
+		for _, s := range executed {
+			convey.So(s, convey.ShouldNotContainSubstring, "+09:00")
+		}
+	})
+
+	convey.Convey("copy the nonexistent template account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "golden")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		ca := &createAccount{
+			Name:     "test",
+			Template: "golden",
+		}
+		err := copyTemplateOfAccount(context.TODO(), bh, ca, context.TODO(), &TenantInfo{TenantID: 6})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_checkDefaultRoleOfSession(t *testing.T) {
+	convey.Convey("the default role is dropped by another session", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+		tenant := ses.GetTenantInfo()
+		tenant.SetDefaultRole("r1")
+		tenant.SetDefaultRoleID(10)
+
+		sql2result := make(map[string]ExecResult)
+		sql2result[getSqlForRoleNameOfRoleId(10)] = newMrsForStrings([]string{"role_name"}, [][]interface{}{
+			{"r1"},
+		})
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, sql2result, &executed)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		//the role exists
+		err := checkDefaultRoleOfSession(ctx, ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tenant.GetDefaultRole(), convey.ShouldEqual, "r1")
+		convey.So(tenant.GetDefaultRoleID(), convey.ShouldEqual, uint32(10))
+
+		//another session drops the role
+		sql2result[getSqlForRoleNameOfRoleId(10)] = newMrsForStrings([]string{"role_name"}, [][]interface{}{})
+
+		//switch to the public role
+		err = checkDefaultRoleOfSession(ctx, ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tenant.GetDefaultRole(), convey.ShouldEqual, publicRoleName)
+		convey.So(tenant.GetDefaultRoleID(), convey.ShouldEqual, uint32(publicRoleID))
+
+		//terminate the session
+		getGlobalPu().SV.DroppedDefaultRolePolicy = droppedDefaultRolePolicyTerminate
+		defer func() {
+			getGlobalPu().SV.DroppedDefaultRolePolicy = ""
+		}()
+		rt := &Routine{}
+		ses.setRoutine(rt)
+		tenant.SetDefaultRole("r1")
+		tenant.SetDefaultRoleID(10)
+
+		err = checkDefaultRoleOfSession(ctx, ses)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(rt.isCancelled(), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("the predefined role is not checked", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, map[string]ExecResult{}, &executed)
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		err := checkDefaultRoleOfSession(ctx, ses)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldBeEmpty)
+	})
+}
+
+func Test_getImpactOfGrantingRole(t *testing.T) {
+	convey.Convey("preview the grant of the role", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+
+		sql2result := make(map[string]ExecResult)
+		makeRowsOfCheckTenant(sql2result, sysAccountName, tree.AccountStatusOpen.String())
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{
+			{20},
+		})
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "u1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{})
+		sql, _ = getSqlForPasswordOfUser(context.TODO(), "u1")
+		sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{
+			{5, "111", 10},
+		})
+		sql, _ = getSqlForRoleOfUser(context.TODO(), 5, moAdminRoleName)
+		sql2result[sql] = newMrsForRoleOfUser([][]interface{}{})
+		sql2result[getSqlForUsersOfAccount()] = newMrsForUsersOfAccount([][]interface{}{
+			{5, "u1", 10},
+			{6, "u2", 11},
+		})
+		sql2result[getSqlForGetRolesOfCurrentUser(6)] = newMrsForRoleIdOfRole([][]interface{}{
+			{11},
+		})
+		sql2result[getSqlForGetAllStuffRoleGrantFormat()] = newMrsForGetAllStuffRoleGrant([][]interface{}{})
+		sql2result[getSqlForCheckRoleGrant(20, 5)] = newMrsForCheckRoleGrant([][]interface{}{})
+		sql2result[getSqlForCheckUserGrant(20, 5)] = newMrsForCheckUserGrant([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(10)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(11)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(20)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{})
+		//u1 has the select through the role 10 already
+		sql2result[getSqlForPrivilegesOfRole(10)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(11)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeInsert), "insert", "*.*", false},
+		})
+		sql2result[getSqlForPrivilegesOfRole(20)] = newMrsForPrivilegesOfRole([][]interface{}{
+			{"table", 0, int64(PrivilegeTypeSelect), "select", "*.*", false},
+			{"database", 0, int64(PrivilegeTypeCreateTable), "create table", "*", false},
+		})
+
+		//the mo_user_grant changes until the rollback
+		var executed []string
+		var currentSql string
+		var granted bool
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			currentSql = sql
+			executed = append(executed, sql)
+			if strings.HasPrefix(sql, "insert into mo_catalog.mo_user_grant") {
+				granted = true
+			} else if sql == "rollback;" {
+				granted = false
+			}
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			if currentSql == getSqlForGetRolesOfCurrentUser(5) {
+				rows := [][]interface{}{{10}}
+				if granted {
+					rows = append(rows, []interface{}{20})
+				}
+				return []interface{}{newMrsForRoleIdOfRole(rows)}
+			}
+			return []interface{}{sql2result[currentSql]}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		auditStub := gostub.Stub(&auditImpersonationView, func(ctx context.Context, ses *Session, target string, roleIds []int64) {})
+		defer auditStub.Reset()
+
+		gr := &tree.GrantRole{
+			Roles: []*tree.Role{{UserName: "r1"}},
+			Users: []*tree.User{{Username: "u1"}},
+		}
+		impacts, err := getImpactOfGrantingRole(ses.GetTxnHandler().GetTxnCtx(), ses, gr)
+		convey.So(err, convey.ShouldBeNil)
+
+		//only the u1 gains the create table
+		convey.So(len(impacts), convey.ShouldEqual, 1)
+		convey.So(impacts[0].userName, convey.ShouldEqual, "u1")
+		convey.So(len(impacts[0].gained), convey.ShouldEqual, 1)
+		convey.So(impacts[0].gained[0].privilegeId, convey.ShouldEqual, int64(PrivilegeTypeCreateTable))
 
-		err := doCreateSnapshot(ctx, ses, cs)
-		convey.So(err, convey.ShouldNotBeNil)
+		//always rolled back
+		convey.So(executed, convey.ShouldNotContain, "commit;")
+		convey.So(executed[len(executed)-1], convey.ShouldEqual, "rollback;")
+
+		//the preview matches the effect of applying the grant
+		before, err := getEffectivePrivilegesOfUser(ses.GetTxnHandler().GetTxnCtx(), ses, "u1")
+		convey.So(err, convey.ShouldBeNil)
+		ret, err := simulatePrivilegeChanges(ses.GetTxnHandler().GetTxnCtx(), ses,
+			[]tree.Statement{&tree.Grant{Typ: tree.GrantTypeRole, GrantRole: *gr}}, []string{"u1"})
+		convey.So(err, convey.ShouldBeNil)
+		var applied []int64
+		for _, rp := range ret["u1"] {
+			found := false
+			for _, b := range before {
+				if b.privilegeId == rp.privilegeId && b.objType == rp.objType {
+					found = true
+				}
+			}
+			if !found {
+				applied = append(applied, rp.privilegeId)
+			}
+		}
+		convey.So(applied, convey.ShouldResemble, []int64{impacts[0].gained[0].privilegeId})
 	})
 
-	// system tenant  create account level snapshot for other tenant
-	// but the account not exists
-	convey.Convey("doCreateSnapshot fail", t, func() {
+	convey.Convey("preview the grant of the role fail", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		ses := newTestSession(t, ctrl)
-		defer ses.Close()
-
-		bh := &backgroundExecTest{}
-		bh.init()
+		ses := newSes(nil, ctrl)
 
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, map[string]ExecResult{}, &executed)
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
 
+		//not admin
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		_, err := getImpactOfGrantingRole(ses.GetTxnHandler().GetTxnCtx(), ses, &tree.GrantRole{})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldBeEmpty)
+	})
+}
+
+func Test_getAccountVersion(t *testing.T) {
+	convey.Convey("the version of the account across the suspend and the resume", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
 		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
 		pu.SV.SetDefaultValues()
-		setGlobalPu(pu)
 		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
 		rm, _ := NewRoutineManager(ctx)
 		ses.rm = rm
 
-		tenant := &TenantInfo{
-			Tenant:        sysAccountName,
-			User:          rootName,
-			DefaultRole:   moAdminRoleName,
-			TenantID:      sysAccountID,
-			UserID:        rootID,
-			DefaultRoleID: moAdminRoleID,
-		}
-		ses.SetTenantInfo(tenant)
-		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
-		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
-		timeStamp, _ := timestamp.ParseTimestamp("2021-01-01 00:00:00")
-		txnOperator.EXPECT().SnapshotTS().Return(timeStamp).AnyTimes()
-		// process.
-		ses.proc = testutil.NewProc()
-		ses.proc.Base.TxnOperator = txnOperator
-		cs := &tree.CreateSnapShot{
-			IfNotExists: false,
-			Name:        tree.Identifier("snapshot_test"),
-			Object: tree.ObjectInfo{
-				SLevel: tree.SnapshotLevelType{
-					Level: tree.SNAPSHOTLEVELACCOUNT,
+		//the mo_account of the account acc
+		status := tree.AccountStatusOpen.String()
+		version := uint64(0)
+		checkSql, _ := getSqlForCheckTenant(ctx, "acc")
+		var currentSql string
+		bh := mock_frontend.NewMockBackgroundExec(ctrl)
+		bh.EXPECT().ClearExecResultSet().AnyTimes()
+		bh.EXPECT().Close().Return().AnyTimes()
+		bh.EXPECT().Exec(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sql string) error {
+			currentSql = sql
+			if strings.HasPrefix(sql, `update mo_catalog.mo_account set status = "suspend"`) {
+				status = tree.AccountStatusSuspend.String()
+			} else if strings.HasPrefix(sql, `update mo_catalog.mo_account set status = "open",version = `) {
+				status = tree.AccountStatusOpen.String()
+				v := strings.TrimPrefix(sql, `update mo_catalog.mo_account set status = "open",version = `)
+				v = v[:strings.Index(v, ",")]
+				newVersion, err := strconv.ParseUint(v, 10, 64)
+				if err != nil {
+					return err
+				}
+				version = newVersion
+			}
+			return nil
+		}).AnyTimes()
+		bh.EXPECT().GetExecResultSet().DoAndReturn(func() []interface{} {
+			if currentSql == checkSql {
+				return []interface{}{newMrsForCheckTenant([][]interface{}{
+					{int64(3), "acc", status, version},
+				})}
+			}
+			return []interface{}{nil}
+		}).AnyTimes()
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		alterStatus := func(option tree.AccountStatusOption) error {
+			return doAlterAccount(ctx, ses, &alterAccount{
+				Name: "acc",
+				StatusOption: tree.AccountStatus{
+					Exist:  true,
+					Option: option,
 				},
-				ObjName: "acc1",
-			},
+			})
 		}
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		last, err := getAccountVersion(ctx, bh, "acc")
+		convey.So(err, convey.ShouldBeNil)
+		for i := 0; i < 3; i++ {
+			convey.So(alterStatus(tree.AccountStatusSuspend), convey.ShouldBeNil)
+			//the suspend keeps the version
+			cur, err := getAccountVersion(ctx, bh, "acc")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cur, convey.ShouldEqual, last)
 
-		sql, _ := getSqlForCheckSnapshot(ctx, "snapshot_test")
-		mrs := newMrsForPasswordOfUser([][]interface{}{{}})
-		bh.sql2result[sql] = mrs
+			convey.So(alterStatus(tree.AccountStatusOpen), convey.ShouldBeNil)
+			//the resume increases the version
+			cur, err = getAccountVersion(ctx, bh, "acc")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cur, convey.ShouldEqual, last+1)
+			last = cur
+		}
 
-		sql2, _ := getSqlForCheckTenant(ctx, "acc1")
-		mrs2 := newMrsForPasswordOfUser([][]interface{}{{1}})
-		bh.sql2result[sql2] = mrs2
+		//the version wraps around
+		version = math.MaxUint64 - 2
+		convey.So(alterStatus(tree.AccountStatusOpen), convey.ShouldBeNil)
+		cur, err := getAccountVersion(ctx, bh, "acc")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cur, convey.ShouldEqual, uint64(math.MaxUint64-1))
+		convey.So(alterStatus(tree.AccountStatusOpen), convey.ShouldBeNil)
+		cur, err = getAccountVersion(ctx, bh, "acc")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(cur, convey.ShouldEqual, uint64(0))
+		convey.So(nextAccountVersion(0), convey.ShouldEqual, uint64(1))
+	})
 
-		err := doCreateSnapshot(ctx, ses, cs)
+	convey.Convey("the version of the nonexistent account", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForCheckTenant(context.TODO(), "acc")
+		sql2result[sql] = newMrsForCheckTenant([][]interface{}{})
+		bh := newBh(ctrl, sql2result)
+
+		_, err := getAccountVersion(context.TODO(), bh, "acc")
 		convey.So(err, convey.ShouldNotBeNil)
 	})
 }
 
-func TestDoResolveSnapshotTsWithSnapShotName(t *testing.T) {
-	convey.Convey("doResolveSnapshotWithSnapshotName success", t, func() {
+func Test_doAlterRoutineOwner(t *testing.T) {
+	makeSql2Result := func(isFunction bool, owners, roles [][]interface{}) map[string]ExecResult {
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForGetOwnerOfRoutine(context.TODO(), isFunction, "db1", "f1")
+		sql2result[sql] = newMrsForStrings([]string{"id", "owner"}, owners)
+		sql, _ = getSqlForRoleIdOfRole(context.TODO(), "r2")
+		sql2result[sql] = newMrsForStrings([]string{"role_id"}, roles)
+		//the role 5 inherits the role 6
+		sql2result[getSqlForInheritedRoleIdOfRoleId(5)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{{6, false}})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(6)] = newMrsForInheritedRoleIdOfRoleId(nil)
+		return sql2result
+	}
+	stmt := func(isFunction bool) *tree.AlterRoutineOwner {
+		return &tree.AlterRoutineOwner{IsFunction: isFunction, DbName: "db1", Name: "f1", Owner: "r2"}
+	}
+	nonAdmin := &TenantInfo{
+		Tenant:        "acc1",
+		User:          "u1",
+		DefaultRole:   "r1",
+		TenantID:      3,
+		UserID:        5,
+		DefaultRoleID: 5,
+	}
+
+	convey.Convey("the administrator transfers the ownership", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		ses := newTestSession(t, ctrl)
-		defer ses.Close()
+		ses := newSes(nil, ctrl)
+		for _, isFunction := range []bool{true, false} {
+			var executed []string
+			bh := newBhWithExecutedSqls(ctrl, makeSql2Result(isFunction, [][]interface{}{{1, 9}}, [][]interface{}{{7}}), &executed)
+			bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+			err := doAlterRoutineOwner(context.TODO(), ses, stmt(isFunction))
+			bhStub.Reset()
+			convey.So(err, convey.ShouldBeNil)
+			updateSql, _ := getSqlForUpdateOwnerOfRoutine(context.TODO(), isFunction, "db1", "f1", 7)
+			convey.So(executed, convey.ShouldContain, updateSql)
+		}
+	})
 
-		bh := &backgroundExecTest{}
-		bh.init()
+	convey.Convey("the owner transfers the ownership", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(nonAdmin)
+		var executed []string
+		//the inherited role 6 owns the function
+		bh := newBhWithExecutedSqls(ctrl, makeSql2Result(true, [][]interface{}{{1, 6}}, [][]interface{}{{7}}), &executed)
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
+		err := doAlterRoutineOwner(context.TODO(), ses, stmt(true))
+		convey.So(err, convey.ShouldBeNil)
+		updateSql, _ := getSqlForUpdateOwnerOfRoutine(context.TODO(), true, "db1", "f1", 7)
+		convey.So(executed, convey.ShouldContain, updateSql)
+	})
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-		setGlobalPu(pu)
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
-		rm, _ := NewRoutineManager(ctx)
-		ses.rm = rm
-
-		tenant := &TenantInfo{
-			Tenant:        sysAccountName,
-			User:          rootName,
-			DefaultRole:   moAdminRoleName,
-			TenantID:      sysAccountID,
-			UserID:        rootID,
-			DefaultRoleID: moAdminRoleID,
-		}
-		ses.SetTenantInfo(tenant)
+	convey.Convey("transfer the ownership fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+		ses := newSes(nil, ctrl)
+		//no such routine
+		bh := newBh(ctrl, makeSql2Result(false, nil, [][]interface{}{{7}}))
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		err := doAlterRoutineOwner(context.TODO(), ses, stmt(false))
+		bhStub.Reset()
+		convey.So(err, convey.ShouldNotBeNil)
 
-		sql, _ := getSqlForGetSnapshotTsWithSnapshotName(ctx, "test_sp")
-		mrs := newMrsForPasswordOfUser([][]interface{}{})
-		bh.sql2result[sql] = mrs
+		//no such role
+		bh = newBh(ctrl, makeSql2Result(true, [][]interface{}{{1, 9}}, nil))
+		bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
+		err = doAlterRoutineOwner(context.TODO(), ses, stmt(true))
+		bhStub.Reset()
+		convey.So(err, convey.ShouldNotBeNil)
 
-		_, err := doResolveSnapshotWithSnapshotName(ctx, ses, "test_sp")
+		//the user does not own one of the overloaded functions
+		ses.SetTenantInfo(nonAdmin)
+		var executed []string
+		bh = newBhWithExecutedSqls(ctrl, makeSql2Result(true, [][]interface{}{{1, 6}, {2, 9}}, [][]interface{}{{7}}), &executed)
+		bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
+		err = doAlterRoutineOwner(context.TODO(), ses, stmt(true))
+		bhStub.Reset()
 		convey.So(err, convey.ShouldNotBeNil)
+		updateSql, _ := getSqlForUpdateOwnerOfRoutine(context.TODO(), true, "db1", "f1", 7)
+		convey.So(executed, convey.ShouldNotContain, updateSql)
 	})
 }
 
-func TestCheckTimeStampValid(t *testing.T) {
-	convey.Convey("checkTimeStampValid success", t, func() {
+func Test_doAlterRole(t *testing.T) {
+	makeSql2Result := func(roles [][]interface{}) map[string]ExecResult {
+		sql2result := make(map[string]ExecResult)
+		sql, _ := getSqlForOwnerOfRole(context.TODO(), "r2")
+		sql2result[sql] = newMrsForStrings([]string{"role_id", "owner"}, roles)
+		//the role 5 inherits the role 6
+		sql2result[getSqlForInheritedRoleIdOfRoleId(5)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{{6, false}})
+		sql2result[getSqlForInheritedRoleIdOfRoleId(6)] = newMrsForInheritedRoleIdOfRoleId(nil)
+		return sql2result
+	}
+	stmt := func(role string) *tree.AlterRole {
+		return &tree.AlterRole{Role: &tree.Role{UserName: role}, Comment: "the role of the reports"}
+	}
+	nonAdmin := &TenantInfo{
+		Tenant:        "acc1",
+		User:          "u1",
+		DefaultRole:   "r1",
+		TenantID:      3,
+		UserID:        5,
+		DefaultRoleID: 5,
+	}
+	updateSql := getSqlForUpdateCommentsOfRole("the role of the reports", 7)
+
+	convey.Convey("the administrator alters the comment of the role", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		ses := newTestSession(t, ctrl)
-		defer ses.Close()
+		ses := newSes(nil, ctrl)
+		var executed []string
+		bh := newBhWithExecutedSqls(ctrl, makeSql2Result([][]interface{}{{7, 9}}), &executed)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+		err := doAlterRole(context.TODO(), ses, stmt("R2"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, updateSql)
+	})
 
-		bh := &backgroundExecTest{}
-		bh.init()
+	convey.Convey("the owner alters the comment of the role", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(nonAdmin)
+		var executed []string
+		//the inherited role 6 owns the role
+		bh := newBhWithExecutedSqls(ctrl, makeSql2Result([][]interface{}{{7, 6}}), &executed)
 		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
 		defer bhStub.Reset()
+		err := doAlterRole(context.TODO(), ses, stmt("r2"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(executed, convey.ShouldContain, updateSql)
+	})
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-		setGlobalPu(pu)
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
-		rm, _ := NewRoutineManager(ctx)
-		ses.rm = rm
+	convey.Convey("alter the comment of the role fail", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		tenant := &TenantInfo{
-			Tenant:        sysAccountName,
-			User:          rootName,
-			DefaultRole:   moAdminRoleName,
-			TenantID:      sysAccountID,
-			UserID:        rootID,
-			DefaultRoleID: moAdminRoleID,
+		ses := newSes(nil, ctrl)
+		//the predefined role
+		for _, role := range []string{moAdminRoleName, accountAdminRoleName, publicRoleName} {
+			err := doAlterRole(context.TODO(), ses, stmt(role))
+			convey.So(err, convey.ShouldNotBeNil)
 		}
-		ses.SetTenantInfo(tenant)
-
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
 
-		sql := getSqlForCheckSnapshotTs(1713235646865937000)
-		mrs := newMrsForPasswordOfUser([][]interface{}{})
-		bh.sql2result[sql] = mrs
+		//no such role
+		bh := newBh(ctrl, makeSql2Result(nil))
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		err := doAlterRole(context.TODO(), ses, stmt("r2"))
+		bhStub.Reset()
+		convey.So(err, convey.ShouldNotBeNil)
 
-		valid, err := checkTimeStampValid(ctx, ses, 1713235646865937000)
-		convey.So(err, convey.ShouldBeNil)
-		convey.So(valid, convey.ShouldBeFalse)
+		//the user does not own the role
+		ses.SetTenantInfo(nonAdmin)
+		var executed []string
+		bh = newBhWithExecutedSqls(ctrl, makeSql2Result([][]interface{}{{7, 9}}), &executed)
+		bhStub = gostub.StubFunc(&NewBackgroundExec, bh)
+		err = doAlterRole(context.TODO(), ses, stmt("r2"))
+		bhStub.Reset()
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(executed, convey.ShouldNotContain, updateSql)
 	})
+}
 
-	convey.Convey("checkTimeStampValid success", t, func() {
+func Test_explainDeniedCreateUserWithRole(t *testing.T) {
+	entries := []privilegeEntry{
+		privilegeEntriesMap[PrivilegeTypeCreateUser],
+		privilegeEntriesMap[PrivilegeTypeManageGrants],
+	}
+	nonAdmin := &TenantInfo{
+		Tenant:        "acc1",
+		User:          "u1",
+		DefaultRole:   "r5",
+		TenantID:      3,
+		UserID:        5,
+		DefaultRoleID: 5,
+	}
+	stmt := &tree.CreateUser{
+		Users: []*tree.User{{Username: "u2"}},
+		Role:  &tree.Role{UserName: "r1"},
+	}
+
+	//the role 5 inherits the role 6. the role r1 is the role roleIdOfR1.
+	makeSql2Result := func(privsOfRole5, privsOfRole6 [][][]interface{}, roleIdOfR1 int) map[string]ExecResult {
+		rowsOfMoRolePrivs := [][][][]interface{}{privsOfRole5, privsOfRole6}
+		rowsOfMoRoleGrant := [][][]interface{}{{{6, false}}, {}}
+		sql2result := makeSql2ExecResult2(5, nil, []int{5, 6}, entries, rowsOfMoRolePrivs, []int{5, 6}, rowsOfMoRoleGrant, nil, nil)
+		sql, _ := getSqlForRoleIdOfRole(context.TODO(), "r1")
+		sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{roleIdOfR1}})
+		return sql2result
+	}
+	explain := func(ctrl *gomock.Controller, sql2result map[string]ExecResult) error {
+		ses := newSes(nil, ctrl)
+		ses.SetTenantInfo(nonAdmin)
+		bh := newBh(ctrl, sql2result)
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+		return getDeniedErrorOfStatement(context.TODO(), ses, stmt)
+	}
+	none := [][][]interface{}{{}, {}}
+
+	convey.Convey("the user does not have the privilege create user", t, func() {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		ses := newTestSession(t, ctrl)
-		defer ses.Close()
+		//the role 6 has the manage grants only
+		err := explain(ctrl, makeSql2Result(none, [][][]interface{}{{}, {{6, true}}}, 6))
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the privilege create user is needed")
+	})
 
-		bh := &backgroundExecTest{}
-		bh.init()
+	convey.Convey("the user does not have the privilege manage grants", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
-		defer bhStub.Reset()
+		//the role 5 has the create user only. the role r1 is not granted to the user.
+		err := explain(ctrl, makeSql2Result([][][]interface{}{{{5, true}}, {}}, none, 9))
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the privilege manage grants is needed")
+	})
 
-		pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
-		pu.SV.SetDefaultValues()
-		setGlobalPu(pu)
-		ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
-		rm, _ := NewRoutineManager(ctx)
-		ses.rm = rm
+	convey.Convey("the user can not grant the role to others", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		tenant := &TenantInfo{
-			Tenant:        sysAccountName,
-			User:          rootName,
-			DefaultRole:   moAdminRoleName,
-			TenantID:      sysAccountID,
-			UserID:        rootID,
-			DefaultRoleID: moAdminRoleID,
-		}
-		ses.SetTenantInfo(tenant)
+		//the role 5 has the create user only. the role r1 is the inherited role 6.
+		err := explain(ctrl, makeSql2Result([][][]interface{}{{{5, true}}, {}}, none, 6))
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "the role can not be granted to others without the grant option")
+	})
 
-		//no result set
-		bh.sql2result["begin;"] = nil
-		bh.sql2result["commit;"] = nil
-		bh.sql2result["rollback;"] = nil
+	convey.Convey("the privileges are not granted to the same role", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
 
-		sql := getSqlForCheckSnapshotTs(1713235646865937000)
-		mrs := newMrsForPasswordOfUser([][]interface{}{{"018ee4cd-5991-7caa-b75d-f9290144bd9f"}})
-		bh.sql2result[sql] = mrs
+		//the role 5 has the create user. the role 6 has the manage grants.
+		err := explain(ctrl, makeSql2Result([][][]interface{}{{{5, true}}, {}}, [][][]interface{}{{}, {{6, true}}}, 9))
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "are not granted to the same role")
+	})
 
-		valid, err := checkTimeStampValid(ctx, ses, 1713235646865937000)
-		convey.So(err, convey.ShouldBeNil)
-		convey.So(valid, convey.ShouldBeTrue)
+	convey.Convey("the other statements are denied in general", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		for _, st := range []tree.Statement{&tree.CreateUser{}, &tree.DropUser{}} {
+			err := getDeniedErrorOfStatement(context.TODO(), ses, st)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "do not have privilege to execute the statement")
+		}
 	})
 }
@@ -38,3 +38,13 @@ var Collations []*Collation = []*Collation{
 	{"utf8mb4_is_0900_ai_ci", "utf8mb4", 257, "", "Yes", 0, "NO PAD"},
 	{"utf8mb4_lv_0900_ai_ci", "utf8mb4", 258, "", "Yes", 0, "NO PAD"},
 }
+
+// isSupportedCharset checks the charset is the charset of any collation.
+func isSupportedCharset(charset string) bool {
+	for _, c := range Collations {
+		if c.charset == charset {
+			return true
+		}
+	}
+	return false
+}
@@ -282,6 +282,10 @@ func (tcc *TxnCompilerContext) getRelation(dbName string, tableName string, sub
 		}
 	}
 	if sub != nil {
+		//the publication only exposes the tables in its table list
+		if len(sub.Tables) != 0 && !slices.Contains(sub.Tables, tableName) {
+			return nil, nil, moerr.NewNoSuchTable(tempCtx, dbName, tableName)
+		}
 		tempCtx = defines.AttachAccountId(tempCtx, uint32(sub.AccountId))
 		dbName = sub.DbName
 	}
@@ -938,8 +942,8 @@ func (tcc *TxnCompilerContext) GetQueryingSubscription() *plan.SubscriptionMeta
 	return tcc.sub
 }
 
-func (tcc *TxnCompilerContext) IsPublishing(dbName string) (bool, error) {
-	return isDbPublishing(tcc.GetContext(), dbName, tcc.GetSession())
+func (tcc *TxnCompilerContext) GetPublicationsOfDatabase(dbName string) ([]string, error) {
+	return getPubsOfDatabase(tcc.GetContext(), dbName, tcc.GetSession())
 }
 
 // makeResultMetaPath gets query result meta path
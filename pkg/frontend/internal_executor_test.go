@@ -50,6 +50,15 @@ func TestIe(t *testing.T) {
 	executor.ApplySessionOverride(ie.NewOptsBuilder().Username("dump").Finish())
 	sess := executor.newCmdSession(ctx, ie.NewOptsBuilder().Database("mo_catalog").Internal(true).Finish())
 	assert.Equal(t, "dump", sess.GetResponser().GetStr(USERNAME))
+	assert.True(t, sess.isTrustedFor(objectTypeTable))
+	assert.False(t, sess.isTrustedFor(objectTypeNone))
+	assert.False(t, sess.isTrustedFor(objectTypeAccount))
+	assert.False(t, sess.isTrustedFor(objectTypeDatabase))
+
+	//the operations of the other accounts are checked as usual
+	accountCtx := defines.AttachAccount(ctx, 10, 2, 3)
+	sess = executor.newCmdSession(accountCtx, ie.NewOptsBuilder().Finish())
+	assert.False(t, sess.isTrustedFor(objectTypeTable))
 
 	err := executor.Exec(ctx, "whatever", ie.NewOptsBuilder().Finish())
 	assert.Error(t, err)
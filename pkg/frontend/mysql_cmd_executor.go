@@ -641,6 +641,14 @@ func doSetVar(ses *Session, execCtx *ExecCtx, sv *tree.SetVar, sql string) error
 			}
 		}
 
+		//SET PERSIST keeps the variable for the current user
+		if assign.Persist {
+			if err = doSetUserSystemVariable(execCtx.reqCtx, ses, name, value); err != nil {
+				return err
+			}
+			continue
+		}
+
 		//TODO : fix SET NAMES after parser is ready
 		if name == "names" {
 			//replaced into three system variable:
@@ -673,6 +681,34 @@ func doSetVar(ses *Session, execCtx *ExecCtx, sv *tree.SetVar, sql string) error
 				if err != nil {
 					return err
 				}
+
+				//load the common privileges again after the invalidation
+				if ok {
+					err = warmUpPrivilegeCacheIfEnabled(execCtx.reqCtx, ses)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		} else if name == "warm_up_privilege_cache" {
+			//if it is global variable, it does nothing.
+			if !assign.Global {
+				//if the value is 'on', warm up the privilege cache now
+				//and after every invalidation of the privilege cache.
+				ok, err = valueIsBoolTrue(value)
+				if err != nil {
+					return err
+				}
+				err = setVarFunc(assign.System, assign.Global, name, value, sql)
+				if err != nil {
+					return err
+				}
+				if ok {
+					err = warmUpPrivilegeCache(execCtx.reqCtx, ses)
+					if err != nil {
+						return err
+					}
+				}
 			}
 		} else if name == "enable_privilege_cache" {
 			ok, err = valueIsBoolTrue(value)
@@ -844,55 +880,161 @@ func doShowVariables(ses *Session, execCtx *ExecCtx, sv *tree.ShowVariables) err
 	}
 
 	if sv.Where != nil {
-		bat, _, err := convertRowsIntoBatch(execCtx.proc.Mp(), mrs.Columns, rows)
-		defer cleanBatch(execCtx.proc.Mp(), bat)
+		rows, err = filterVariableRowsByWhere(execCtx, mrs, rows, sv.Where)
 		if err != nil {
 			return err
 		}
-		binder := plan2.NewDefaultBinder(execCtx.reqCtx, nil, nil, plan2.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen}, []string{"variable_name", "value"})
-		planExpr, err := binder.BindExpr(sv.Where.Expr, 0, false)
-		if err != nil {
-			return err
+	}
+
+	//sort by name
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(string) < rows[j][0].(string)
+	})
+
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+
+	return trySaveQueryResult(execCtx.reqCtx, ses, mrs)
+}
+
+// filterVariableRowsByWhere filters the rows of the Variable_name and the Value by the where clause.
+func filterVariableRowsByWhere(execCtx *ExecCtx, mrs *MysqlResultSet, rows [][]interface{}, where *tree.Where) ([][]interface{}, error) {
+	bat, _, err := convertRowsIntoBatch(execCtx.proc.Mp(), mrs.Columns, rows)
+	defer cleanBatch(execCtx.proc.Mp(), bat)
+	if err != nil {
+		return nil, err
+	}
+	binder := plan2.NewDefaultBinder(execCtx.reqCtx, nil, nil, plan2.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen}, []string{"variable_name", "value"})
+	planExpr, err := binder.BindExpr(where.Expr, 0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	executor, err := colexec.NewExpressionExecutor(execCtx.proc, planExpr)
+	if err != nil {
+		return nil, err
+	}
+	vec, err := executor.Eval(execCtx.proc, []*batch.Batch{bat}, nil)
+	if err != nil {
+		executor.Free()
+		return nil, err
+	}
+
+	bs := vector.MustFixedCol[bool](vec)
+	sels := execCtx.proc.Mp().GetSels()
+	for i, b := range bs {
+		if b {
+			sels = append(sels, int64(i))
 		}
+	}
+	executor.Free()
 
-		executor, err := colexec.NewExpressionExecutor(execCtx.proc, planExpr)
-		if err != nil {
-			return err
+	bat.Shrink(sels, false)
+	execCtx.proc.Mp().PutSels(sels)
+
+	v0 := vector.GenerateFunctionStrParameter(bat.Vecs[0])
+	v1 := vector.GenerateFunctionStrParameter(bat.Vecs[1])
+	rows = rows[:bat.Vecs[0].Length()]
+	for i := range rows {
+		s0, isNull := v0.GetStrValue(uint64(i))
+		if isNull {
+			rows[i][0] = ""
+		} else {
+			rows[i][0] = s0
 		}
-		vec, err := executor.Eval(execCtx.proc, []*batch.Batch{bat}, nil)
-		if err != nil {
-			executor.Free()
-			return err
+		s1, isNull := v1.GetStrValue(uint64(i))
+		if isNull {
+			rows[i][1] = ""
+		} else {
+			rows[i][1] = s1
 		}
+	}
+	return rows, nil
+}
 
-		bs := vector.MustFixedCol[bool](vec)
-		sels := execCtx.proc.Mp().GetSels()
-		for i, b := range bs {
-			if b {
-				sels = append(sels, int64(i))
-			}
+/*
+handle show variables
+*/
+func handleShowVariables(ses FeSession, execCtx *ExecCtx, sv *tree.ShowVariables) error {
+	return doShowVariables(ses.(*Session), execCtx, sv)
+}
+
+const (
+	statusPrivilegeChecks        = "Privilege_checks"
+	statusPrivilegeChecksAllowed = "Privilege_checks_allowed"
+	statusPrivilegeChecksDenied  = "Privilege_checks_denied"
+	statusPrivilegeCacheLookups  = "Privilege_cache_lookups"
+	statusPrivilegeCacheHits     = "Privilege_cache_hits"
+)
+
+// getSessionStatus returns the status counters of the session.
+func getSessionStatus(ses *Session) map[string]uint64 {
+	total, allowed, denied := ses.privCheckStats.get()
+	status := map[string]uint64{
+		statusPrivilegeChecks:        total,
+		statusPrivilegeChecksAllowed: allowed,
+		statusPrivilegeChecksDenied:  denied,
+	}
+	if cache := ses.GetPrivilegeCache(); cache != nil {
+		status[statusPrivilegeCacheLookups] = cache.total.Load()
+		status[statusPrivilegeCacheHits] = cache.hit.Load()
+	}
+	return status
+}
+
+// doShowStatus shows the status counters of the session.
+// There is no global status counter.
+func doShowStatus(ses *Session, execCtx *ExecCtx, ss *tree.ShowStatus) error {
+	if ss.Like != nil && ss.Where != nil {
+		return moerr.NewSyntaxError(execCtx.reqCtx, "like clause and where clause cannot exist at the same time")
+	}
+
+	var err error
+
+	col1 := new(MysqlColumn)
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col1.SetName("Variable_name")
+
+	col2 := new(MysqlColumn)
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col2.SetName("Value")
+
+	mrs := ses.GetMysqlResultSet()
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+
+	var hasLike = false
+	var likePattern = ""
+	var isIlike = false
+	if ss.Like != nil {
+		hasLike = true
+		if ss.Like.Op == tree.ILIKE {
+			isIlike = true
 		}
-		executor.Free()
+		likePattern = strings.ToLower(ss.Like.Right.String())
+	}
 
-		bat.Shrink(sels, false)
-		execCtx.proc.Mp().PutSels(sels)
-
-		v0 := vector.GenerateFunctionStrParameter(bat.Vecs[0])
-		v1 := vector.GenerateFunctionStrParameter(bat.Vecs[1])
-		rows = rows[:bat.Vecs[0].Length()]
-		for i := range rows {
-			s0, isNull := v0.GetStrValue(uint64(i))
-			if isNull {
-				rows[i][0] = ""
-			} else {
-				rows[i][0] = s0
-			}
-			s1, isNull := v1.GetStrValue(uint64(i))
-			if isNull {
-				rows[i][1] = ""
-			} else {
-				rows[i][1] = s1
+	var rows [][]interface{}
+	if !ss.Global {
+		for name, value := range getSessionStatus(ses) {
+			if hasLike {
+				s := name
+				if isIlike {
+					s = strings.ToLower(s)
+				}
+				if !WildcardMatch(likePattern, s) {
+					continue
+				}
 			}
+			rows = append(rows, []interface{}{name, strconv.FormatUint(value, 10)})
+		}
+	}
+
+	if ss.Where != nil {
+		rows, err = filterVariableRowsByWhere(execCtx, mrs, rows, ss.Where)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -908,11 +1050,8 @@ func doShowVariables(ses *Session, execCtx *ExecCtx, sv *tree.ShowVariables) err
 	return trySaveQueryResult(execCtx.reqCtx, ses, mrs)
 }
 
-/*
-handle show variables
-*/
-func handleShowVariables(ses FeSession, execCtx *ExecCtx, sv *tree.ShowVariables) error {
-	return doShowVariables(ses.(*Session), execCtx, sv)
+func handleShowStatus(ses FeSession, execCtx *ExecCtx, ss *tree.ShowStatus) error {
+	return doShowStatus(ses.(*Session), execCtx, ss)
 }
 
 func handleAnalyzeStmt(ses *Session, execCtx *ExecCtx, stmt *tree.AnalyzeStmt) error {
@@ -1176,30 +1315,44 @@ func handleRestoreSnapshot(ses *Session, execCtx *ExecCtx, rs *tree.RestoreSnapS
 // which has been initialized.
 func handleCreateAccount(ses FeSession, execCtx *ExecCtx, ca *tree.CreateAccount, proc *process.Process) error {
 	//step1 : create new account.
+	create, err := newCreateAccount(execCtx.reqCtx, ca, proc.GetPrepareParams())
+	if err != nil {
+		return err
+	}
+
+	return InitGeneralTenant(execCtx.reqCtx, ses.(*Session), create)
+}
+
+// newCreateAccount binds the parameters of the CREATE ACCOUNT.
+// The params is nil if the statement is not prepared.
+func newCreateAccount(ctx context.Context, ca *tree.CreateAccount, params *vector.Vector) (*createAccount, error) {
 	create := &createAccount{
 		IfNotExists:  ca.IfNotExists,
 		IdentTyp:     ca.AuthOption.IdentifiedType.Typ,
 		StatusOption: ca.StatusOption,
 		Comment:      ca.Comment,
+		Settings:     ca.Settings,
+		Template:     ca.Template,
 	}
 
 	b := strParamBinder{
-		ctx:    execCtx.reqCtx,
-		params: proc.GetPrepareParams(),
+		ctx:    ctx,
+		params: params,
 	}
 	create.Name = b.bind(ca.Name)
 	create.AdminName = b.bind(ca.AuthOption.AdminName)
 	create.IdentStr = b.bindIdentStr(&ca.AuthOption.IdentifiedType)
 	if b.err != nil {
-		return b.err
+		return nil, b.err
 	}
-
-	return InitGeneralTenant(execCtx.reqCtx, ses.(*Session), create)
+	return create, nil
 }
 
 func handleDropAccount(ses FeSession, execCtx *ExecCtx, da *tree.DropAccount, proc *process.Process) error {
 	drop := &dropAccount{
 		IfExists: da.IfExists,
+		Force:    da.Force,
+		DryRun:   da.DryRun,
 	}
 
 	b := strParamBinder{
@@ -1211,15 +1364,57 @@ func handleDropAccount(ses FeSession, execCtx *ExecCtx, da *tree.DropAccount, pr
 		return b.err
 	}
 
+	if drop.DryRun {
+		return doDropAccountDryRun(ses.(*Session), execCtx, drop)
+	}
 	return doDropAccount(execCtx.reqCtx, ses.(*Session), drop)
 }
 
+// doDropAccountDryRun reports the objects that would be dropped with the account.
+func doDropAccountDryRun(ses *Session, execCtx *ExecCtx, da *dropAccount) error {
+	objs, err := getDroppedObjectsOfAccount(execCtx.reqCtx, ses, da)
+	if err != nil {
+		return err
+	}
+
+	col1 := new(MysqlColumn)
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col1.SetName("Object Type")
+
+	col2 := new(MysqlColumn)
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col2.SetName("Object Name")
+
+	col3 := new(MysqlColumn)
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col3.SetName("Rows")
+
+	mrs := ses.GetMysqlResultSet()
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+
+	for _, obj := range objs {
+		row := make([]interface{}, 3)
+		row[0] = obj.objType
+		row[1] = obj.name
+		//only the cluster table has the rows of the account
+		if obj.objType == dropAccountObjectClusterTable {
+			row[2] = obj.rows
+		}
+		mrs.AddRow(row)
+	}
+	return nil
+}
+
 // handleDropAccount drops a new user-level tenant
 func handleAlterAccount(ses FeSession, execCtx *ExecCtx, st *tree.AlterAccount, proc *process.Process) error {
 	aa := &alterAccount{
 		IfExists:     st.IfExists,
 		StatusOption: st.StatusOption,
 		Comment:      st.Comment,
+		RenameTo:     st.RenameTo,
+		Quotas:       st.Quotas,
 	}
 
 	b := strParamBinder{
@@ -1238,6 +1433,9 @@ func handleAlterAccount(ses FeSession, execCtx *ExecCtx, st *tree.AlterAccount,
 		return b.err
 	}
 
+	if len(st.Parent) != 0 {
+		return doSetParentOfAccount(execCtx.reqCtx, ses.(*Session), aa.Name, st.Parent)
+	}
 	return doAlterAccount(execCtx.reqCtx, ses.(*Session), aa)
 }
 
@@ -1251,6 +1449,11 @@ func handleAlterAccountConfig(ses FeSession, execCtx *ExecCtx, st *tree.AlterDat
 	return doAlterAccountConfig(execCtx.reqCtx, ses.(*Session), st)
 }
 
+// handleResetMysqlCompatibilityMode resets the account's mysql_compatibility_mode
+func handleResetMysqlCompatibilityMode(ses FeSession, execCtx *ExecCtx, st *tree.ResetMysqlCompatibilityMode) error {
+	return doResetMysqlCompatibilityMode(execCtx.reqCtx, ses.(*Session), st)
+}
+
 // handleCreateUser creates the user for the tenant
 func handleCreateUser(ses FeSession, execCtx *ExecCtx, st *tree.CreateUser) error {
 	tenant := ses.GetTenantInfo()
@@ -1284,6 +1487,11 @@ func handleCreateUser(ses FeSession, execCtx *ExecCtx, st *tree.CreateUser) erro
 		cu.Users = append(cu.Users, &v)
 	}
 
+	//the admin of the parent account creates the users in its child
+	if len(st.Account) != 0 {
+		return doCreateUserInChildAccount(execCtx.reqCtx, ses.(*Session), st.Account, cu)
+	}
+
 	//step1 : create the user
 	return InitUser(execCtx.reqCtx, ses.(*Session), tenant, cu)
 }
@@ -1330,6 +1538,11 @@ func handleAlterUser(ses FeSession, execCtx *ExecCtx, st *tree.AlterUser) error
 func handleCreateRole(ses FeSession, execCtx *ExecCtx, cr *tree.CreateRole) error {
 	tenant := ses.GetTenantInfo()
 
+	//the admin of the parent account creates the roles in its child
+	if len(cr.Account) != 0 {
+		return doCreateRoleInChildAccount(execCtx.reqCtx, ses.(*Session), cr.Account, cr)
+	}
+
 	//step1 : create the role
 	return InitRole(execCtx.reqCtx, ses.(*Session), tenant, cr)
 }
@@ -1359,6 +1572,63 @@ func handleDropProcedure(ses FeSession, execCtx *ExecCtx, dp *tree.DropProcedure
 	return doDropProcedure(execCtx.reqCtx, ses.(*Session), dp)
 }
 
+// handleAlterRoutineOwner transfers the ownership of the function or the procedure
+func handleAlterRoutineOwner(ses FeSession, execCtx *ExecCtx, aro *tree.AlterRoutineOwner) error {
+	return doAlterRoutineOwner(execCtx.reqCtx, ses.(*Session), aro)
+}
+
+// handleAlterRole sets the comment or the tags of the role
+func handleAlterRole(ses FeSession, execCtx *ExecCtx, ar *tree.AlterRole) error {
+	return doAlterRole(execCtx.reqCtx, ses.(*Session), ar)
+}
+
+// handleMergeRole merges the privileges of the role and responds the conflicts resolved
+func handleMergeRole(ses FeSession, execCtx *ExecCtx, mr *tree.MergeRole) error {
+	conflicts, err := doMergeRoles(execCtx.reqCtx, ses.(*Session), mr.Source.UserName, mr.Target.UserName)
+	if err != nil {
+		return err
+	}
+
+	col1 := new(MysqlColumn)
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col1.SetName("PRIVILEGE")
+
+	col2 := new(MysqlColumn)
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col2.SetName("OBJECT_TYPE")
+
+	col3 := new(MysqlColumn)
+	col3.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col3.SetName("OBJECT_ID")
+
+	col4 := new(MysqlColumn)
+	col4.SetColumnType(defines.MYSQL_TYPE_BOOL)
+	col4.SetName("SOURCE_WITH_GRANT_OPTION")
+
+	col5 := new(MysqlColumn)
+	col5.SetColumnType(defines.MYSQL_TYPE_BOOL)
+	col5.SetName("TARGET_WITH_GRANT_OPTION")
+
+	col6 := new(MysqlColumn)
+	col6.SetColumnType(defines.MYSQL_TYPE_BOOL)
+	col6.SetName("WITH_GRANT_OPTION")
+
+	mrs := ses.GetMysqlResultSet()
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddColumn(col4)
+	mrs.AddColumn(col5)
+	mrs.AddColumn(col6)
+
+	for _, c := range conflicts {
+		mrs.AddRow([]interface{}{c.privilegeName, c.objType, c.objId,
+			c.sourceWithGrantOption, c.targetWithGrantOption, c.withGrantOption})
+	}
+
+	return trySaveQueryResult(execCtx.reqCtx, ses.(*Session), mrs)
+}
+
 func handleCallProcedure(ses FeSession, execCtx *ExecCtx, call *tree.CallStmt) error {
 	results, err := doInterpretCall(execCtx.reqCtx, ses.(*Session), call)
 	if err != nil {
@@ -1372,6 +1642,10 @@ func handleCallProcedure(ses FeSession, execCtx *ExecCtx, call *tree.CallStmt) e
 
 // handleGrantRole grants the role
 func handleGrantRole(ses FeSession, execCtx *ExecCtx, gr *tree.GrantRole) error {
+	if gr.ContinueOnError {
+		respondGrantPairStatuses(ses, doGrantRoleContinueOnError(execCtx.reqCtx, ses.(*Session), gr))
+		return nil
+	}
 	return doGrantRole(execCtx.reqCtx, ses.(*Session), gr)
 }
 
@@ -1382,9 +1656,52 @@ func handleRevokeRole(ses FeSession, execCtx *ExecCtx, rr *tree.RevokeRole) erro
 
 // handleGrantRole grants the privilege to the role
 func handleGrantPrivilege(ses FeSession, execCtx *ExecCtx, gp *tree.GrantPrivilege) error {
+	if gp.ContinueOnError {
+		respondGrantPairStatuses(ses, doGrantPrivilegeContinueOnError(execCtx.reqCtx, ses, gp))
+		return nil
+	}
 	return doGrantPrivilege(execCtx.reqCtx, ses, gp)
 }
 
+// respondGrantPairStatuses reports the status of every pair in the GRANT ... CONTINUE ON ERROR
+func respondGrantPairStatuses(ses FeSession, statuses []grantPairStatus) {
+	col1 := new(MysqlColumn)
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col1.SetName("Granted")
+
+	col2 := new(MysqlColumn)
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col2.SetName("Grantee")
+
+	col3 := new(MysqlColumn)
+	col3.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col3.SetName("Status")
+
+	col4 := new(MysqlColumn)
+	col4.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col4.SetName("Message")
+
+	mrs := ses.GetMysqlResultSet()
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddColumn(col4)
+
+	for _, status := range statuses {
+		row := make([]interface{}, 4)
+		row[0] = status.granted
+		row[1] = status.grantee
+		if status.err == nil {
+			row[2] = "OK"
+			row[3] = ""
+		} else {
+			row[2] = "FAILED"
+			row[3] = status.err.Error()
+		}
+		mrs.AddRow(row)
+	}
+}
+
 // handleRevokePrivilege revokes the privilege from the user or role
 func handleRevokePrivilege(ses FeSession, execCtx *ExecCtx, rp *tree.RevokePrivilege) error {
 	return doRevokePrivilege(execCtx.reqCtx, ses, rp)
@@ -1606,6 +1923,18 @@ func handleShowSubscriptions(ses FeSession, execCtx *ExecCtx, ss *tree.ShowSubsc
 	return err
 }
 
+func handleShowSubscribers(ses FeSession, execCtx *ExecCtx, ss *tree.ShowSubscribers) error {
+	return doShowSubscribers(execCtx.reqCtx, ses.(*Session), ss)
+}
+
+func handleShowCreateFunction(ses FeSession, execCtx *ExecCtx, scf *tree.ShowCreateFunction) error {
+	return doShowCreateFunction(execCtx.reqCtx, ses.(*Session), scf)
+}
+
+func handleShowCreateProcedure(ses FeSession, execCtx *ExecCtx, scp *tree.ShowCreateProcedure) error {
+	return doShowCreateProcedure(execCtx.reqCtx, ses.(*Session), scp)
+}
+
 func doShowBackendServers(ses *Session, execCtx *ExecCtx) error {
 	// Construct the columns.
 	col1 := new(MysqlColumn)
@@ -1693,6 +2022,46 @@ func handleShowBackendServers(ses FeSession, execCtx *ExecCtx) error {
 	return err
 }
 
+// doShowRoleHierarchy shows the grants among the roles of the user
+func doShowRoleHierarchy(ses *Session, execCtx *ExecCtx, stmt *tree.ShowRoleHierarchy) error {
+	edges, err := getRoleHierarchyOfUser(execCtx.reqCtx, ses, stmt.Username)
+	if err != nil {
+		return err
+	}
+
+	col1 := new(MysqlColumn)
+	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col1.SetName("GRANTED_ROLE")
+
+	col2 := new(MysqlColumn)
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col2.SetName("GRANTEE")
+
+	col3 := new(MysqlColumn)
+	col3.SetColumnType(defines.MYSQL_TYPE_BOOL)
+	col3.SetName("WITH_GRANT_OPTION")
+
+	col4 := new(MysqlColumn)
+	col4.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col4.SetName("DEPTH")
+
+	mrs := ses.GetMysqlResultSet()
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddColumn(col3)
+	mrs.AddColumn(col4)
+
+	for _, e := range edges {
+		mrs.AddRow([]interface{}{e.granted, e.grantee, e.withGrantOption, int64(e.depth)})
+	}
+
+	return trySaveQueryResult(execCtx.reqCtx, ses, mrs)
+}
+
+func handleShowRoleHierarchy(ses FeSession, execCtx *ExecCtx, stmt *tree.ShowRoleHierarchy) error {
+	return doShowRoleHierarchy(ses.(*Session), execCtx, stmt)
+}
+
 func handleEmptyStmt(ses FeSession, execCtx *ExecCtx, stmt *tree.EmptyStmt) error {
 	var err error
 	return err
@@ -2008,6 +2377,9 @@ func incStatementErrorsCounter(tenant string, stmt tree.Statement) {
 func authenticateUserCanExecuteStatement(reqCtx context.Context, ses *Session, stmt tree.Statement) error {
 	reqCtx, span := trace.Debug(reqCtx, "authenticateUserCanExecuteStatement")
 	defer span.End()
+	if ses.isPasswordExpired() && !isAlterPasswordOfCurrentUser(reqCtx, ses, stmt) {
+		return moerr.NewInternalError(reqCtx, "the password has expired, you must reset it using ALTER USER ... IDENTIFIED BY before executing this statement")
+	}
 	if getGlobalPu().SV.SkipCheckPrivilege {
 		return nil
 	}
@@ -2018,6 +2390,16 @@ func authenticateUserCanExecuteStatement(reqCtx context.Context, ses *Session, s
 	var havePrivilege bool
 	var err error
 	if ses.GetTenantInfo() != nil {
+		// the account is suspended. the connection is waiting to be killed.
+		if ses.getRoutine() != nil && ses.getRoutine().isSuspended() {
+			return moerr.NewInternalError(reqCtx, "the account %s is suspended", ses.GetTenantInfo().GetTenant())
+		}
+
+		err = checkDefaultRoleOfSession(reqCtx, ses)
+		if err != nil {
+			return err
+		}
+
 		ses.SetPrivilege(determinePrivilegeSetOfStatement(stmt))
 
 		// can or not execute in retricted status
@@ -2031,7 +2413,8 @@ func authenticateUserCanExecuteStatement(reqCtx context.Context, ses *Session, s
 		}
 
 		if !havePrivilege {
-			err = moerr.NewInternalError(reqCtx, "do not have privilege to execute the statement")
+			recordPrivilegeCheck(reqCtx, ses, stmt, false)
+			err = getDeniedErrorOfStatement(reqCtx, ses, stmt)
 			return err
 		}
 
@@ -2041,13 +2424,50 @@ func authenticateUserCanExecuteStatement(reqCtx context.Context, ses *Session, s
 		}
 
 		if !havePrivilege {
+			recordPrivilegeCheck(reqCtx, ses, stmt, false)
 			err = moerr.NewInternalError(reqCtx, "do not have privilege to execute the statement")
 			return err
 		}
+		recordPrivilegeCheck(reqCtx, ses, stmt, true)
 	}
 	return err
 }
 
+// authenticateStatementsOfTxnBlock checks the user can execute the statements of the explicit
+// transaction block that follow the BEGIN in the same request, when the check_privileges_at_begin is on.
+// The block ends at the COMMIT or the ROLLBACK. Only the privileges that do not depend on the plan
+// are checked, so that the transaction fails at the BEGIN before any statement of it is executed.
+func authenticateStatementsOfTxnBlock(reqCtx context.Context, ses *Session, cws []ComputationWrapper) error {
+	value, err := ses.GetSessionSysVar(CheckPrivilegesAtBegin)
+	if err != nil {
+		return err
+	}
+	on, err := valueIsBoolTrue(value)
+	if err != nil || !on {
+		return err
+	}
+
+	//the privilege of the BEGIN is decided after the block
+	old := ses.GetPrivilege()
+	defer ses.SetPrivilege(old)
+	for i, cw := range cws {
+		stmt := cw.GetAst()
+		switch stmt.(type) {
+		case *tree.CommitTransaction, *tree.RollbackTransaction, *tree.BeginTransaction:
+			return nil
+		}
+		if IsPrepareStatement(stmt) {
+			continue
+		}
+		err = authenticateUserCanExecuteStatement(reqCtx, ses, stmt)
+		if err != nil {
+			return moerr.NewInternalError(reqCtx, "the statement %d (%s) of the transaction is rejected at the begin: %s",
+				i+1, stmt.GetStatementType(), err.Error())
+		}
+	}
+	return nil
+}
+
 // authenticateCanExecuteStatementAndPlan checks the user can execute the statement and its plan
 func authenticateCanExecuteStatementAndPlan(reqCtx context.Context, ses *Session, stmt tree.Statement, p *plan.Plan) error {
 	_, task := gotrace.NewTask(reqCtx, "frontend.authenticateCanExecuteStatementAndPlan")
@@ -2064,6 +2484,14 @@ func authenticateCanExecuteStatementAndPlan(reqCtx context.Context, ses *Session
 		return err
 	}
 	if !yes {
+		recordPrivilegeCheck(reqCtx, ses, stmt, false)
+		detail, err := explainPrivilegeDenial(reqCtx, ses, stmt, p)
+		if err != nil {
+			return err
+		}
+		if len(detail) != 0 {
+			return moerr.NewInternalError(reqCtx, "do not have privilege to execute the statement: %s", detail)
+		}
 		return moerr.NewInternalError(reqCtx, "do not have privilege to execute the statement")
 	}
 	return nil
@@ -2539,7 +2967,16 @@ func executeStmt(ses *Session,
 			err = moerr.NewInternalError(execCtx.reqCtx, "only admin can create subscription")
 			return
 		}
+		err = checkQuotaOfAccountForStatement(execCtx.reqCtx, ses, st)
+		if err != nil {
+			return
+		}
 		st.Sql = execCtx.sqlOfStmt
+	case *tree.CreateTable:
+		err = checkQuotaOfAccountForStatement(execCtx.reqCtx, ses, st)
+		if err != nil {
+			return
+		}
 	case *tree.DropDatabase:
 		err = inputNameIsInvalid(execCtx.reqCtx, string(st.Name))
 		if err != nil {
@@ -2795,6 +3232,14 @@ func doComQuery(ses *Session, execCtx *ExecCtx, input *UserInput) (retErr error)
 		statsInfo.ParseDuration = time.Duration(ParseDuration.Nanoseconds() / int64(len(cws)))
 
 		tenant := ses.GetTenantNameWithStmt(stmt)
+		//check the statements of the transaction block together
+		if _, ok := stmt.(*tree.BeginTransaction); ok && ses.GetTenantInfo() != nil {
+			err = authenticateStatementsOfTxnBlock(execCtx.reqCtx, ses, cws[i+1:])
+			if err != nil {
+				logStatementStatus(execCtx.reqCtx, ses, stmt, fail, err)
+				return err
+			}
+		}
 		//skip PREPARE statement here
 		if ses.GetTenantInfo() != nil && !IsPrepareStatement(stmt) {
 			err = authenticateUserCanExecuteStatement(execCtx.reqCtx, ses, stmt)
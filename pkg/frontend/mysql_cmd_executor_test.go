@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"io"
+	"sync"
 	"testing"
 	"time"
 
@@ -749,6 +750,112 @@ func Test_handleShowVariables(t *testing.T) {
 	})
 }
 
+func Test_authenticateStatementsOfTxnBlock(t *testing.T) {
+	convey.Convey("check the privileges of the transaction block at the begin", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		auditStub := gostub.Stub(&auditPrivilegeCheck, func(ctx context.Context, ses *Session, stmt tree.Statement, ok bool, grant *privilegeGrant) {})
+		defer auditStub.Reset()
+
+		//begin; show accounts; commit; show accounts;
+		//the later statement needs the admin role
+		cws := []ComputationWrapper{
+			&TxnComputationWrapper{stmt: &tree.BeginTransaction{}},
+			&TxnComputationWrapper{stmt: &tree.ShowAccounts{}},
+			&TxnComputationWrapper{stmt: &tree.CommitTransaction{}},
+			&TxnComputationWrapper{stmt: &tree.ShowAccounts{}},
+		}
+		ses.GetTenantInfo().SetDefaultRole("r1")
+
+		//the mode is off
+		convey.So(authenticateStatementsOfTxnBlock(ctx, ses, cws[1:]), convey.ShouldBeNil)
+
+		err := ses.SetSessionSysVar(ctx, CheckPrivilegesAtBegin, int8(1))
+		convey.So(err, convey.ShouldBeNil)
+		err = authenticateStatementsOfTxnBlock(ctx, ses, cws[1:])
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.Error(), convey.ShouldContainSubstring, "rejected at the begin")
+
+		//the statements after the commit are not in the block
+		convey.So(authenticateStatementsOfTxnBlock(ctx, ses, cws[2:]), convey.ShouldBeNil)
+
+		//the admin passes
+		ses.GetTenantInfo().SetDefaultRole(moAdminRoleName)
+		convey.So(authenticateStatementsOfTxnBlock(ctx, ses, cws[1:]), convey.ShouldBeNil)
+	})
+}
+
+func Test_handleShowStatus(t *testing.T) {
+	convey.Convey("show the privilege checks of the session", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		ctx := ses.GetTxnHandler().GetTxnCtx()
+
+		auditStub := gostub.Stub(&auditPrivilegeCheck, func(ctx context.Context, ses *Session, stmt tree.Statement, ok bool, grant *privilegeGrant) {})
+		defer auditStub.Reset()
+
+		//the admin can execute the show accounts
+		stmt := &tree.ShowAccounts{}
+		for i := 0; i < 3; i++ {
+			convey.So(authenticateUserCanExecuteStatement(ctx, ses, stmt), convey.ShouldBeNil)
+		}
+		//the select on the table is checked with the plan
+		convey.So(authenticateUserCanExecuteStatement(ctx, ses, &tree.Select{}), convey.ShouldBeNil)
+
+		//the other role can not
+		ses.GetTenantInfo().SetDefaultRole("r1")
+		for i := 0; i < 2; i++ {
+			convey.So(authenticateUserCanExecuteStatement(ctx, ses, stmt), convey.ShouldNotBeNil)
+		}
+
+		total, allowed, denied := ses.privCheckStats.get()
+		convey.So(total, convey.ShouldEqual, 6)
+		convey.So(allowed, convey.ShouldEqual, 4)
+		convey.So(denied, convey.ShouldEqual, 2)
+
+		//counted concurrently
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					recordPrivilegeCheck(ctx, ses, stmt, j%2 == 0)
+				}
+			}()
+		}
+		wg.Wait()
+		total, allowed, denied = ses.privCheckStats.get()
+		convey.So(total, convey.ShouldEqual, 806)
+		convey.So(allowed, convey.ShouldEqual, 404)
+		convey.So(denied, convey.ShouldEqual, 402)
+
+		ses.mrs = &MysqlResultSet{}
+		ec := &ExecCtx{reqCtx: ctx}
+		st, err := mysql.ParseOne(ctx, "show status like 'privilege_checks%'", 1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(handleShowStatus(ses, ec, st.(*tree.ShowStatus)), convey.ShouldBeNil)
+		mrs := ses.GetMysqlResultSet()
+		convey.So(mrs.GetRowCount(), convey.ShouldEqual, 3)
+		convey.So(mrs.Data, convey.ShouldResemble, [][]interface{}{
+			{statusPrivilegeChecks, "806"},
+			{statusPrivilegeChecksAllowed, "404"},
+			{statusPrivilegeChecksDenied, "402"},
+		})
+
+		//no global status
+		ses.mrs = &MysqlResultSet{}
+		convey.So(handleShowStatus(ses, ec, &tree.ShowStatus{Global: true}), convey.ShouldBeNil)
+		convey.So(ses.GetMysqlResultSet().GetRowCount(), convey.ShouldEqual, 0)
+	})
+}
+
 func Test_GetColumns(t *testing.T) {
 	convey.Convey("GetColumns succ", t, func() {
 		//cw := &ComputationWrapperImpl{exec: &compile.Exec{}}
@@ -20,7 +20,6 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/binary"
-	"encoding/hex"
 	"fmt"
 	"math"
 	"math/rand"
@@ -156,6 +155,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -269,6 +270,9 @@ type MysqlProtocolImpl struct {
 	//random bytes
 	salt []byte
 
+	//the authentication plugin that the client uses
+	authPlugin string
+
 	//the id of the connection
 	connectionID uint32
 
@@ -1319,35 +1323,59 @@ func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
 }
 
 // the server get the auth string from HandShakeResponse
-// pwd is SHA1(SHA1(password)), AUTH is from client
-// hash1 = AUTH XOR SHA1( slat + pwd)
-// hash2 = SHA1(hash1)
-// check(hash2, hpwd)
+// pwd is the hash of the password in the mo_user, AUTH is from client.
+// The scheme of the hash decides how to check the AUTH.
+// If the client authenticated with another scheme, the server asks
+// the client to authenticate again with the scheme of the hash.
 func (mp *MysqlProtocolImpl) checkPassword(pwd, salt, auth []byte) bool {
 	ses := mp.GetSession()
-	sha := sha1.New()
-	_, err := sha.Write(salt)
-	if err != nil {
-		ses.Error(mp.ctx, "SHA1(salt) failed.")
-		return false
-	}
-	_, err = sha.Write(pwd)
-	if err != nil {
-		ses.Error(mp.ctx, "SHA1(hpwd) failed.")
-		return false
+	hasher := getPasswordHasherOfHash(pwd)
+	if mp.getAuthPlugin() != hasher.Plugin() {
+		data, err := mp.negotiateAuthenticationMethod(mp.ctx, hasher.Plugin())
+		if err != nil {
+			ses.Errorf(mp.ctx, "switch authentication method to %s failed. error:%v", hasher.Plugin(), err)
+			return false
+		}
+		mp.authResponse = data
+		mp.authPlugin = hasher.Plugin()
+		auth = data
 	}
-	hash1 := sha.Sum(nil)
+	return hasher.CheckScramble(pwd, salt, auth)
+}
 
-	if len(auth) != len(hash1) {
-		return false
+// getAuthPlugin returns the authentication plugin that the client uses.
+func (mp *MysqlProtocolImpl) getAuthPlugin() string {
+	if mp.authPlugin == AuthCachingSha2Password {
+		return AuthCachingSha2Password
 	}
+	return AuthNativePassword
+}
 
-	for i := range hash1 {
-		hash1[i] ^= auth[i]
-	}
+// sendFastAuthSuccess tells the client that the fast authentication of
+// the caching_sha2_password succeeded.
+func (mp *MysqlProtocolImpl) sendFastAuthSuccess() error {
+	data := make([]byte, HeaderOffset+2)
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, 0x01)
+	pos = mp.io.WriteUint8(data, pos, 0x03)
+	return mp.writePackets(data[:pos])
+}
 
-	hash2 := HashSha1(hash1)
-	return bytes.Equal(pwd, hash2)
+const (
+	// connectAttrRole is the connection attribute designating the default role of the session
+	connectAttrRole = "role"
+	// connectAttrDatabase is the connection attribute designating the default database of the session
+	connectAttrDatabase = "database"
+)
+
+// getConnectAttrDefaults gets the default role and database designated by the connection attributes.
+func getConnectAttrDefaults(attrs map[string]string) (role string, database string) {
+	if attrs == nil {
+		return
+	}
+	role = strings.TrimSpace(attrs[connectAttrRole])
+	database = strings.TrimSpace(attrs[connectAttrDatabase])
+	return
 }
 
 // the server authenticate that the client can connect and use the database
@@ -1357,23 +1385,33 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	var tenant *TenantInfo
 
 	ses := mp.GetSession()
+	//the database in the handshake takes precedence over the one in the connection attributes
+	attrRole, attrDatabase := getConnectAttrDefaults(mp.GetConnectAttrs())
+	if len(mp.GetDatabaseName()) == 0 && len(attrDatabase) != 0 {
+		mp.SetDatabaseName(attrDatabase)
+	}
 	if !mp.SV.SkipCheckUser {
 		ses.Debugf(ctx, "authenticate user 1")
-		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), mp.authResponse, mp.GetSalt(), mp.checkPassword)
+		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), attrRole, mp.authResponse, mp.GetSalt(), mp.checkPassword)
 		if err != nil {
 			return err
 		}
 		ses.Debugf(ctx, "authenticate user 2")
 
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
+		//the authResponse may be changed when the authentication method is switched.
+		//the system variables have been loaded by the AuthenticateUser.
+		if mp.checkPassword(psw, mp.GetSalt(), mp.authResponse) {
 			ses.Debugf(ctx, "check password succeeded")
-			if err = ses.InitSystemVariables(ctx); err != nil {
-				return err
-			}
 		} else {
 			return moerr.NewInternalError(ctx, "check password failed")
 		}
+		ses.SetAuthPlugin(mp.getAuthPlugin())
+		if mp.getAuthPlugin() == AuthCachingSha2Password {
+			if err = mp.sendFastAuthSuccess(); err != nil {
+				return err
+			}
+		}
 	} else {
 		ses.Debugf(ctx, "skip authenticate user")
 		//Get tenant info
@@ -1381,6 +1419,9 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 		if err != nil {
 			return err
 		}
+		if err = applyConnectAttrRole(ctx, tenant, attrRole); err != nil {
+			return err
+		}
 
 		if ses != nil {
 			ses.SetTenantInfo(tenant)
@@ -1418,6 +1459,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp41.authResponse
+		mp.authPlugin = resp41.clientPluginName
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
@@ -1654,9 +1696,9 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		if info.clientPluginName != AuthNativePassword && info.clientPluginName != AuthCachingSha2Password {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthNativePassword); err != nil {
 				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
 			}
 			info.clientPluginName = AuthNativePassword
@@ -1827,9 +1869,9 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
@@ -3078,9 +3120,9 @@ func HashPassWordWithByte(pwd []byte) string {
 }
 
 // GetPassWord is used to get hash byte password
-// SHA1(SHA1(password))
+// SHA1(SHA1(password)) or SHA256(SHA256(password))
 func GetPassWord(pwd string) ([]byte, error) {
-	pwdByte, err := hex.DecodeString(pwd[1:])
+	pwdByte, err := getPasswordHasherOfAuthString(pwd).Decode(pwd)
 	if err != nil {
 		logutil.Errorf("GetPassWord failed.")
 	}
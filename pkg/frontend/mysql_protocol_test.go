@@ -2817,6 +2817,67 @@ func Test_handleHandshake_Recover(t *testing.T) {
 	})
 }
 
+func Test_authenticateUser_connectAttrs(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("connection attributes designate the defaults", t, func() {
+		role, db := getConnectAttrDefaults(nil)
+		convey.So(role, convey.ShouldBeEmpty)
+		convey.So(db, convey.ShouldBeEmpty)
+
+		role, db = getConnectAttrDefaults(map[string]string{
+			connectAttrRole:     " r1 ",
+			connectAttrDatabase: "db1",
+			"_client_name":      "libmysql",
+		})
+		convey.So(role, convey.ShouldEqual, "r1")
+		convey.So(db, convey.ShouldEqual, "db1")
+
+		var SV = &config.FrontendParameters{}
+		SV.SkipCheckUser = true
+		mp := &MysqlProtocolImpl{SV: SV}
+		mp.username = "sys:dump"
+		mp.connectAttrs = map[string]string{
+			connectAttrRole:     "r1",
+			connectAttrDatabase: "db1",
+		}
+		err := mp.authenticateUser(ctx, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mp.GetDatabaseName(), convey.ShouldEqual, "db1")
+
+		//the database in the handshake takes precedence
+		mp.database = "db0"
+		err = mp.authenticateUser(ctx, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mp.GetDatabaseName(), convey.ShouldEqual, "db0")
+
+		//the same role in the login information
+		mp.username = "sys:dump:r1"
+		err = mp.authenticateUser(ctx, nil)
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	convey.Convey("connection attributes with invalid values", t, func() {
+		var SV = &config.FrontendParameters{}
+		SV.SkipCheckUser = true
+		mp := &MysqlProtocolImpl{SV: SV}
+		mp.username = "sys:dump:r2"
+		mp.connectAttrs = map[string]string{
+			connectAttrRole: "r1",
+		}
+		err := mp.authenticateUser(ctx, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		tenant, err := GetTenantInfo(ctx, "sys:dump")
+		convey.So(err, convey.ShouldBeNil)
+		err = applyConnectAttrRole(ctx, tenant, "r1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tenant.GetDefaultRole(), convey.ShouldEqual, "r1")
+
+		err = applyConnectAttrRole(ctx, tenant, "r2")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
 func TestMysqlProtocolImpl_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
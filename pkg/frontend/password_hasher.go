@@ -0,0 +1,183 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// the prefix of the authentication_string hashed by the caching_sha2_password.
+	cachingSha2PasswordPrefix = "$SHA2$"
+)
+
+// PasswordHasher hashes the password into the authentication_string in the mo_user
+// and verifies the password or the scramble from the client against it.
+type PasswordHasher interface {
+	// Plugin returns the name of the authentication plugin of the scheme
+	Plugin() string
+	// Hash returns the authentication_string of the password
+	Hash(pwd []byte) string
+	// Verify checks the plain password against the authentication_string
+	Verify(stored string, pwd []byte) bool
+	// Owns returns true if the authentication_string is hashed by the scheme
+	Owns(stored string) bool
+	// Decode returns the hash of the password in the authentication_string
+	Decode(stored string) ([]byte, error)
+	// CheckScramble checks the scramble from the client with the salt against the hash of the password
+	CheckScramble(hash, salt, auth []byte) bool
+}
+
+var _ PasswordHasher = &nativePasswordHasher{}
+var _ PasswordHasher = &cachingSha2PasswordHasher{}
+
+// nativePasswordHasher is the mysql_native_password.
+// authentication_string: *HEX(SHA1(SHA1(password)))
+type nativePasswordHasher struct{}
+
+func (h *nativePasswordHasher) Plugin() string {
+	return AuthNativePassword
+}
+
+func (h *nativePasswordHasher) Hash(pwd []byte) string {
+	return HashPassWordWithByte(pwd)
+}
+
+func (h *nativePasswordHasher) Verify(stored string, pwd []byte) bool {
+	return len(stored) != 0 && h.Hash(pwd) == stored
+}
+
+func (h *nativePasswordHasher) Owns(stored string) bool {
+	return strings.HasPrefix(stored, "*")
+}
+
+func (h *nativePasswordHasher) Decode(stored string) ([]byte, error) {
+	return hex.DecodeString(stored[1:])
+}
+
+// CheckScramble checks the scramble.
+// hash is SHA1(SHA1(password)), auth is from the client
+// hash1 = auth XOR SHA1(salt + hash)
+// check(SHA1(hash1), hash)
+func (h *nativePasswordHasher) CheckScramble(hash, salt, auth []byte) bool {
+	sha := sha1.New()
+	_, _ = sha.Write(salt)
+	_, _ = sha.Write(hash)
+	hash1 := sha.Sum(nil)
+
+	if len(auth) != len(hash1) {
+		return false
+	}
+
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+
+	return bytes.Equal(hash, HashSha1(hash1))
+}
+
+// cachingSha2PasswordHasher is the caching_sha2_password.
+// authentication_string: $SHA2$HEX(SHA256(SHA256(password)))
+type cachingSha2PasswordHasher struct{}
+
+func hashSha256(toHash []byte) []byte {
+	sum := sha256.Sum256(toHash)
+	return sum[:]
+}
+
+func (h *cachingSha2PasswordHasher) Plugin() string {
+	return AuthCachingSha2Password
+}
+
+func (h *cachingSha2PasswordHasher) Hash(pwd []byte) string {
+	if len(pwd) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s%X", cachingSha2PasswordPrefix, hashSha256(hashSha256(pwd)))
+}
+
+func (h *cachingSha2PasswordHasher) Verify(stored string, pwd []byte) bool {
+	return len(stored) != 0 && h.Hash(pwd) == stored
+}
+
+func (h *cachingSha2PasswordHasher) Owns(stored string) bool {
+	return strings.HasPrefix(stored, cachingSha2PasswordPrefix)
+}
+
+func (h *cachingSha2PasswordHasher) Decode(stored string) ([]byte, error) {
+	return hex.DecodeString(stored[len(cachingSha2PasswordPrefix):])
+}
+
+// CheckScramble checks the scramble of the fast authentication.
+// hash is SHA256(SHA256(password)), auth is from the client
+// hash1 = auth XOR SHA256(hash + salt)
+// check(SHA256(hash1), hash)
+func (h *cachingSha2PasswordHasher) CheckScramble(hash, salt, auth []byte) bool {
+	sha := sha256.New()
+	_, _ = sha.Write(hash)
+	_, _ = sha.Write(salt)
+	hash1 := sha.Sum(nil)
+
+	if len(auth) != len(hash1) {
+		return false
+	}
+
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+
+	return bytes.Equal(hash, hashSha256(hash1))
+}
+
+var passwordHashers = []PasswordHasher{
+	&cachingSha2PasswordHasher{},
+	&nativePasswordHasher{},
+}
+
+// getPasswordHasher returns the hasher of the authentication plugin.
+// The mysql_native_password is the default.
+func getPasswordHasher(plugin string) PasswordHasher {
+	for _, h := range passwordHashers {
+		if h.Plugin() == plugin {
+			return h
+		}
+	}
+	return &nativePasswordHasher{}
+}
+
+// getPasswordHasherOfAuthString returns the hasher that hashed the authentication_string.
+// The authentication_string created before the caching_sha2_password is supported
+// is hashed by the mysql_native_password.
+func getPasswordHasherOfAuthString(stored string) PasswordHasher {
+	for _, h := range passwordHashers {
+		if h.Owns(stored) {
+			return h
+		}
+	}
+	return &nativePasswordHasher{}
+}
+
+// getPasswordHasherOfHash returns the hasher by the length of the hash of the password.
+func getPasswordHasherOfHash(hash []byte) PasswordHasher {
+	if len(hash) == sha256.Size {
+		return &cachingSha2PasswordHasher{}
+	}
+	return &nativePasswordHasher{}
+}
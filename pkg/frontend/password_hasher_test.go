@@ -0,0 +1,95 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// scrambleNativePassword is what the client sends with the mysql_native_password.
+// SHA1(password) XOR SHA1(salt + SHA1(SHA1(password)))
+func scrambleNativePassword(pwd, salt []byte) []byte {
+	hash1 := HashSha1(pwd)
+	hash2 := HashSha1(hash1)
+	hash3 := HashSha1(append(append([]byte{}, salt...), hash2...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1
+}
+
+// scrambleCachingSha2Password is what the client sends with the caching_sha2_password.
+// SHA256(password) XOR SHA256(SHA256(SHA256(password)) + salt)
+func scrambleCachingSha2Password(pwd, salt []byte) []byte {
+	hash1 := sha256.Sum256(pwd)
+	hash2 := sha256.Sum256(hash1[:])
+	hash3 := sha256.Sum256(append(hash2[:], salt...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+func Test_PasswordHasher(t *testing.T) {
+	pwd := []byte("123456")
+	salt := []byte("0123456789abcdefghij")
+
+	convey.Convey("round trip the authentication_string", t, func() {
+		for _, h := range passwordHashers {
+			stored := h.Hash(pwd)
+			convey.So(h.Owns(stored), convey.ShouldBeTrue)
+			convey.So(h.Verify(stored, pwd), convey.ShouldBeTrue)
+			convey.So(h.Verify(stored, []byte("654321")), convey.ShouldBeFalse)
+			convey.So(getPasswordHasherOfAuthString(stored).Plugin(), convey.ShouldEqual, h.Plugin())
+			convey.So(getPasswordHasher(h.Plugin()).Plugin(), convey.ShouldEqual, h.Plugin())
+
+			hash, err := GetPassWord(stored)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(getPasswordHasherOfHash(hash).Plugin(), convey.ShouldEqual, h.Plugin())
+		}
+		convey.So(getPasswordHasher("sha256_password").Plugin(), convey.ShouldEqual, AuthNativePassword)
+	})
+
+	convey.Convey("the authentication_string of the old scheme still validates", t, func() {
+		stored := HashPassWord(string(pwd))
+		h := getPasswordHasherOfAuthString(stored)
+		convey.So(h.Plugin(), convey.ShouldEqual, AuthNativePassword)
+		convey.So(h.Verify(stored, pwd), convey.ShouldBeTrue)
+
+		hash, err := GetPassWord(stored)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(h.CheckScramble(hash, salt, scrambleNativePassword(pwd, salt)), convey.ShouldBeTrue)
+	})
+
+	convey.Convey("check the scramble of the client", t, func() {
+		native := getPasswordHasher(AuthNativePassword)
+		hash, err := native.Decode(native.Hash(pwd))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(native.CheckScramble(hash, salt, scrambleNativePassword(pwd, salt)), convey.ShouldBeTrue)
+		convey.So(native.CheckScramble(hash, salt, scrambleNativePassword([]byte("654321"), salt)), convey.ShouldBeFalse)
+
+		sha2 := getPasswordHasher(AuthCachingSha2Password)
+		hash, err = sha2.Decode(sha2.Hash(pwd))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(sha2.CheckScramble(hash, salt, scrambleCachingSha2Password(pwd, salt)), convey.ShouldBeTrue)
+		convey.So(sha2.CheckScramble(hash, salt, scrambleCachingSha2Password([]byte("654321"), salt)), convey.ShouldBeFalse)
+
+		//the scramble of the other scheme
+		convey.So(sha2.CheckScramble(hash, salt, scrambleNativePassword(pwd, salt)), convey.ShouldBeFalse)
+	})
+}
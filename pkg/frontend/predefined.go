@@ -34,7 +34,12 @@ var (
 				login_type  varchar(16),
 				creator int signed,
 				owner int signed,
-				default_role int signed
+				default_role int signed,
+				login_attempts int unsigned default 0,
+				lock_time timestamp,
+				comment text,
+				attribute text,
+				password_lifetime int signed default NULL
     		)`
 
 	MoCatalogMoAccountDDL = `create table mo_catalog.mo_account (
@@ -46,7 +51,13 @@ var (
 				comments varchar(256),
 				version bigint unsigned auto_increment,
 				suspended_time timestamp default NULL,
-				create_version varchar(50) default '1.2.0'
+				create_version varchar(50) default '1.2.0',
+				parent_account_id int signed default NULL,
+				max_databases int signed default NULL,
+				max_tables int signed default NULL,
+				max_connections int signed default NULL,
+				suspended_by int signed default NULL,
+				suspend_reason varchar(256) default NULL
 			)`
 
 	MoCatalogMoRoleDDL = `create table mo_catalog.mo_role (
@@ -55,7 +66,8 @@ var (
 				creator int signed,
 				owner int signed,
 				created_time timestamp,
-				comments text
+				comments text,
+				tags varchar(1024)
 			)`
 
 	MoCatalogMoUserGrantDDL = `create table mo_catalog.mo_user_grant (
@@ -63,9 +75,13 @@ var (
 				user_id int signed,
 				granted_time timestamp,
 				with_grant_option bool,
+				expiry_time timestamp,
 				primary key(role_id, user_id)
 			)`
 
+	// the operation_user_id 2147483647 (math.MaxInt32) denotes the user made the row
+	// has been dropped under the drop-user-cleanup-policy reassign.
+	// It is the same in the mo_role_privs, the mo_column_privs and the mo_variable_audit.
 	MoCatalogMoRoleGrantDDL = `create table mo_catalog.mo_role_grant (
 				granted_id int signed,
 				grantee_id int signed,
@@ -73,6 +89,7 @@ var (
 				operation_user_id int signed,
 				granted_time timestamp,
 				with_grant_option bool,
+				expiry_time timestamp,
 				primary key(granted_id, grantee_id)
 			)`
 
@@ -149,7 +166,7 @@ var (
 
 	MoCatalogMoStoredProcedureDDL = `create table mo_catalog.mo_stored_procedure (
 				proc_id int auto_increment,
-				name     varchar(100) unique key,
+				name     varchar(100),
 				creator  int unsigned,
 				args     text,
 				body     text,
@@ -177,6 +194,51 @@ var (
 				primary key(stage_id)
 			)`
 
+	MoCatalogMoColumnPrivsDDL = `create table mo_catalog.mo_column_privs (
+				role_id int signed,
+				role_name  varchar(100),
+				table_id bigint unsigned,
+				column_name varchar(256),
+				privilege_id int,
+				privilege_name varchar(100),
+				operation_user_id int unsigned,
+				granted_time timestamp,
+				with_grant_option bool,
+				primary key(role_id, table_id, column_name, privilege_id)
+			)`
+
+	// the system variables persisted by the user with SET PERSIST.
+	// they override the variables of the account when the user logs in.
+	MoCatalogMoUserVariablesDDL = `create table mo_catalog.mo_user_variables (
+				user_id int signed,
+				variable_name varchar(300),
+				variable_value varchar(5000),
+				primary key(user_id, variable_name)
+			)`
+
+	// the changes of the variables in the mo_mysql_compatibility_mode.
+	// the operation_user_id 2147483647 denotes the user has been dropped.
+	MoCatalogMoVariableAuditDDL = `create table mo_catalog.mo_variable_audit (
+				audit_id bigint unsigned auto_increment,
+				account_id int,
+				account_name varchar(300),
+				dat_name varchar(5000),
+				variable_name varchar(300),
+				old_value varchar(5000),
+				new_value varchar(5000),
+				operation_user_id int unsigned,
+				changed_time timestamp,
+				primary key(audit_id)
+			)`
+
+	MoCatalogMoPasswordHistoryDDL = `create table mo_catalog.mo_password_history (
+				history_id bigint unsigned auto_increment,
+				user_id int signed,
+				authentication_string varchar(100),
+				created_time timestamp,
+				primary key(history_id)
+			)`
+
 	MoCatalogMoSessionsDDL       = `CREATE VIEW mo_catalog.mo_sessions AS SELECT node_id, conn_id, session_id, account, user, host, db, session_start, command, info, txn_id, statement_id, statement_type, query_type, sql_source_type, query_start, client_host, role, proxy_host FROM mo_sessions() AS mo_sessions_tmp`
 	MoCatalogMoConfigurationsDDL = `CREATE VIEW mo_catalog.mo_configurations AS SELECT node_type, node_id, name, current_value, default_value, internal FROM mo_configurations() AS mo_configurations_tmp`
 	MoCatalogMoLocksDDL          = `CREATE VIEW mo_catalog.mo_locks AS SELECT cn_id, txn_id, table_id, lock_key, lock_content, lock_mode, lock_status, lock_wait FROM mo_locks() AS mo_locks_tmp`
@@ -65,6 +65,10 @@ type Routine struct {
 
 	restricted atomic.Bool
 
+	// suspended denotes the account of the routine is suspended or dropped.
+	// The routine rejects new statements and waits to be killed.
+	suspended atomic.Bool
+
 	printInfoOnce bool
 
 	mc *migrateController
@@ -86,6 +90,14 @@ func (rt *Routine) isRestricted() bool {
 	return rt.restricted.Load()
 }
 
+func (rt *Routine) setSuspended(val bool) {
+	rt.suspended.Store(val)
+}
+
+func (rt *Routine) isSuspended() bool {
+	return rt.suspended.Load()
+}
+
 func (rt *Routine) increaseCount(counter func()) {
 	if rt.connectionBeCounted.CompareAndSwap(false, true) {
 		if counter != nil {
@@ -116,6 +128,12 @@ func (rt *Routine) setInProcessRequest(b bool) {
 	rt.inProcessRequest = b
 }
 
+func (rt *Routine) isInProcessRequest() bool {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return rt.inProcessRequest
+}
+
 // execCallbackInProcessRequestOnly denotes if inProcessRequest is true,
 // then the callback will be called.
 // It has used the mutex.
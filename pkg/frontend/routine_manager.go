@@ -102,16 +102,41 @@ func (ar *AccountRoutineManager) deleteRoutine(tenantID int64, rt *Routine) {
 	}
 }
 
+func (ar *AccountRoutineManager) countRoutines(tenantID int64) int {
+	ar.accountRoutineMu.RLock()
+	defer ar.accountRoutineMu.RUnlock()
+	return len(ar.accountId2Routine[tenantID])
+}
+
 func (ar *AccountRoutineManager) EnKillQueue(tenantID int64, version uint64) {
 	if tenantID == sysAccountID {
 		return
 	}
 
-	KillRecord := NewKillRecord(time.Now(), version)
+	// the connections are killed after the grace period.
+	// new statements on them are rejected since now.
+	KillRecord := NewKillRecord(time.Now().Add(suspendAccountGracePeriod()), version)
 	ar.killQueueMu.Lock()
-	defer ar.killQueueMu.Unlock()
 	ar.killIdQueue[tenantID] = KillRecord
+	ar.killQueueMu.Unlock()
+
+	ar.accountRoutineMu.RLock()
+	defer ar.accountRoutineMu.RUnlock()
+	for rt, rtVersion := range ar.accountId2Routine[tenantID] {
+		if rt != nil && ((rtVersion+1)%math.MaxUint64)-1 <= version {
+			rt.setSuspended(true)
+		}
+	}
+}
 
+// suspendAccountGracePeriod returns the duration that the connections of
+// the suspended account are kept alive before they are killed.
+func suspendAccountGracePeriod() time.Duration {
+	seconds := getGlobalPu().SV.SuspendAccountGracePeriod
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
 }
 
 func (ar *AccountRoutineManager) AlterRoutineStatue(tenantID int64, status string) {
@@ -572,12 +597,37 @@ func (rm *RoutineManager) cleanKillQueue() {
 	}
 }
 
+// markPrivilegeCacheStaleOfAccount marks the privilege cache of the sessions
+// of the account on this node stale. Every session clears its own cache.
+func (rm *RoutineManager) markPrivilegeCacheStaleOfAccount(accountId uint32) {
+	rm.mu.RLock()
+	routines := make([]*Routine, 0, len(rm.clients))
+	for _, rt := range rm.clients {
+		routines = append(routines, rt)
+	}
+	rm.mu.RUnlock()
+
+	for _, rt := range routines {
+		ses := rt.getSession()
+		if ses == nil || ses.GetTenantInfo() == nil {
+			continue
+		}
+		if ses.GetTenantInfo().GetTenantID() == accountId {
+			ses.markPrivilegeCacheStale()
+		}
+	}
+}
+
 func (rm *RoutineManager) KillRoutineConnections() {
 	ar := rm.accountRoutine
 	tempKillQueue := ar.deepCopyKillQueue()
 	accountId2RoutineMap := ar.deepCopyRoutineMap()
 
 	for account, killRecord := range tempKillQueue {
+		// still in the grace period
+		if time.Now().Before(killRecord.killTime) {
+			continue
+		}
 		if rtMap, ok := accountId2RoutineMap[account]; ok {
 			for rt, version := range rtMap {
 				if rt != nil && ((version+1)%math.MaxUint64)-1 <= killRecord.version {
@@ -592,6 +642,77 @@ func (rm *RoutineManager) KillRoutineConnections() {
 	rm.cleanKillQueue()
 }
 
+// routineIsRegistered checks the routine is still served by the routine manager.
+// The routine is unregistered when its connection is closed.
+func (rm *RoutineManager) routineIsRegistered(rt *Routine) bool {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+	for _, r := range rm.clients {
+		if r == rt {
+			return true
+		}
+	}
+	return false
+}
+
+// killAccountRoutinesAndWait kills the connections of the account on this node
+// whose version is not newer than the version. Then it waits until all of them are closed.
+// The connections processing the request are killed after they finish the request
+// or the grace period passes.
+// It returns an error if any of them is still alive after the timeout.
+func (rm *RoutineManager) killAccountRoutinesAndWait(ctx context.Context, accountId int64, version uint64, grace, timeout time.Duration) error {
+	var killed []*Routine
+	if rtMap, ok := rm.accountRoutine.deepCopyRoutineMap()[accountId]; ok {
+		for rt, rtVersion := range rtMap {
+			if rt != nil && ((rtVersion+1)%math.MaxUint64)-1 <= version {
+				killed = append(killed, rt)
+			}
+		}
+	}
+
+	graceDeadline := time.Now().Add(grace)
+	for {
+		busy := 0
+		for _, rt := range killed {
+			if rt.isInProcessRequest() {
+				busy++
+			}
+		}
+		if busy == 0 || !time.Now().Before(graceDeadline) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	for _, rt := range killed {
+		rt.killConnection(false)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		alive := 0
+		for _, rt := range killed {
+			if rm.routineIsRegistered(rt) {
+				alive++
+			}
+		}
+		if alive == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return moerr.NewInternalError(ctx, "%d connections of the account %d are still alive after %s", alive, accountId, timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
 func (rm *RoutineManager) MigrateConnectionTo(ctx context.Context, req *query.MigrateConnToRequest) error {
 	routine := rm.getRoutineByConnID(req.ConnID)
 	if routine == nil {
@@ -22,10 +22,13 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/fagongzi/goetty/v2"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 )
 
 func create_test_server() *MOServer {
@@ -75,3 +78,145 @@ func Test_Closed(t *testing.T) {
 
 	closeDbConn(t, db)
 }
+
+func Test_killAccountRoutinesAndWait(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.TODO()
+	rm := &RoutineManager{
+		ctx:              ctx,
+		clients:          make(map[goetty.IOSession]*Routine),
+		routinesByConnID: make(map[uint32]*Routine),
+		accountRoutine: &AccountRoutineManager{
+			accountId2Routine: make(map[int64]map[*Routine]uint64),
+			killIdQueue:       make(map[int64]KillRecord),
+			ctx:               ctx,
+		},
+	}
+
+	//the routines have been cancelled. killing them does not touch the network.
+	newRoutine := func(version uint64) (goetty.IOSession, *Routine) {
+		rs := mock_frontend.NewMockIOSession(ctrl)
+		rt := &Routine{}
+		rt.setCancelled(true)
+		rm.clients[rs] = rt
+		rm.accountRoutine.recordRountine(10, rt, version)
+		return rs, rt
+	}
+
+	_, rt1 := newRoutine(1)
+	_, rt2 := newRoutine(1)
+	//the routine of the newer version is not killed
+	_, rt3 := newRoutine(2)
+
+	//the routine is alive after the timeout
+	err := rm.killAccountRoutinesAndWait(ctx, 10, 1, 0, 50*time.Millisecond)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "2 connections of the account 10 are still alive")
+
+	//the routines are closed before the timeout
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		rm.mu.Lock()
+		for rs, rt := range rm.clients {
+			if rt == rt1 || rt == rt2 {
+				delete(rm.clients, rs)
+			}
+		}
+		rm.mu.Unlock()
+	}()
+	err = rm.killAccountRoutinesAndWait(ctx, 10, 1, 0, 5*time.Second)
+	require.NoError(t, err)
+	require.True(t, rm.routineIsRegistered(rt3))
+}
+
+func Test_suspendAccountGracePeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+	pu.SV.SetDefaultValues()
+	pu.SV.SuspendAccountGracePeriod = 1
+	setGlobalPu(pu)
+	defer func() {
+		pu.SV.SuspendAccountGracePeriod = 0
+	}()
+
+	ctx := context.TODO()
+	rm := &RoutineManager{
+		ctx:              ctx,
+		clients:          make(map[goetty.IOSession]*Routine),
+		routinesByConnID: make(map[uint32]*Routine),
+		accountRoutine: &AccountRoutineManager{
+			accountId2Routine: make(map[int64]map[*Routine]uint64),
+			killIdQueue:       make(map[int64]KillRecord),
+			ctx:               ctx,
+		},
+	}
+
+	newRoutine := func(version uint64) *Routine {
+		rs := mock_frontend.NewMockIOSession(ctrl)
+		rt := &Routine{}
+		rm.clients[rs] = rt
+		rm.accountRoutine.recordRountine(10, rt, version)
+		return rt
+	}
+
+	rt1 := newRoutine(1)
+	rt2 := newRoutine(2)
+
+	//new statements are rejected since the account is suspended
+	rm.accountRoutine.EnKillQueue(10, 1)
+	require.True(t, rt1.isSuspended())
+	require.False(t, rt2.isSuspended())
+
+	//the routine is not killed in the grace period
+	rt1.setInProcessRequest(true)
+	rm.KillRoutineConnections()
+	require.False(t, rt1.isCancelled())
+	require.Equal(t, 2, rm.accountRoutine.countRoutines(10))
+
+	//the routine is killed after the grace period
+	time.Sleep(1100 * time.Millisecond)
+	rm.KillRoutineConnections()
+	require.True(t, rt1.isCancelled())
+	require.False(t, rt2.isCancelled())
+	require.Equal(t, 1, rm.accountRoutine.countRoutines(10))
+}
+
+func Test_killAccountRoutinesAndWaitWithGrace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.TODO()
+	rm := &RoutineManager{
+		ctx:              ctx,
+		clients:          make(map[goetty.IOSession]*Routine),
+		routinesByConnID: make(map[uint32]*Routine),
+		accountRoutine: &AccountRoutineManager{
+			accountId2Routine: make(map[int64]map[*Routine]uint64),
+			killIdQueue:       make(map[int64]KillRecord),
+			ctx:               ctx,
+		},
+	}
+
+	rs := mock_frontend.NewMockIOSession(ctrl)
+	rt := &Routine{}
+	rt.setInProcessRequest(true)
+	rm.clients[rs] = rt
+	rm.accountRoutine.recordRountine(10, rt, 1)
+
+	//the in-flight statement finishes in the grace period, then the routine is killed
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		rt.setInProcessRequest(false)
+		time.Sleep(50 * time.Millisecond)
+		rm.mu.Lock()
+		delete(rm.clients, rs)
+		rm.mu.Unlock()
+	}()
+	err := rm.killAccountRoutinesAndWait(ctx, 10, 1, 5*time.Second, 5*time.Second)
+	require.NoError(t, err)
+	require.True(t, rt.isCancelled())
+}
@@ -157,6 +157,13 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		if err != nil {
 			return
 		}
+	case *tree.ShowStatus:
+		ses.EnterFPrint(120)
+		defer ses.ExitFPrint(120)
+		err = handleShowStatus(ses, execCtx, st)
+		if err != nil {
+			return
+		}
 	case *tree.ShowErrors, *tree.ShowWarnings:
 		ses.EnterFPrint(25)
 		defer ses.ExitFPrint(25)
@@ -206,6 +213,24 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		if err = handleShowSubscriptions(ses, execCtx, st); err != nil {
 			return
 		}
+	case *tree.ShowSubscribers:
+		ses.EnterFPrint(124)
+		defer ses.ExitFPrint(124)
+		if err = handleShowSubscribers(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.ShowCreateFunction:
+		ses.EnterFPrint(125)
+		defer ses.ExitFPrint(125)
+		if err = handleShowCreateFunction(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.ShowCreateProcedure:
+		ses.EnterFPrint(126)
+		defer ses.ExitFPrint(126)
+		if err = handleShowCreateProcedure(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.CreateStage:
 		ses.EnterFPrint(33)
 		defer ses.ExitFPrint(33)
@@ -258,6 +283,12 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 				return
 			}
 		}
+	case *tree.ResetMysqlCompatibilityMode:
+		ses.EnterFPrint(127)
+		defer ses.ExitFPrint(127)
+		if err = handleResetMysqlCompatibilityMode(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.CreateUser:
 		ses.EnterFPrint(40)
 		defer ses.ExitFPrint(40)
@@ -320,6 +351,24 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		if err = handleDropProcedure(ses, execCtx, st); err != nil {
 			return
 		}
+	case *tree.AlterRoutineOwner:
+		ses.EnterFPrint(121)
+		defer ses.ExitFPrint(121)
+		if err = handleAlterRoutineOwner(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.AlterRole:
+		ses.EnterFPrint(123)
+		defer ses.ExitFPrint(123)
+		if err = handleAlterRole(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.MergeRole:
+		ses.EnterFPrint(128)
+		defer ses.ExitFPrint(128)
+		if err = handleMergeRole(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.CallStmt:
 		ses.EnterFPrint(49)
 		defer ses.ExitFPrint(49)
@@ -340,6 +389,10 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 				return
 			}
 		}
+		//load the common privileges again after the invalidation
+		if err = warmUpPrivilegeCacheIfEnabled(execCtx.reqCtx, ses); err != nil {
+			return
+		}
 	case *tree.Revoke:
 		ses.EnterFPrint(51)
 		defer ses.ExitFPrint(51)
@@ -354,6 +407,10 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 				return
 			}
 		}
+		//load the common privileges again after the invalidation
+		if err = warmUpPrivilegeCacheIfEnabled(execCtx.reqCtx, ses); err != nil {
+			return
+		}
 	case *tree.Kill:
 		ses.EnterFPrint(52)
 		defer ses.ExitFPrint(52)
@@ -379,6 +436,12 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		if err = handleShowBackendServers(ses, execCtx); err != nil {
 			return
 		}
+	case *tree.ShowRoleHierarchy:
+		ses.EnterFPrint(122)
+		defer ses.ExitFPrint(122)
+		if err = handleShowRoleHierarchy(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.SetTransaction:
 		ses.EnterFPrint(56)
 		defer ses.ExitFPrint(56)
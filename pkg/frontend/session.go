@@ -138,7 +138,31 @@ type Session struct {
 	//that the internal or background program executes
 	fromRealUser bool
 
+	//trustedObjTypes denotes the object types that the privilege check is bypassed for.
+	//it is only set by the internal program.
+	trustedObjTypes map[objectType]bool
+
+	//authPlugin denotes the authentication plugin that the client logged in with.
+	//the password set in the session is hashed by it.
+	authPlugin string
+
+	//passwordExpired denotes the password of the user has expired.
+	//the user can only change the password with the ALTER USER.
+	passwordExpired bool
+
 	cache *privilegeCache
+	//privilegeCacheStale denotes the privileges have been changed by the other sessions.
+	//the cache is cleared by the session itself when it uses the cache next time.
+	privilegeCacheStale atomic.Bool
+
+	//privCheckStats counts the privilege checks of the statements in the session
+	privCheckStats privilegeCheckStats
+
+	//subMetaCache caches the subscription meta checked in the txn
+	subMetaCache subscriptionMetaCache
+
+	//roleGrantCache caches the mo_role_grant for the privilege checks
+	roleGrantCache roleGrantCache
 
 	mu   sync.Mutex
 	rwmu sync.RWMutex
@@ -246,7 +270,8 @@ func (ses *Session) InitSystemVariables(ctx context.Context) (err error) {
 		return
 	}
 	ses.sesSysVars = ses.gSysVars.Clone()
-	return
+	// the variables persisted by the user override the ones of the account
+	return ses.applyUserSysVars(ctx)
 }
 
 func (ses *Session) GetTxnHandler() *TxnHandler {
@@ -718,15 +743,32 @@ func (ses *Session) UpdateDebugString() {
 func (ses *Session) GetPrivilegeCache() *privilegeCache {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
+	if ses.privilegeCacheStale.CompareAndSwap(true, false) {
+		ses.cache.invalidate()
+	}
 	return ses.cache
 }
 
+// markPrivilegeCacheStale asks the session to clear its privilege cache.
+// It is safe to be called by the other sessions.
+func (ses *Session) markPrivilegeCacheStale() {
+	ses.privilegeCacheStale.Store(true)
+}
+
 func (ses *Session) InvalidatePrivilegeCache() {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
 	ses.cache.invalidate()
 }
 
+func (ses *Session) GetRoleGrantCache() *roleGrantCache {
+	return &ses.roleGrantCache
+}
+
+func (ses *Session) InvalidateRoleGrantCache() {
+	ses.roleGrantCache.invalidate()
+}
+
 // GetBackgroundExec generates a background executor
 func (ses *Session) GetBackgroundExec(ctx context.Context) BackgroundExec {
 	ses.EnterFPrint(99)
@@ -1056,8 +1098,25 @@ func (ses *Session) skipAuthForSpecialUser() bool {
 	return false
 }
 
+// applyConnectAttrRole designates the role from the connection attributes as the default role of the login.
+// It conflicts with a different role in the login information.
+func applyConnectAttrRole(ctx context.Context, tenant *TenantInfo, attrRole string) error {
+	if len(attrRole) == 0 {
+		return nil
+	}
+	if tenant.HasDefaultRole() {
+		if tenant.GetDefaultRole() != attrRole {
+			return moerr.NewInternalError(ctx, "the role %s in the connection attributes conflicts with the role %s in the login information",
+				attrRole, tenant.GetDefaultRole())
+		}
+		return nil
+	}
+	tenant.SetDefaultRole(attrRole)
+	return nil
+}
+
 // AuthenticateUser Verify the user's password, and if the login information contains the database name, verify if the database exists
-func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbName string, authResponse []byte, salt []byte, checkPassword func(pwd []byte, salt []byte, auth []byte) bool) ([]byte, error) {
+func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbName string, attrRole string, authResponse []byte, salt []byte, checkPassword func(pwd []byte, salt []byte, auth []byte) bool) ([]byte, error) {
 	var defaultRoleID int64
 	var defaultRole string
 	var tenant *TenantInfo
@@ -1074,6 +1133,11 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 		return nil, err
 	}
 
+	//the role in the connection attributes is checked as the one in the login information
+	if err = applyConnectAttrRole(ctx, tenant, attrRole); err != nil {
+		return nil, err
+	}
+
 	ses.SetTenantInfo(tenant)
 	ses.UpdateDebugString()
 
@@ -1084,6 +1148,9 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 		if len(ses.requestLabel) == 0 {
 			ses.requestLabel = db_holder.GetLabelSelector()
 		}
+		if err = ses.InitSystemVariables(ctx); err != nil {
+			return nil, err
+		}
 		return GetPassWord(HashPassWordWithByte(pwdBytes))
 	}
 
@@ -1254,16 +1321,44 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 		return nil, err
 	}
 
+	//the system variables are loaded once for the login.
+	//the failed logins before the password check depend on them.
+	if err = ses.InitSystemVariables(ctx); err != nil {
+		return nil, err
+	}
+
+	//check the user is locked by the ACCOUNT LOCK or not
+	if err = checkAccountLockOfUser(tenantCtx, ses, tenant, userID); err != nil {
+		return nil, err
+	}
+
+	//check the user is locked by the failed logins or not
+	lock, err := getLoginLockOfUser(tenantCtx, ses, tenant, userID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.locked {
+		return nil, moerr.NewInternalError(tenantCtx, "the user %s is locked for too many failed logins, please try again later", tenant.GetUser())
+	}
+
 	// TO Check password
 	if checkPassword(psw, salt, authResponse) {
 		ses.Debug(tenantCtx, "check password succeeded")
-		if err = ses.InitSystemVariables(ctx); err != nil {
+		if err = resetLoginLockOfUser(tenantCtx, ses, lock); err != nil {
 			return nil, err
 		}
 	} else {
+		if err = recordFailedLogin(tenantCtx, ses, lock); err != nil {
+			return nil, err
+		}
 		return nil, moerr.NewInternalError(tenantCtx, "check password failed")
 	}
 
+	//check the password has expired or not
+	if err = checkPasswordExpired(tenantCtx, ses, userID); err != nil {
+		return nil, err
+	}
+
 	// If the login information contains the database name, verify if the database exists
 	if dbName != "" {
 		ses.timestampMap[TSCheckDbNameStart] = time.Now()
@@ -1275,6 +1370,10 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 		ses.timestampMap[TSCheckDbNameEnd] = time.Now()
 		v2.CheckDbNameDurationHistogram.Observe(ses.timestampMap[TSCheckDbNameEnd].Sub(ses.timestampMap[TSCheckDbNameStart]).Seconds())
 	}
+	//check the connections of the account reach the quota or not
+	if err = checkConnectionQuotaOfAccount(sysTenantCtx, ses, tenant, tenantID); err != nil {
+		return nil, err
+	}
 	//------------------------------------------------------------------------------------------------------------------
 	// record the id :routine pair in RoutineManager
 	ses.getRoutineManager().accountRoutine.recordRountine(tenantID, ses.getRoutine(), accountVersion)
@@ -1339,6 +1438,55 @@ func (ses *Session) getGlobalSysVars(ctx context.Context) (gSysVars map[string]i
 	return
 }
 
+// applyUserSysVars sets the system variables persisted by the current user in the session.
+func (ses *Session) applyUserSysVars(ctx context.Context) (err error) {
+	var execResults []ExecResult
+
+	tenantInfo := ses.GetTenantInfo()
+	tenantCtx := defines.AttachAccount(ctx, tenantInfo.TenantID, tenantInfo.UserID, tenantInfo.DefaultRoleID)
+	sqlForGetVariables := getSqlForGetUserVariables(int64(tenantInfo.GetUserID()))
+
+	if execResults, err = ExeSqlInBgSes(tenantCtx, ses, sqlForGetVariables); err != nil {
+		// the account that has not been upgraded has no mo_user_variables.
+		// no variable has been persisted for the user.
+		if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			return nil
+		}
+		return
+	}
+
+	for _, execResult := range execResults {
+		for i := uint64(0); i < execResult.GetRowCount(); i++ {
+			var varName, varValue string
+			if varName, err = execResult.GetString(tenantCtx, i, 0); err != nil {
+				return
+			}
+			if varValue, err = execResult.GetString(tenantCtx, i, 1); err != nil {
+				return
+			}
+
+			// the variables removed or turned into the global ones are skipped
+			sv, ok := gSysVarsDefs[varName]
+			if !ok || sv.Scope == ScopeGlobal {
+				continue
+			}
+			var val interface{}
+			if val, err = sv.GetType().ConvertFromString(varValue); err != nil {
+				return
+			}
+			if sv.UpdateSessVar != nil {
+				if err = sv.UpdateSessVar(tenantCtx, ses, ses.sesSysVars, varName, val); err != nil {
+					return
+				}
+			} else {
+				ses.sesSysVars.Set(varName, val)
+			}
+		}
+	}
+
+	return
+}
+
 func (ses *Session) GetPrivilege() *privilege {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -1363,6 +1511,53 @@ func (ses *Session) GetFromRealUser() bool {
 	return ses.fromRealUser
 }
 
+// setTrustedContext bypasses the privilege check for the object types.
+// It is only for the internal maintenance operations.
+func (ses *Session) setTrustedContext(objTypes ...objectType) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.trustedObjTypes = make(map[objectType]bool, len(objTypes))
+	for _, objType := range objTypes {
+		ses.trustedObjTypes[objType] = true
+	}
+}
+
+func (ses *Session) clearTrustedContext() {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.trustedObjTypes = nil
+}
+
+func (ses *Session) isTrustedFor(objType objectType) bool {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.trustedObjTypes[objType]
+}
+
+func (ses *Session) SetAuthPlugin(plugin string) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.authPlugin = plugin
+}
+
+func (ses *Session) GetAuthPlugin() string {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.authPlugin
+}
+
+func (ses *Session) setPasswordExpired(expired bool) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.passwordExpired = expired
+}
+
+func (ses *Session) isPasswordExpired() bool {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.passwordExpired
+}
+
 func changeVersion(ctx context.Context, ses *Session, db string) error {
 	var err error
 	if _, ok := bannedCatalogDatabases[db]; ok {
@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/ctl"
 	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
@@ -70,13 +71,13 @@ const (
 		"		CAST(ma.account_id AS BIGINT) AS account_id," +
 		"		ma.account_name," +
 		"		ma.admin_name," +
-		"		ma.created_time," +
+		"		ma.created_time AS created," +
 		"		ma.status," +
 		"		ma.suspended_time," +
 		"		db_tbl_counts.db_count," +
-		"		db_tbl_counts.tbl_count," +
+		"		db_tbl_counts.tbl_count AS table_count," +
 		"		CAST(0 AS DOUBLE) AS size," +
-		"		ma.comments" +
+		"		ma.comments AS comment" +
 		"	FROM" +
 		"		db_tbl_counts" +
 		"	JOIN" +
@@ -85,7 +86,7 @@ const (
 		"		db_tbl_counts.account_id = ma.account_id " +
 		"		%s" + // where clause
 		")" +
-		"SELECT * FROM final_result;"
+		"SELECT * FROM final_result%s;" // where clause of the show accounts
 )
 
 const idxOfAccountId = 0
@@ -106,12 +107,26 @@ const (
 	totalColumnCnt = 9
 )
 
+// filterColumnsOfShowAccounts are the columns of the show accounts that can be in the where clause.
+// The db_count, the table_count and the size are amended after the query. They can not be filtered in the query.
+var filterColumnsOfShowAccounts = map[string]struct{}{
+	"account_name":   {},
+	"admin_name":     {},
+	"created":        {},
+	"status":         {},
+	"suspended_time": {},
+	"comment":        {},
+}
+
 var cnUsageCache = logtail.NewStorageUsageCache(
 	logtail.WithLazyThreshold(5))
 
-func getSqlForAccountInfo(like *tree.ComparisonExpr, accId int64) string {
+// getSqlForAccountInfo makes the sql for the show accounts.
+// The like filters the account name. The where filters the columns of the show accounts.
+// The accId is -1 for all accounts.
+func getSqlForAccountInfo(like *tree.ComparisonExpr, where *tree.Where, accId int64) string {
 	var likePattern = ""
-	var where = ""
+	var whereOfAccount = ""
 	var and = ""
 	var account = ""
 	if like != nil {
@@ -127,12 +142,75 @@ func getSqlForAccountInfo(like *tree.ComparisonExpr, accId int64) string {
 	}
 
 	if len(likePattern) != 0 || len(account) != 0 {
-		where = "where"
+		whereOfAccount = "where"
 	}
 
-	clause := fmt.Sprintf("%s %s %s %s", where, likePattern, and, account)
+	clause := fmt.Sprintf("%s %s %s %s", whereOfAccount, likePattern, and, account)
+
+	filter := ""
+	if where != nil {
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+		where.Format(fmtCtx)
+		filter = " " + fmtCtx.String()
+	}
 
-	return fmt.Sprintf(getAccountInfoFormatV2, clause)
+	return fmt.Sprintf(getAccountInfoFormatV2, clause, filter)
+}
+
+// checkFilterOfShowAccounts checks the where clause of the show accounts.
+// The filter is run in the query of the sys account. It is restricted to
+// the columns of the show accounts, the constants and the operators on them.
+// The subqueries and the functions are rejected so that the normal account
+// can not read the other tables of the sys account through the filter.
+func checkFilterOfShowAccounts(ctx context.Context, expr tree.Expr) error {
+	var check func(exprs ...tree.Expr) error
+	check = func(exprs ...tree.Expr) error {
+		for _, expr := range exprs {
+			var err error
+			switch e := expr.(type) {
+			case nil, *tree.NumVal, *tree.StrVal:
+			case *tree.UnresolvedName:
+				if e.Star || e.NumParts != 1 {
+					return moerr.NewNotSupported(ctx, "the column %s in the where clause of the show accounts", tree.String(e, dialect.MYSQL))
+				}
+				if _, ok := filterColumnsOfShowAccounts[e.ColName()]; !ok {
+					return moerr.NewNotSupported(ctx, "the column %s in the where clause of the show accounts", e.ColNameOrigin())
+				}
+			case *tree.ParenExpr:
+				err = check(e.Expr)
+			case *tree.NotExpr:
+				err = check(e.Expr)
+			case *tree.UnaryExpr:
+				err = check(e.Expr)
+			case *tree.IsNullExpr:
+				err = check(e.Expr)
+			case *tree.IsNotNullExpr:
+				err = check(e.Expr)
+			case *tree.AndExpr:
+				err = check(e.Left, e.Right)
+			case *tree.OrExpr:
+				err = check(e.Left, e.Right)
+			case *tree.XorExpr:
+				err = check(e.Left, e.Right)
+			case *tree.BinaryExpr:
+				err = check(e.Left, e.Right)
+			case *tree.ComparisonExpr:
+				err = check(e.Left, e.Right, e.Escape)
+			case *tree.RangeCond:
+				err = check(e.Left, e.From, e.To)
+			case *tree.Tuple:
+				err = check(e.Exprs...)
+			default:
+				// the subqueries and the functions
+				return moerr.NewNotSupported(ctx, "the expression %s in the where clause of the show accounts", tree.String(expr, dialect.MYSQL))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return check(expr)
 }
 
 func requestStorageUsage(ctx context.Context, ses *Session, accIds [][]int64) (resp any, tried bool, err error) {
@@ -400,25 +478,33 @@ func doShowAccounts(ctx context.Context, ses *Session, sa *tree.ShowAccounts) (e
 		return err
 	}
 
+	if sa.Like != nil && sa.Where != nil {
+		return moerr.NewSyntaxError(ctx, "like clause and where clause cannot exist at the same time")
+	}
+
+	if sa.Where != nil {
+		if err = checkFilterOfShowAccounts(ctx, sa.Where.Expr); err != nil {
+			return err
+		}
+	}
+
 	if account.IsSysTenant() {
-		sql = getSqlForAccountInfo(sa.Like, -1)
+		sql = getSqlForAccountInfo(sa.Like, sa.Where, -1)
 		if accInfosBatches, accIds, err = getAccountInfo(ctx, bh, sql, mp); err != nil {
 			return err
 		}
 
 		// normal account
 	} else {
-		if sa.Like != nil {
-			return moerr.NewInternalError(ctx, "only sys account can use LIKE clause")
-		}
 		// switch to the sys account to get account info
+		// the normal account only sees itself even if it filters the accounts
 		newCtx := defines.AttachAccountId(ctx, uint32(sysAccountID))
-		sql = getSqlForAccountInfo(nil, int64(account.GetTenantID()))
+		sql = getSqlForAccountInfo(sa.Like, sa.Where, int64(account.GetTenantID()))
 		if accInfosBatches, accIds, err = getAccountInfo(newCtx, bh, sql, mp); err != nil {
 			return err
 		}
 
-		if len(accInfosBatches) != 1 {
+		if sa.Like == nil && sa.Where == nil && len(accInfosBatches) != 1 {
 			return moerr.NewInternalError(ctx, "no such account %v", account.GetTenantID())
 		}
 	}
@@ -447,6 +533,9 @@ func doShowAccounts(ctx context.Context, ses *Session, sa *tree.ShowAccounts) (e
 	}
 
 	backSes := bh.(*backExec)
+	if len(backSes.backSes.allResultSet) == 0 {
+		return moerr.NewInternalError(ctx, "no account info")
+	}
 	resultSet := backSes.backSes.allResultSet[0]
 	columnDef := backSes.backSes.rs
 	bh.ClearExecResultSet()
@@ -507,10 +596,8 @@ func getAccountInfo(ctx context.Context,
 		return nil, nil, err
 	}
 	//the resultBatches referred outside this function far away
+	//no batch if the filter of the show accounts matches nothing
 	rsOfMoAccount = Copy[*batch.Batch](bh.GetExecResultBatches())
-	if len(rsOfMoAccount) == 0 {
-		return nil, nil, moerr.NewInternalError(ctx, "no account info")
-	}
 
 	//copy rsOfMoAccount from backgroundExec
 	//the original batches to be released at the ClearExecResultBatches or the backgroundExec.Close
@@ -543,9 +630,13 @@ func getAccountInfo(ctx context.Context,
 		}
 
 		backSes := bh.(*backExec)
-		backSes.backSes.allResultSet[0].Columns = backSes.backSes.allResultSet[0].Columns[idxOfAccountId+1:]
-		delete(backSes.backSes.allResultSet[0].Name2Index, "account_id")
-		backSes.backSes.rs.ResultCols = backSes.backSes.rs.ResultCols[idxOfAccountId+1:]
+		if len(backSes.backSes.allResultSet) != 0 {
+			backSes.backSes.allResultSet[0].Columns = backSes.backSes.allResultSet[0].Columns[idxOfAccountId+1:]
+			delete(backSes.backSes.allResultSet[0].Name2Index, "account_id")
+		}
+		if backSes.backSes.rs != nil {
+			backSes.backSes.rs.ResultCols = backSes.backSes.rs.ResultCols[idxOfAccountId+1:]
+		}
 	}
 
 	return rsOfMoAccount, accountIds, err
@@ -34,17 +34,35 @@ import (
 
 func Test_getSqlForAccountInfo(t *testing.T) {
 	type arg struct {
-		s    string
-		want string
+		s     string
+		accId int64
+		want  string
 	}
 	args := []arg{
 		{
-			s:    "show accounts;",
-			want: "WITH db_tbl_counts AS (\tSELECT\t\tCAST(mt.account_id AS BIGINT) AS account_id,\t\tCOUNT(DISTINCT md.dat_id) AS db_count,\t\tCOUNT(DISTINCT mt.rel_id) AS tbl_count\tFROM\t\tmo_catalog.mo_tables AS mt\tJOIN\t\tmo_catalog.mo_database AS md\tON \t\tmt.account_id = md.account_id AND\t\tmt.relkind IN ('v','e','r','cluster') \tGROUP BY\t\tmt.account_id),final_result AS (\tSELECT\t\tCAST(ma.account_id AS BIGINT) AS account_id,\t\tma.account_name,\t\tma.admin_name,\t\tma.created_time,\t\tma.status,\t\tma.suspended_time,\t\tdb_tbl_counts.db_count,\t\tdb_tbl_counts.tbl_count,\t\tCAST(0 AS DOUBLE) AS size,\t\tma.comments\tFROM\t\tdb_tbl_counts\tJOIN\t\tmo_catalog.mo_account AS ma \tON \t\tdb_tbl_counts.account_id = ma.account_id \t\t   )SELECT * FROM final_result;",
+			s:     "show accounts;",
+			accId: -1,
+			want:  "WITH db_tbl_counts AS (\tSELECT\t\tCAST(mt.account_id AS BIGINT) AS account_id,\t\tCOUNT(DISTINCT md.dat_id) AS db_count,\t\tCOUNT(DISTINCT mt.rel_id) AS tbl_count\tFROM\t\tmo_catalog.mo_tables AS mt\tJOIN\t\tmo_catalog.mo_database AS md\tON \t\tmt.account_id = md.account_id AND\t\tmt.relkind IN ('v','e','r','cluster') \tGROUP BY\t\tmt.account_id),final_result AS (\tSELECT\t\tCAST(ma.account_id AS BIGINT) AS account_id,\t\tma.account_name,\t\tma.admin_name,\t\tma.created_time AS created,\t\tma.status,\t\tma.suspended_time,\t\tdb_tbl_counts.db_count,\t\tdb_tbl_counts.tbl_count AS table_count,\t\tCAST(0 AS DOUBLE) AS size,\t\tma.comments AS comment\tFROM\t\tdb_tbl_counts\tJOIN\t\tmo_catalog.mo_account AS ma \tON \t\tdb_tbl_counts.account_id = ma.account_id \t\t   )SELECT * FROM final_result;",
 		},
 		{
-			s:    "show accounts like '%abc';",
-			want: "WITH db_tbl_counts AS (\tSELECT\t\tCAST(mt.account_id AS BIGINT) AS account_id,\t\tCOUNT(DISTINCT md.dat_id) AS db_count,\t\tCOUNT(DISTINCT mt.rel_id) AS tbl_count\tFROM\t\tmo_catalog.mo_tables AS mt\tJOIN\t\tmo_catalog.mo_database AS md\tON \t\tmt.account_id = md.account_id AND\t\tmt.relkind IN ('v','e','r','cluster') \tGROUP BY\t\tmt.account_id),final_result AS (\tSELECT\t\tCAST(ma.account_id AS BIGINT) AS account_id,\t\tma.account_name,\t\tma.admin_name,\t\tma.created_time,\t\tma.status,\t\tma.suspended_time,\t\tdb_tbl_counts.db_count,\t\tdb_tbl_counts.tbl_count,\t\tCAST(0 AS DOUBLE) AS size,\t\tma.comments\tFROM\t\tdb_tbl_counts\tJOIN\t\tmo_catalog.mo_account AS ma \tON \t\tdb_tbl_counts.account_id = ma.account_id \t\twhere ma.account_name like '%abc'  )SELECT * FROM final_result;",
+			s:     "show accounts like '%abc';",
+			accId: -1,
+			want:  "WITH db_tbl_counts AS (\tSELECT\t\tCAST(mt.account_id AS BIGINT) AS account_id,\t\tCOUNT(DISTINCT md.dat_id) AS db_count,\t\tCOUNT(DISTINCT mt.rel_id) AS tbl_count\tFROM\t\tmo_catalog.mo_tables AS mt\tJOIN\t\tmo_catalog.mo_database AS md\tON \t\tmt.account_id = md.account_id AND\t\tmt.relkind IN ('v','e','r','cluster') \tGROUP BY\t\tmt.account_id),final_result AS (\tSELECT\t\tCAST(ma.account_id AS BIGINT) AS account_id,\t\tma.account_name,\t\tma.admin_name,\t\tma.created_time AS created,\t\tma.status,\t\tma.suspended_time,\t\tdb_tbl_counts.db_count,\t\tdb_tbl_counts.tbl_count AS table_count,\t\tCAST(0 AS DOUBLE) AS size,\t\tma.comments AS comment\tFROM\t\tdb_tbl_counts\tJOIN\t\tmo_catalog.mo_account AS ma \tON \t\tdb_tbl_counts.account_id = ma.account_id \t\twhere ma.account_name like '%abc'  )SELECT * FROM final_result;",
+		},
+		{
+			s:     "show accounts where status = 'suspend';",
+			accId: -1,
+			want:  "WITH db_tbl_counts AS (\tSELECT\t\tCAST(mt.account_id AS BIGINT) AS account_id,\t\tCOUNT(DISTINCT md.dat_id) AS db_count,\t\tCOUNT(DISTINCT mt.rel_id) AS tbl_count\tFROM\t\tmo_catalog.mo_tables AS mt\tJOIN\t\tmo_catalog.mo_database AS md\tON \t\tmt.account_id = md.account_id AND\t\tmt.relkind IN ('v','e','r','cluster') \tGROUP BY\t\tmt.account_id),final_result AS (\tSELECT\t\tCAST(ma.account_id AS BIGINT) AS account_id,\t\tma.account_name,\t\tma.admin_name,\t\tma.created_time AS created,\t\tma.status,\t\tma.suspended_time,\t\tdb_tbl_counts.db_count,\t\tdb_tbl_counts.tbl_count AS table_count,\t\tCAST(0 AS DOUBLE) AS size,\t\tma.comments AS comment\tFROM\t\tdb_tbl_counts\tJOIN\t\tmo_catalog.mo_account AS ma \tON \t\tdb_tbl_counts.account_id = ma.account_id \t\t   )SELECT * FROM final_result where status = \"suspend\";",
+		},
+		{
+			s:     "show accounts like '%abc';",
+			accId: 5,
+			want:  "WITH db_tbl_counts AS (\tSELECT\t\tCAST(mt.account_id AS BIGINT) AS account_id,\t\tCOUNT(DISTINCT md.dat_id) AS db_count,\t\tCOUNT(DISTINCT mt.rel_id) AS tbl_count\tFROM\t\tmo_catalog.mo_tables AS mt\tJOIN\t\tmo_catalog.mo_database AS md\tON \t\tmt.account_id = md.account_id AND\t\tmt.relkind IN ('v','e','r','cluster') \tGROUP BY\t\tmt.account_id),final_result AS (\tSELECT\t\tCAST(ma.account_id AS BIGINT) AS account_id,\t\tma.account_name,\t\tma.admin_name,\t\tma.created_time AS created,\t\tma.status,\t\tma.suspended_time,\t\tdb_tbl_counts.db_count,\t\tdb_tbl_counts.tbl_count AS table_count,\t\tCAST(0 AS DOUBLE) AS size,\t\tma.comments AS comment\tFROM\t\tdb_tbl_counts\tJOIN\t\tmo_catalog.mo_account AS ma \tON \t\tdb_tbl_counts.account_id = ma.account_id \t\twhere ma.account_name like '%abc' and ma.account_id = 5)SELECT * FROM final_result;",
+		},
+		{
+			s:     "show accounts where status = 'suspend' and comment like 'a%';",
+			accId: 5,
+			want:  "WITH db_tbl_counts AS (\tSELECT\t\tCAST(mt.account_id AS BIGINT) AS account_id,\t\tCOUNT(DISTINCT md.dat_id) AS db_count,\t\tCOUNT(DISTINCT mt.rel_id) AS tbl_count\tFROM\t\tmo_catalog.mo_tables AS mt\tJOIN\t\tmo_catalog.mo_database AS md\tON \t\tmt.account_id = md.account_id AND\t\tmt.relkind IN ('v','e','r','cluster') \tGROUP BY\t\tmt.account_id),final_result AS (\tSELECT\t\tCAST(ma.account_id AS BIGINT) AS account_id,\t\tma.account_name,\t\tma.admin_name,\t\tma.created_time AS created,\t\tma.status,\t\tma.suspended_time,\t\tdb_tbl_counts.db_count,\t\tdb_tbl_counts.tbl_count AS table_count,\t\tCAST(0 AS DOUBLE) AS size,\t\tma.comments AS comment\tFROM\t\tdb_tbl_counts\tJOIN\t\tmo_catalog.mo_account AS ma \tON \t\tdb_tbl_counts.account_id = ma.account_id \t\twhere   ma.account_id = 5)SELECT * FROM final_result where status = \"suspend\" and comment like \"a%\";",
 		},
 	}
 
@@ -52,11 +70,38 @@ func Test_getSqlForAccountInfo(t *testing.T) {
 		one, err := parsers.ParseOne(context.Background(), dialect.MYSQL, a.s, 1)
 		assert.NoError(t, err)
 		sa1 := one.(*tree.ShowAccounts)
-		r1 := getSqlForAccountInfo(sa1.Like, -1)
+		r1 := getSqlForAccountInfo(sa1.Like, sa1.Where, a.accId)
 		assert.Equal(t, a.want, r1)
 	}
 }
 
+func Test_checkFilterOfShowAccounts(t *testing.T) {
+	kases := []struct {
+		s       string
+		wantErr bool
+	}{
+		{"show accounts where status = 'suspend';", false},
+		{"show accounts where comment = 'x' and (created > '2024-01-01' or suspended_time is not null);", false},
+		{"show accounts where account_name in ('a', 'b') and admin_name like 'r%';", false},
+		{"show accounts where table_count > 1;", true},
+		{"show accounts where ma.status = 'open';", true},
+		{"show accounts where (select count(*) from mo_catalog.mo_user where user_name = 'root') = 1;", true},
+		{"show accounts where exists (select 1 from mo_catalog.mo_user);", true},
+		{"show accounts where status in (select status from mo_catalog.mo_account);", true},
+		{"show accounts where lower(status) = 'open';", true},
+	}
+	for _, kase := range kases {
+		one, err := parsers.ParseOne(context.Background(), dialect.MYSQL, kase.s, 1)
+		assert.NoError(t, err)
+		err = checkFilterOfShowAccounts(context.Background(), one.(*tree.ShowAccounts).Where.Expr)
+		if kase.wantErr {
+			assert.Error(t, err, kase.s)
+		} else {
+			assert.NoError(t, err, kase.s)
+		}
+	}
+}
+
 func Test_updateStorageSize(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,281 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+const (
+	getDefinitionOfFunctionFormat  = `select args, retType, body, language from mo_catalog.mo_user_defined_function where name = "%s" and db = "%s" order by function_id;`
+	getDefinitionOfProcedureFormat = `select args, body from mo_catalog.mo_stored_procedure where name = "%s" and db = "%s" order by proc_id;`
+)
+
+var (
+	showCreateFunctionOutputColumns = [2]Column{
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Function",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Create Function",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+	}
+
+	showCreateProcedureOutputColumns = [2]Column{
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Procedure",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Create Procedure",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+	}
+)
+
+// procedureArgForShow is the argument of the procedure unmarshalled from the mo_stored_procedure.
+type procedureArgForShow struct {
+	Type      *tree.T
+	InOutType tree.InOutArgType
+}
+
+func getSqlForDefinitionOfFunction(ctx context.Context, dbName, name string) (string, error) {
+	err := inputNameIsInvalid(ctx, dbName, name)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getDefinitionOfFunctionFormat, name, dbName), nil
+}
+
+func getSqlForDefinitionOfProcedure(ctx context.Context, dbName, name string) (string, error) {
+	err := inputNameIsInvalid(ctx, dbName, name)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getDefinitionOfProcedureFormat, name, dbName), nil
+}
+
+// getDbNameOfRoutine returns the database of the function or the procedure.
+func getDbNameOfRoutine(ses *Session, hasNoQualifier bool, schemaName tree.Identifier) (string, error) {
+	if hasNoQualifier {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		return ses.GetDatabaseName(), nil
+	}
+	return string(schemaName), nil
+}
+
+// makeCreateFunctionSql reassembles the CREATE FUNCTION statement from the columns of the mo_user_defined_function.
+func makeCreateFunctionSql(dbName, name, argsStr, retType, body, language string) (string, error) {
+	var args []function.Arg
+	if len(argsStr) != 0 {
+		if err := json.Unmarshal([]byte(argsStr), &args); err != nil {
+			return "", err
+		}
+	}
+	argDefs := make([]string, 0, len(args))
+	for _, arg := range args {
+		argDefs = append(argDefs, arg.Name+" "+arg.Type)
+	}
+
+	sql := fmt.Sprintf("create function %s.%s (%s) returns %s language %s", dbName, name, strings.Join(argDefs, ", "), retType, language)
+	if language == string(tree.SQL) {
+		return sql + " as " + quoteVariableValue(body), nil
+	}
+
+	nb := function.NonSqlUdfBody{}
+	if err := json.Unmarshal([]byte(body), &nb); err != nil {
+		return "", err
+	}
+	if nb.Import {
+		sql += " import " + quoteVariableValue(nb.Body)
+	} else {
+		sql += " as " + quoteVariableValue(nb.Body)
+	}
+	if len(nb.Handler) != 0 {
+		sql += " handler " + quoteVariableValue(nb.Handler)
+	}
+	return sql, nil
+}
+
+// makeCreateProcedureSql reassembles the CREATE PROCEDURE statement from the columns of the mo_stored_procedure.
+// The arguments are kept by the name. They are listed in the order of the name.
+func makeCreateProcedureSql(dbName, name, argsStr, body string) (string, error) {
+	var args map[string]procedureArgForShow
+	if len(argsStr) != 0 {
+		if err := json.Unmarshal([]byte(argsStr), &args); err != nil {
+			return "", err
+		}
+	}
+	argNames := make([]string, 0, len(args))
+	for argName := range args {
+		argNames = append(argNames, argName)
+	}
+	sort.Strings(argNames)
+
+	fmtctx := tree.NewFmtCtx(dialect.MYSQL)
+	argDefs := make([]string, 0, len(args))
+	for _, argName := range argNames {
+		arg := args[argName]
+		switch arg.InOutType {
+		case tree.TYPE_OUT:
+			fmtctx.WriteString("out ")
+		case tree.TYPE_INOUT:
+			fmtctx.WriteString("inout ")
+		default:
+			fmtctx.WriteString("in ")
+		}
+		fmtctx.WriteString(argName)
+		if arg.Type != nil {
+			fmtctx.WriteByte(' ')
+			arg.Type.InternalType.Format(fmtctx)
+		}
+		argDefs = append(argDefs, fmtctx.String())
+		fmtctx.Reset()
+	}
+
+	return fmt.Sprintf("create procedure %s.%s (%s) %s", dbName, name, strings.Join(argDefs, ", "), quoteVariableValue(body)), nil
+}
+
+// doShowCreateFunction shows the CREATE FUNCTION statements of all the overloads of the function.
+func doShowCreateFunction(ctx context.Context, ses *Session, scf *tree.ShowCreateFunction) (err error) {
+	var sql, argsStr, retType, body, language, createSql string
+	var erArray []ExecResult
+
+	dbName, err := getDbNameOfRoutine(ses, scf.Name.HasNoNameQualifier(), scf.Name.Name.SchemaName)
+	if err != nil {
+		return err
+	}
+	name := string(scf.Name.Name.ObjectName)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	sql, err = getSqlForDefinitionOfFunction(ctx, dbName, name)
+	if err != nil {
+		return err
+	}
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewNoUDFNoCtx(name)
+	}
+
+	var rs = &MysqlResultSet{}
+	for _, column := range showCreateFunctionOutputColumns {
+		rs.AddColumn(column)
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		if argsStr, err = erArray[0].GetString(ctx, i, 0); err != nil {
+			return err
+		}
+		if retType, err = erArray[0].GetString(ctx, i, 1); err != nil {
+			return err
+		}
+		if body, err = erArray[0].GetString(ctx, i, 2); err != nil {
+			return err
+		}
+		if language, err = erArray[0].GetString(ctx, i, 3); err != nil {
+			return err
+		}
+		if createSql, err = makeCreateFunctionSql(dbName, name, argsStr, retType, body, language); err != nil {
+			return err
+		}
+		rs.AddRow([]interface{}{name, createSql})
+	}
+	ses.SetMysqlResultSet(rs)
+
+	return trySaveQueryResult(ctx, ses, rs)
+}
+
+// doShowCreateProcedure shows the CREATE PROCEDURE statements of all the overloads of the procedure.
+func doShowCreateProcedure(ctx context.Context, ses *Session, scp *tree.ShowCreateProcedure) (err error) {
+	var sql, argsStr, body, createSql string
+	var erArray []ExecResult
+
+	dbName, err := getDbNameOfRoutine(ses, scp.Name.HasNoNameQualifier(), scp.Name.Name.SchemaName)
+	if err != nil {
+		return err
+	}
+	name := string(scp.Name.Name.ObjectName)
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	sql, err = getSqlForDefinitionOfProcedure(ctx, dbName, name)
+	if err != nil {
+		return err
+	}
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewNoUDFNoCtx(name)
+	}
+
+	var rs = &MysqlResultSet{}
+	for _, column := range showCreateProcedureOutputColumns {
+		rs.AddColumn(column)
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		if argsStr, err = erArray[0].GetString(ctx, i, 0); err != nil {
+			return err
+		}
+		if body, err = erArray[0].GetString(ctx, i, 1); err != nil {
+			return err
+		}
+		if createSql, err = makeCreateProcedureSql(dbName, name, argsStr, body); err != nil {
+			return err
+		}
+		rs.AddRow([]interface{}{name, createSql})
+	}
+	ses.SetMysqlResultSet(rs)
+
+	return trySaveQueryResult(ctx, ses, rs)
+}
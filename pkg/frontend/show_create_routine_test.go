@@ -0,0 +1,140 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func TestMakeCreateFunctionSql(t *testing.T) {
+	sql, err := makeCreateFunctionSql("db1", "f1", `[{"name": "a", "type": "int"}, {"name": "b", "type": "int"}]`, "int", "$1 + $2", "sql")
+	require.NoError(t, err)
+	require.Equal(t, "create function db1.f1 (a int, b int) returns int language sql as '$1 + $2'", sql)
+
+	sql, err = makeCreateFunctionSql("db1", "f2", "", "varchar", "select 'a'", "sql")
+	require.NoError(t, err)
+	require.Equal(t, "create function db1.f2 () returns varchar language sql as 'select ''a'''", sql)
+
+	sql, err = makeCreateFunctionSql("db1", "f3", `[{"name": "a", "type": "int"}]`, "int", `{"handler": "add", "import": false, "body": "def add(a):\n  return a"}`, "python")
+	require.NoError(t, err)
+	require.Equal(t, "create function db1.f3 (a int) returns int language python as 'def add(a):\n  return a' handler 'add'", sql)
+
+	sql, err = makeCreateFunctionSql("db1", "f4", `[{"name": "a", "type": "int"}]`, "int", `{"handler": "add", "import": true, "body": "stage://s1/add.py"}`, "python")
+	require.NoError(t, err)
+	require.Equal(t, "create function db1.f4 (a int) returns int language python import 'stage://s1/add.py' handler 'add'", sql)
+
+	_, err = makeCreateFunctionSql("db1", "f5", "[", "int", "", "sql")
+	require.Error(t, err)
+}
+
+func TestMakeCreateProcedureSql(t *testing.T) {
+	stmt, err := mysql.ParseOne(context.Background(), "create procedure p1 (inout b varchar(10), a int, out c int) 'begin select 1; end'", 1)
+	require.NoError(t, err)
+	argsJson, err := getProcedureArgsJson(stmt.(*tree.CreateProcedure).Args)
+	require.NoError(t, err)
+
+	sql, err := makeCreateProcedureSql("db1", "p1", string(argsJson), "begin select 'a'; end")
+	require.NoError(t, err)
+	require.Equal(t, "create procedure db1.p1 (in a int, inout b varchar(10), out c int) 'begin select ''a''; end'", sql)
+
+	sql, err = makeCreateProcedureSql("db1", "p2", "{}", "begin select 1; end")
+	require.NoError(t, err)
+	require.Equal(t, "create procedure db1.p2 () 'begin select 1; end'", sql)
+}
+
+func TestDoShowCreateRoutine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetDatabaseName("db1")
+
+	sql2result := make(map[string]ExecResult)
+	sql, err := getSqlForDefinitionOfFunction(ctx, "db1", "f1")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"args", "retType", "body", "language"}, [][]interface{}{
+		{`[{"name": "a", "type": "int"}]`, "int", "$1 + 1", "sql"},
+		{`[{"name": "a", "type": "bigint"}]`, "bigint", "$1 + 2", "sql"},
+	})
+	sql, err = getSqlForDefinitionOfFunction(ctx, "db1", "f2")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"args", "retType", "body", "language"}, nil)
+	sql, err = getSqlForDefinitionOfProcedure(ctx, "db2", "p1")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"args", "body"}, [][]interface{}{
+		{"{}", "begin select 1; end"},
+	})
+	sql, err = getSqlForDefinitionOfProcedure(ctx, "db1", "p2")
+	require.NoError(t, err)
+	sql2result[sql] = newMrsForStrings([]string{"args", "body"}, nil)
+
+	bh := newBh(ctrl, sql2result)
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	// the overloads of the function
+	err = doShowCreateFunction(ctx, ses, &tree.ShowCreateFunction{
+		Name: tree.NewFuncName("f1", tree.ObjectNamePrefix{}),
+	})
+	require.NoError(t, err)
+	rs := ses.GetMysqlResultSet()
+	require.Equal(t, uint64(2), rs.GetRowCount())
+	row, err := rs.GetRow(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"f1", "create function db1.f1 (a bigint) returns bigint language sql as '$1 + 2'"}, row)
+
+	err = doShowCreateFunction(ctx, ses, &tree.ShowCreateFunction{
+		Name: tree.NewFuncName("f2", tree.ObjectNamePrefix{}),
+	})
+	require.Error(t, err)
+
+	// the procedure in the other database
+	err = doShowCreateProcedure(ctx, ses, &tree.ShowCreateProcedure{
+		Name: tree.NewProcedureName("p1", tree.ObjectNamePrefix{SchemaName: "db2", ExplicitSchema: true}),
+	})
+	require.NoError(t, err)
+	rs = ses.GetMysqlResultSet()
+	require.Equal(t, uint64(1), rs.GetRowCount())
+	row, err = rs.GetRow(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"p1", "create procedure db2.p1 () 'begin select 1; end'"}, row)
+
+	err = doShowCreateProcedure(ctx, ses, &tree.ShowCreateProcedure{
+		Name: tree.NewProcedureName("p2", tree.ObjectNamePrefix{}),
+	})
+	require.Error(t, err)
+
+	// the privilege to show the routine
+	priv := determinePrivilegeSetOfStatement(&tree.ShowCreateProcedure{
+		Name: tree.NewProcedureName("p1", tree.ObjectNamePrefix{}),
+	})
+	require.Equal(t, objectTypeDatabase, priv.objectType())
+	hasExecute := false
+	for _, entry := range priv.entries {
+		if entry.privilegeId == PrivilegeTypeExecute {
+			hasExecute = true
+		}
+	}
+	require.True(t, hasExecute)
+}
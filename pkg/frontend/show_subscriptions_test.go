@@ -314,3 +314,113 @@ func TestDoShowSubscriptionsAllLike(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expected, actual)
 }
+
+func TestDoShowSubscribers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+
+	ses := newTestSession(t, ctrl)
+	ses.SetTimeZone(time.UTC)
+	ses.SetTenantInfo(tenant)
+	ses.SetMysqlResultSet(&MysqlResultSet{})
+	defer ses.Close()
+
+	mp := ses.GetMemPool()
+	bh.init(mp)
+	sa := &tree.ShowSubscribers{Publication: "pub1"}
+
+	bhStub := gostub.StubFunc(&GetRawBatchBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	err := doShowSubscribers(ctx, ses, sa)
+	require.NoError(t, err)
+
+	rs := ses.GetMysqlResultSet()
+	require.Equal(t, uint64(len(showSubscribersOutputColumns)), rs.GetColumnCount())
+	require.Equal(t, uint64(1), rs.GetRowCount())
+
+	// the publishing account itself is skipped
+	expected := []interface{}{"account1", "sub1", "0001-01-01 00:00:01"}
+	actual, err := rs.GetRow(ctx, uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	// the publication does not exist
+	bh.initLike(mp)
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        "account1",
+		User:          "admin",
+		DefaultRole:   accountAdminRoleName,
+		TenantID:      1,
+		UserID:        2,
+		DefaultRoleID: accountAdminRoleID,
+	})
+	err = doShowSubscribers(ctx, ses, &tree.ShowSubscribers{Publication: "pub4"})
+	require.Error(t, err)
+
+	// not the admin
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        "account1",
+		User:          "u1",
+		DefaultRole:   "r1",
+		TenantID:      1,
+		UserID:        3,
+		DefaultRoleID: 3,
+	})
+	err = doShowSubscribers(ctx, ses, sa)
+	require.Error(t, err)
+}
+
+func TestCanSub(t *testing.T) {
+	kases := []struct {
+		account     string
+		accountList string
+		want        bool
+	}{
+		{"acc", "all", true},
+		{"acc", "ALL", true},
+		{"acc", "acc", true},
+		{"acc", "acc2", false},
+		{"acc2", "acc", false},
+		{"acc", "acc1,acc2,bacc", false},
+		{"acc", "acc1,acc,acc2", true},
+		{"acc", "", false},
+		{"", "", false},
+		{"", "acc,,acc2", false},
+		{"team_a", "like:team_%", true},
+		{"team_a", "acc,like:team_%", true},
+		{"teamb", "acc,like:team_%", false},
+		{"acc", "acc,like:team_%", true},
+		{"team", "like:team_%", false},
+		{"like:team_%", "like:team_%", false},
+	}
+	for _, kase := range kases {
+		require.Equal(t, kase.want, canSub(kase.account, kase.accountList), "%s in %s", kase.account, kase.accountList)
+	}
+}
+
+func TestSplitAccountList(t *testing.T) {
+	require.Equal(t, []string{}, splitAccountList(""))
+	require.Equal(t, []string{"acc"}, splitAccountList("acc"))
+	require.Equal(t, []string{"acc", "acc1", "acc2"}, splitAccountList("acc2,acc,acc1"))
+	require.Equal(t, []string{"acc", "acc2"}, splitAccountList("acc,, acc2"))
+}
+
+func TestJoinAccountList(t *testing.T) {
+	require.Equal(t, "", joinAccountList(nil, ""))
+	require.Equal(t, "acc,acc2", joinAccountList([]string{"acc2", "acc"}, ""))
+	require.Equal(t, "like:team_%", joinAccountList(nil, "team_%"))
+	require.Equal(t, "acc,like:team_%,zacc", joinAccountList([]string{"zacc", "acc"}, "team_%"))
+
+	accts, patterns := splitAccountPatterns(splitAccountList("zacc,like:team_%,acc"))
+	require.Equal(t, []string{"acc", "zacc"}, accts)
+	require.Equal(t, []string{"team_%"}, patterns)
+
+	require.Equal(t, "all", formatAccountListForExport("all"))
+	require.Equal(t, "acc, zacc", formatAccountListForExport("acc,zacc"))
+	require.Equal(t, "like 'team_%'", formatAccountListForExport("like:team_%"))
+	require.Equal(t, "acc, zacc like 'team_%'", formatAccountListForExport("acc,like:team_%,zacc"))
+}
@@ -74,6 +74,29 @@ var (
 	}
 )
 
+var (
+	showSubscribersOutputColumns = [3]Column{
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "sub_account",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "sub_name",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "sub_time",
+				columnType: defines.MYSQL_TYPE_TIMESTAMP,
+			},
+		},
+	}
+)
+
 type published struct {
 	pubName     string
 	pubAccount  string
@@ -127,13 +150,70 @@ func getAccountIdNames(ctx context.Context, ses *Session, bh BackgroundExec, lik
 	return accountIds, accountNames, nil
 }
 
+// pubAccountPatternPrefix marks the entry of the account_list that is the pattern of the account names.
+// The ':' is not allowed in the account name. The entry can not be taken as an account.
+const pubAccountPatternPrefix = "like:"
+
+// accountPatternIsInvalid checks the pattern of the account names.
+// Besides the characters of the account name, the wildcard '%' is allowed.
+func accountPatternIsInvalid(pattern string) bool {
+	return accountNameIsInvalid(strings.ReplaceAll(pattern, "%", "_"))
+}
+
+// joinAccountList makes the account_list of the mo_pubs from the account names and the pattern.
+func joinAccountList(accts []string, pattern string) string {
+	entries := make([]string, 0, len(accts)+1)
+	entries = append(entries, accts...)
+	if len(pattern) != 0 {
+		entries = append(entries, pubAccountPatternPrefix+pattern)
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// splitAccountPatterns separates the account names and the patterns in the split account_list.
+func splitAccountPatterns(entries []string) (accts []string, patterns []string) {
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, pubAccountPatternPrefix) {
+			patterns = append(patterns, entry[len(pubAccountPatternPrefix):])
+		} else {
+			accts = append(accts, entry)
+		}
+	}
+	return accts, patterns
+}
+
+// splitAccountList splits the account_list of the mo_pubs into the sorted account names.
+// The empty names are skipped.
+func splitAccountList(accountList string) []string {
+	accts := make([]string, 0)
+	for _, acct := range strings.Split(accountList, ",") {
+		if acct = strings.TrimSpace(acct); len(acct) != 0 {
+			accts = append(accts, acct)
+		}
+	}
+	sort.Strings(accts)
+	return accts
+}
+
+// canSub checks the account can subscribe the publication with the account_list.
+// The "all" allows every account. Otherwise, the account listed by the name is allowed first.
+// The account not listed is allowed only if it matches one of the patterns.
+// The patterns only extend the listed accounts and never exclude any of them.
 func canSub(subAccount, subAccountListStr string) bool {
 	if strings.ToLower(subAccountListStr) == "all" {
 		return true
 	}
 
-	for _, acc := range strings.Split(subAccountListStr, ",") {
-		if acc == subAccount {
+	accts := splitAccountList(subAccountListStr)
+	idx := sort.SearchStrings(accts, subAccount)
+	if idx < len(accts) && accts[idx] == subAccount {
+		return true
+	}
+
+	_, patterns := splitAccountPatterns(accts)
+	for _, pattern := range patterns {
+		if WildcardMatch(pattern, subAccount) {
 			return true
 		}
 	}
@@ -336,3 +416,106 @@ func doShowSubscriptions(ctx context.Context, ses *Session, ss *tree.ShowSubscri
 
 	return trySaveQueryResult(ctx, ses, rs)
 }
+
+// subscriber is the subscription of the publication in the account
+type subscriber struct {
+	subAccount string
+	subName    string
+	subTime    string
+}
+
+// pubExists checks the publication exists in the account
+func pubExists(ctx context.Context, bh BackgroundExec, accountId uint32, pubName string) (bool, error) {
+	bh.ClearExecResultBatches()
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, accountId)
+	sql := getPubsSql + fmt.Sprintf(" where pub_name = '%s';", pubName)
+	if err := bh.Exec(ctx, sql); err != nil {
+		return false, err
+	}
+	for _, batch := range bh.GetExecResultBatches() {
+		if batch.RowCount() > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// doShowSubscribers lists the accounts that subscribe the publication of the current account.
+// The subscription databases of all the accounts are scanned.
+// Only the admin of the publishing account can do it.
+func doShowSubscribers(ctx context.Context, ses *Session, ss *tree.ShowSubscribers) (err error) {
+	tenantInfo := ses.GetTenantInfo()
+	if tenantInfo == nil || !tenantInfo.IsAdminRole() {
+		return moerr.NewInternalError(ctx, "only admin can show subscribers")
+	}
+	pubName := string(ss.Publication)
+
+	bh := GetRawBatchBackgroundExec(ctx, ses)
+	defer bh.Close()
+
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return err
+	}
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+
+	// step 1. check the publication
+	var exists bool
+	if exists, err = pubExists(ctx, bh, tenantInfo.GetTenantID(), pubName); err != nil {
+		return err
+	}
+	if !exists {
+		return moerr.NewInternalError(ctx, "there is no publication %s", pubName)
+	}
+
+	// step 2. get all account
+	var accountIds []int32
+	var accountNames []string
+	if accountIds, accountNames, err = getAccountIdNames(ctx, ses, bh, ""); err != nil {
+		return err
+	}
+
+	// step 3. traversal all accounts, get the subscriptions of the publication
+	var subscribers []*subscriber
+	for i := 0; i < len(accountIds); i++ {
+		if uint32(accountIds[i]) == tenantInfo.GetTenantID() {
+			continue
+		}
+		var subs []*subscribed
+		if subs, err = getSubs(ctx, ses, bh, uint32(accountIds[i])); err != nil {
+			return err
+		}
+		for _, sub := range subs {
+			if sub.pubAccount != tenantInfo.GetTenant() || sub.pubName != pubName {
+				continue
+			}
+			subscribers = append(subscribers, &subscriber{
+				subAccount: accountNames[i],
+				subName:    sub.subName,
+				subTime:    sub.subTime,
+			})
+		}
+	}
+
+	// sort by sub_account, sub_name asc
+	sort.SliceStable(subscribers, func(i, j int) bool {
+		if subscribers[i].subAccount != subscribers[j].subAccount {
+			return subscribers[i].subAccount < subscribers[j].subAccount
+		}
+		return subscribers[i].subName < subscribers[j].subName
+	})
+
+	// step 4. generate result set
+	bh.ClearExecResultBatches()
+	var rs = &MysqlResultSet{}
+	for _, column := range showSubscribersOutputColumns {
+		rs.AddColumn(column)
+	}
+	for _, sub := range subscribers {
+		rs.AddRow([]interface{}{sub.subAccount, sub.subName, sub.subTime})
+	}
+	ses.SetMysqlResultSet(rs)
+
+	return trySaveQueryResult(ctx, ses, rs)
+}
@@ -107,6 +107,10 @@ var (
 		"mo_stored_procedure":         0,
 		"mo_mysql_compatibility_mode": 0,
 		"mo_stages":                   0,
+		"mo_password_history":         0,
+		"mo_column_privs":             0,
+		"mo_user_variables":           0,
+		"mo_variable_audit":           0,
 		"mo_pubs":                     1,
 
 		"mo_sessions":       1,
@@ -1314,6 +1318,7 @@ func checkPubAndDropPubRecord(
 			if err = bh.Exec(ctx, sql); err != nil {
 				return
 			}
+			increasePublicationEpoch()
 		}
 	}
 	return
@@ -26,7 +26,7 @@ func IsAdministrativeStatement(stmt tree.Statement) bool {
 	switch st := stmt.(type) {
 	case *tree.CreateAccount, *tree.DropAccount, *tree.AlterAccount,
 		*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
-		*tree.CreateRole, *tree.DropRole,
+		*tree.CreateRole, *tree.DropRole, *tree.AlterRole, *tree.MergeRole,
 		*tree.Revoke, *tree.Grant,
 		*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
 		return true
@@ -174,8 +174,12 @@ func statementCanBeExecutedInUncommittedTransaction(ctx context.Context, ses FeS
 		*tree.ShowAccounts,
 		*tree.ShowPublications,
 		*tree.ShowSubscriptions,
+		*tree.ShowSubscribers,
+		*tree.ShowCreateFunction,
+		*tree.ShowCreateProcedure,
 		*tree.ShowCreatePublications,
 		*tree.ShowBackendServers,
+		*tree.ShowRoleHierarchy,
 		*tree.ShowAccountUpgrade,
 		*tree.ShowConnectors:
 		return true, nil
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -132,6 +133,11 @@ func createTablesInMoCatalog(ctx context.Context, txn executor.TxnExecutor, fina
 	addSqlIntoSet(addInitSystemVariablesSql(sysAccountID, sysAccountName, SaveQueryResult, pu))
 	addSqlIntoSet(addInitSystemVariablesSql(sysAccountID, sysAccountName, QueryResultMaxsize, pu))
 	addSqlIntoSet(addInitSystemVariablesSql(sysAccountID, sysAccountName, QueryResultTimeout, pu))
+	addSqlIntoSet(addInitSystemVariablesSql(sysAccountID, sysAccountName, FailedLoginAttempts, pu))
+	addSqlIntoSet(addInitSystemVariablesSql(sysAccountID, sysAccountName, PasswordLockTime, pu))
+	for _, variableName := range passwordPolicyVariables {
+		addSqlIntoSet(addInitSystemVariablesSql(sysAccountID, sysAccountName, variableName, pu))
+	}
 
 	//fill the mo_account, mo_role, mo_user, mo_role_privs, mo_user_grant, mo_mysql_compatibility_mode
 	for _, sql := range initDataSqls {
@@ -229,3 +235,63 @@ func GenSQLForCheckUpgradeAccountPrivilegeExist() string {
 		moAdminRoleID, entry.objType, entry.objId, entry.privilegeId, entry.privilegeLevel)
 	return sql
 }
+
+// genSQLForNamesWithDelimiters generates an SQL statement to get a name of the users
+// and the roles that contains any of the delimiters.
+func genSQLForNamesWithDelimiters(delimiters string) string {
+	userConds := make([]string, 0, len(delimiters))
+	roleConds := make([]string, 0, len(delimiters))
+	for i := 0; i < len(delimiters); i++ {
+		userConds = append(userConds, fmt.Sprintf("locate('%c', user_name) > 0", delimiters[i]))
+		roleConds = append(roleConds, fmt.Sprintf("locate('%c', role_name) > 0", delimiters[i]))
+	}
+	return fmt.Sprintf("select user_name from mo_catalog.mo_user where %s union all select role_name from mo_catalog.mo_role where %s limit 1",
+		strings.Join(userConds, " or "), strings.Join(roleConds, " or "))
+}
+
+// CheckNamesWithUserInputDelimiters checks the names of the users and the roles
+// in all accounts do not contain the delimiters of the user input.
+// The delimiters can not be changed if the existing users or roles can not login with them.
+func CheckNamesWithUserInputDelimiters(ctx context.Context, exec executor.SQLExecutor, delimiters string) error {
+	if err := ValidateUserInputDelimiters(delimiters); err != nil {
+		return err
+	}
+	if len(delimiters) == 0 {
+		delimiters = defaultUserInputDelimiters
+	}
+
+	opts := executor.Options{}.
+		WithDatabase(catalog.MO_CATALOG).
+		WithWaitCommittedLogApplied()
+	res, err := exec.Exec(ctx, "select account_id, account_name from mo_catalog.mo_account", opts.WithAccountID(sysAccountID))
+	if err != nil {
+		return err
+	}
+	var accountIds []int32
+	var accountNames []string
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		accountIds = append(accountIds, vector.MustFixedCol[int32](cols[0])...)
+		accountNames = append(accountNames, executor.GetStringRows(cols[1])...)
+		return true
+	})
+	res.Close()
+
+	sql := genSQLForNamesWithDelimiters(delimiters)
+	for i, accountId := range accountIds {
+		res, err = exec.Exec(ctx, sql, opts.WithAccountID(uint32(accountId)))
+		if err != nil {
+			return err
+		}
+		var names []string
+		res.ReadRows(func(_ int, cols []*vector.Vector) bool {
+			names = append(names, executor.GetStringRows(cols[0])...)
+			return true
+		})
+		res.Close()
+		if len(names) != 0 {
+			return moerr.NewInternalErrorNoCtx("the name %s in the account %s contains the delimiters '%s' of the user input",
+				names[0], accountNames[i], delimiters)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// udfLanguage describes how the function in the language is kept in the mo_user_defined_function.
+type udfLanguage struct {
+	// external is true if the function is run by the udf service of the language.
+	// The body of the external function is the json of the function.NonSqlUdfBody.
+	external bool
+	// checkBody checks the body and the handler of the function before it is kept.
+	checkBody func(ctx context.Context, cf *tree.CreateFunction) error
+}
+
+// udfLanguages is the registry of the function languages.
+var udfLanguages = map[string]*udfLanguage{}
+
+func registerUdfLanguage(name string, lang *udfLanguage) {
+	udfLanguages[name] = lang
+}
+
+// getUdfLanguage returns the registered language of the function.
+func getUdfLanguage(ctx context.Context, name string) (*udfLanguage, error) {
+	lang, ok := udfLanguages[strings.ToLower(name)]
+	if !ok {
+		return nil, moerr.NewInvalidArg(ctx, "function language", name)
+	}
+	return lang, nil
+}
+
+func init() {
+	registerUdfLanguage(string(tree.SQL), &udfLanguage{
+		checkBody: func(ctx context.Context, cf *tree.CreateFunction) error {
+			if cf.Import {
+				return moerr.NewInvalidInput(ctx, "import")
+			}
+			return nil
+		},
+	})
+
+	registerUdfLanguage(string(tree.PYTHON), &udfLanguage{
+		external: true,
+		checkBody: func(ctx context.Context, cf *tree.CreateFunction) error {
+			if !cf.Import {
+				return nil
+			}
+			if !strings.HasSuffix(cf.Body, ".py") &&
+				!strings.HasSuffix(cf.Body, ".whl") {
+				return moerr.NewInvalidInput(ctx, "file '"+cf.Body+"', only support '*.py', '*.whl'")
+			}
+			if strings.HasSuffix(cf.Body, ".whl") {
+				dotIdx := strings.LastIndex(cf.Handler, ".")
+				if dotIdx < 1 {
+					return moerr.NewInvalidInput(ctx, "handler '"+cf.Handler+"', when you import a *.whl, the handler should be in the format of '<file or module name>.<function name>'")
+				}
+			}
+			return nil
+		},
+	})
+
+	// the wasm module is imported from the file, or is located by the url in the body.
+	// the handler is the name of the function exported by the module.
+	registerUdfLanguage(string(tree.WASM), &udfLanguage{
+		external: true,
+		checkBody: func(ctx context.Context, cf *tree.CreateFunction) error {
+			if len(cf.Handler) == 0 {
+				return moerr.NewInvalidInput(ctx, "wasm function needs the handler")
+			}
+			if cf.Import {
+				if !strings.HasSuffix(cf.Body, ".wasm") {
+					return moerr.NewInvalidInput(ctx, "file '"+cf.Body+"', only support '*.wasm'")
+				}
+				return nil
+			}
+			if !strings.HasPrefix(cf.Body, "http://") &&
+				!strings.HasPrefix(cf.Body, "https://") {
+				return moerr.NewInvalidInput(ctx, "wasm module '"+cf.Body+"', it should be imported or be the url of the module")
+			}
+			return nil
+		},
+	})
+}
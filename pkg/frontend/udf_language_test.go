@@ -0,0 +1,76 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func TestGetUdfLanguage(t *testing.T) {
+	ctx := context.Background()
+
+	lang, err := getUdfLanguage(ctx, "sql")
+	require.NoError(t, err)
+	require.False(t, lang.external)
+
+	lang, err = getUdfLanguage(ctx, "Python")
+	require.NoError(t, err)
+	require.True(t, lang.external)
+
+	lang, err = getUdfLanguage(ctx, "wasm")
+	require.NoError(t, err)
+	require.True(t, lang.external)
+
+	_, err = getUdfLanguage(ctx, "lua")
+	require.Error(t, err)
+}
+
+func TestUdfLanguageCheckBody(t *testing.T) {
+	ctx := context.Background()
+
+	kases := []struct {
+		cf      *tree.CreateFunction
+		wantErr bool
+	}{
+		{&tree.CreateFunction{Language: "sql", Body: "$1 + 1"}, false},
+		{&tree.CreateFunction{Language: "sql", Import: true, Body: "a.sql"}, true},
+		{&tree.CreateFunction{Language: "python", Body: "def add(a):\n  return a", Handler: "add"}, false},
+		{&tree.CreateFunction{Language: "python", Import: true, Body: "add.py", Handler: "add"}, false},
+		{&tree.CreateFunction{Language: "python", Import: true, Body: "add.txt", Handler: "add"}, true},
+		{&tree.CreateFunction{Language: "python", Import: true, Body: "add.whl", Handler: "add"}, true},
+		{&tree.CreateFunction{Language: "python", Import: true, Body: "add.whl", Handler: "mod.add"}, false},
+		{&tree.CreateFunction{Language: "wasm", Import: true, Body: "add.wasm", Handler: "add"}, false},
+		{&tree.CreateFunction{Language: "wasm", Import: true, Body: "add.py", Handler: "add"}, true},
+		{&tree.CreateFunction{Language: "wasm", Body: "https://example.com/add.wasm", Handler: "add"}, false},
+		{&tree.CreateFunction{Language: "wasm", Body: "(module)", Handler: "add"}, true},
+		{&tree.CreateFunction{Language: "wasm", Import: true, Body: "add.wasm"}, true},
+	}
+
+	for _, kase := range kases {
+		lang, err := getUdfLanguage(ctx, kase.cf.Language)
+		require.NoError(t, err)
+		err = lang.checkBody(ctx, kase.cf)
+		if kase.wantErr {
+			require.Error(t, err, kase.cf.Body)
+		} else {
+			require.NoError(t, err, kase.cf.Body)
+		}
+	}
+}
@@ -1203,6 +1203,10 @@ func (b *strParamBinder) bind(e tree.Expr) string {
 	case *tree.NumVal:
 		return val.OrigString()
 	case *tree.ParamExpr:
+		if b.params == nil {
+			b.err = moerr.NewInternalError(b.ctx, "the params of the statement are not bound")
+			return ""
+		}
 		return b.params.GetStringAt(val.Offset - 1)
 	default:
 		b.err = moerr.NewInternalError(b.ctx, "invalid params type %T", e)
@@ -1369,6 +1369,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("enable_privilege_cache"),
 		Default:           int64(1),
 	},
+	"privilege_cache_ttl": {
+		Name:              "privilege_cache_ttl",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableUintType("privilege_cache_ttl", 0, 18446744073709551615),
+		Default:           uint64(0),
+	},
 	"clear_privilege_cache": {
 		Name:              "clear_privilege_cache",
 		Scope:             ScopeSession,
@@ -1377,6 +1385,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("clear_privilege_cache"),
 		Default:           int64(0),
 	},
+	"warm_up_privilege_cache": {
+		Name:              "warm_up_privilege_cache",
+		Scope:             ScopeSession,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("warm_up_privilege_cache"),
+		Default:           int64(0),
+	},
 	"foreign_key_checks": {
 		Name:              "foreign_key_checks",
 		Scope:             ScopeBoth,
@@ -1385,6 +1401,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("foreign_key_checks"),
 		Default:           int64(1),
 	},
+	"account_suspend_kill_timeout": {
+		Name:              "account_suspend_kill_timeout",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("account_suspend_kill_timeout", 0, 3600, false),
+		Default:           int64(0),
+	},
+	"allowed_auth_types": {
+		Name:              "allowed_auth_types",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("allowed_auth_types"),
+		Default:           "password",
+	},
 	"authentication_policy": {
 		Name:              "authentication_policy",
 		Scope:             ScopeGlobal,
@@ -1553,6 +1585,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableStringType("collation_database"),
 		Default:           "utf8mb4_0900_ai_ci",
 	},
+	"comment_max_length": {
+		Name:              "comment_max_length",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("comment_max_length", 0, 65535, false),
+		Default:           int64(2048),
+	},
+	"comment_require_utf8": {
+		Name:              "comment_require_utf8",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("comment_require_utf8"),
+		Default:           int64(1),
+	},
 	"concurrent_insert": {
 		Name:              "concurrent_insert",
 		Scope:             ScopeGlobal,
@@ -1777,6 +1825,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemSystemEnumType("explain_format", "DEFAULT", "TRADITIONAL", "JSON", "TREE"),
 		Default:           "TRADITIONAL",
 	},
+	"explain_privilege_denial": {
+		Name:              "explain_privilege_denial",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("explain_privilege_denial"),
+		Default:           int64(0),
+	},
+	"check_privileges_at_begin": {
+		Name:              "check_privileges_at_begin",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("check_privileges_at_begin"),
+		Default:           int64(0),
+	},
 	"explicit_defaults_for_timestamp": {
 		Name:              "explicit_defaults_for_timestamp",
 		Scope:             ScopeBoth,
@@ -1793,6 +1857,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableStringType("external_user"),
 		Default:           "",
 	},
+	"failed_login_attempts": {
+		Name:              "failed_login_attempts",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("failed_login_attempts", 0, 4294967295, false),
+		Default:           int64(0),
+	},
 	"flush": {
 		Name:              "flush",
 		Scope:             ScopeGlobal,
@@ -1889,6 +1961,22 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("global_connection_memory_tracking"),
 		Default:           int64(0),
 	},
+	"grant_option_default": {
+		Name:              "grant_option_default",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("grant_option_default"),
+		Default:           int64(0),
+	},
+	"grant_option_for_non_admin": {
+		Name:              "grant_option_for_non_admin",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("grant_option_for_non_admin"),
+		Default:           int64(1),
+	},
 	"group_concat_max_len": {
 		Name:              "group_concat_max_len",
 		Scope:             ScopeBoth,
@@ -2625,6 +2713,30 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("optimizer_trace_offset", -2147483647, 2147483647, false),
 		Default:           int64(-1),
 	},
+	"strict_privilege_level": {
+		Name:              "strict_privilege_level",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("strict_privilege_level"),
+		Default:           int64(0),
+	},
+	"strict_publication_accounts": {
+		Name:              "strict_publication_accounts",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("strict_publication_accounts"),
+		Default:           int64(0),
+	},
+	"ownership_implies_dml": {
+		Name:              "ownership_implies_dml",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("ownership_implies_dml"),
+		Default:           int64(1),
+	},
 	"parser_max_mem_size": {
 		Name:              "parser_max_mem_size",
 		Scope:             ScopeBoth,
@@ -2649,6 +2761,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("password_history", 0, 4294967295, false),
 		Default:           int64(0),
 	},
+	"password_lock_time": {
+		Name:              "password_lock_time",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("password_lock_time", 0, 4294967295, false),
+		Default:           int64(300),
+	},
 	"password_require_current": {
 		Name:              "password_require_current",
 		Scope:             ScopeGlobal,
@@ -2705,6 +2825,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("print_identified_with_as_hex"),
 		Default:           int64(0),
 	},
+	"privilege_audit_sample_rate": {
+		Name:              "privilege_audit_sample_rate",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableDoubleType("privilege_audit_sample_rate", 0, 1),
+		Default:           float64(0),
+	},
 	"protocol_version": {
 		Name:              "protocol_version",
 		Scope:             ScopeGlobal,
@@ -3425,6 +3553,54 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemSystemEnumType("use_secondary_engine", "OFF", "ON", "FORCED"),
 		Default:           "ON",
 	},
+	"validate_password": {
+		Name:              "validate_password",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("validate_password"),
+		Default:           int64(0),
+	},
+	"validate_password_length": {
+		Name:              "validate_password_length",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_length", 0, 4294967295, false),
+		Default:           int64(8),
+	},
+	"validate_password_mixed_case_count": {
+		Name:              "validate_password_mixed_case_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_mixed_case_count", 0, 4294967295, false),
+		Default:           int64(1),
+	},
+	"validate_password_number_count": {
+		Name:              "validate_password_number_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_number_count", 0, 4294967295, false),
+		Default:           int64(1),
+	},
+	"validate_password_special_char_count": {
+		Name:              "validate_password_special_char_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_special_char_count", 0, 4294967295, false),
+		Default:           int64(1),
+	},
+	"validate_password_blocklist": {
+		Name:              "validate_password_blocklist",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("validate_password_blocklist"),
+		Default:           "",
+	},
 	"version_compile_machine": {
 		Name:              "version_compile_machine",
 		Scope:             ScopeGlobal,
@@ -3516,73 +3692,76 @@ var gSysVarsDefs = map[string]SystemVariable{
 }
 
 func updateTimeZone(ctx context.Context, sess *Session, sv *SystemVariables, name string, val interface{}) error {
-	tzStr := val.(string)
+	tzStr, loc, err := parseTimeZone(ctx, val.(string))
+	if err != nil {
+		return err
+	}
+	sv.Set(name, tzStr)
+	sess.SetTimeZone(loc)
+	return nil
+}
+
+// parseTimeZone parses the value of the time_zone.
+// It returns the normalized value and the location of the time zone.
+func parseTimeZone(ctx context.Context, tzStr string) (string, *time.Location, error) {
 	tzStr = strings.TrimSpace(strings.ToLower(tzStr))
 	if tzStr == "system" {
-		sv.Set(name, "SYSTEM")
-		sess.SetTimeZone(time.Local)
+		return "SYSTEM", time.Local, nil
 	} else if len(tzStr) > 0 && (tzStr[0] == '-' || tzStr[0] == '+') {
 		if len(tzStr) != 5 && len(tzStr) != 6 {
-			return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+			return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 		}
 
 		minIdx := 3
 		if tzStr[1] < '0' || tzStr[1] > '9' {
-			return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+			return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 		}
 		hour := int(tzStr[1] - '0')
 		if tzStr[2] != ':' {
 			if tzStr[2] < '0' || tzStr[2] > '9' {
-				return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+				return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 			}
 			hour = hour*10 + int(tzStr[2]-'0')
 			minIdx = 4
 			if tzStr[3] != ':' {
-				return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+				return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 			}
 		}
 
 		if minIdx != len(tzStr)-2 {
-			return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+			return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 		}
 		if tzStr[minIdx] < '0' || tzStr[minIdx] > '9' {
-			return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+			return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 		}
 		minute := int(tzStr[minIdx]-'0') * 10
 		if tzStr[minIdx+1] < '0' || tzStr[minIdx+1] > '9' {
-			return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+			return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 		}
 		minute += int(tzStr[minIdx+1] - '0')
 		if minute >= 60 {
-			return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+			return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 		}
 
 		minute += hour * 60
 
 		if tzStr[0] == '-' {
 			if minute >= 14*60 {
-				return moerr.NewWrongDatetimeSpec(ctx, tzStr)
-			}
-			sess.SetTimeZone(time.FixedZone("FixedZone", -minute*60))
-		} else {
-			if minute > 14*60 {
-				return moerr.NewWrongDatetimeSpec(ctx, tzStr)
+				return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 			}
-			sess.SetTimeZone(time.FixedZone("FixedZone", minute*60))
+			return tzStr, time.FixedZone("FixedZone", -minute*60), nil
 		}
-
-		sv.Set(name, tzStr)
-	} else {
-		loc, err := time.LoadLocation(tzStr)
-		if err != nil {
-			return err
+		if minute > 14*60 {
+			return "", nil, moerr.NewWrongDatetimeSpec(ctx, tzStr)
 		}
-
-		sv.Set(name, tzStr)
-		sess.SetTimeZone(loc)
+		return tzStr, time.FixedZone("FixedZone", minute*60), nil
 	}
 
-	return nil
+	loc, err := time.LoadLocation(tzStr)
+	if err != nil {
+		return "", nil, err
+	}
+	return tzStr, loc, nil
 }
 
 func getSystemTimeZone() string {
@@ -2362,6 +2362,7 @@ type SubscriptionMeta struct {
 	DbName               string   `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	AccountName          string   `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
 	SubName              string   `protobuf:"bytes,5,opt,name=sub_name,json=subName,proto3" json:"sub_name,omitempty"`
+	Tables               []string `protobuf:"bytes,6,rep,name=tables,proto3" json:"tables,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2435,6 +2436,13 @@ func (m *SubscriptionMeta) GetSubName() string {
 	return ""
 }
 
+func (m *SubscriptionMeta) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
 type Function struct {
 	Func                 *ObjectRef `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
 	Args                 []*Expr    `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
@@ -8494,7 +8502,10 @@ type DropDatabase struct {
 	UpdateFkSql string `protobuf:"bytes,4,opt,name=updateFkSql,proto3" json:"updateFkSql,omitempty"`
 	// drop database should check mo_foreign_keys to find fk records
 	// that refer to this database before dropping the database
-	CheckFKSql           string   `protobuf:"bytes,5,opt,name=checkFKSql,proto3" json:"checkFKSql,omitempty"`
+	CheckFKSql string `protobuf:"bytes,5,opt,name=checkFKSql,proto3" json:"checkFKSql,omitempty"`
+	// drop database with the force option should delete the publications
+	// of this database from mo_pubs
+	DeletePubSql         string   `protobuf:"bytes,6,opt,name=deletePubSql,proto3" json:"deletePubSql,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -8568,6 +8579,13 @@ func (m *DropDatabase) GetCheckFKSql() string {
 	return ""
 }
 
+func (m *DropDatabase) GetDeletePubSql() string {
+	if m != nil {
+		return m.DeletePubSql
+	}
+	return ""
+}
+
 type FkColName struct {
 	Cols                 []string `protobuf:"bytes,1,rep,name=cols,proto3" json:"cols,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -11561,677 +11579,671 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 10716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5b, 0x8c, 0x1b, 0x57,
-	0x96, 0x58, 0xf3, 0x4d, 0x1e, 0xb2, 0xd9, 0xd5, 0x57, 0x2d, 0x89, 0x92, 0x65, 0xa9, 0x5d, 0x96,
-	0x6d, 0x59, 0xe3, 0x91, 0x6d, 0xc9, 0x0f, 0xd9, 0x3b, 0xb3, 0x63, 0x36, 0x9b, 0xad, 0xa6, 0xc5,
-	0x26, 0x7b, 0x8a, 0x6c, 0xc9, 0x9e, 0x45, 0x52, 0x28, 0xb2, 0x8a, 0xdd, 0xe5, 0x2e, 0x56, 0xd1,
-	0x55, 0x45, 0x75, 0xb7, 0x81, 0x05, 0x26, 0x09, 0x90, 0x45, 0x02, 0xe4, 0x2b, 0xc0, 0xfe, 0x24,
-	0x1b, 0x4c, 0x36, 0x7f, 0x8b, 0x04, 0x08, 0x90, 0x00, 0x1b, 0xe4, 0x37, 0xf9, 0xd8, 0x04, 0x41,
-	0x10, 0x20, 0x1f, 0x8b, 0x24, 0xc0, 0x26, 0x98, 0xfc, 0x67, 0x3f, 0x36, 0xdf, 0x49, 0x70, 0xce,
-	0xbd, 0x55, 0x75, 0x8b, 0x64, 0x8f, 0x6c, 0xcf, 0x2c, 0x92, 0xfc, 0x74, 0xd7, 0x3d, 0xe7, 0xdc,
-	0xf7, 0xbd, 0xe7, 0x75, 0xcf, 0xbd, 0x04, 0x98, 0x39, 0x86, 0xfb, 0x60, 0xe6, 0x7b, 0xa1, 0xc7,
-	0xf2, 0xf8, 0x7d, 0xf3, 0x87, 0xc7, 0x76, 0x78, 0x32, 0x1f, 0x3d, 0x18, 0x7b, 0xd3, 0x77, 0x8f,
-	0xbd, 0x63, 0xef, 0x5d, 0x42, 0x8e, 0xe6, 0x13, 0x4a, 0x51, 0x82, 0xbe, 0x78, 0xa6, 0x9b, 0xe0,
-	0x78, 0xe3, 0x53, 0xf1, 0xbd, 0x11, 0xda, 0x53, 0x2b, 0x08, 0x8d, 0xe9, 0x8c, 0x03, 0xd4, 0x3f,
-	0xce, 0x40, 0x7e, 0x78, 0x31, 0xb3, 0x58, 0x1d, 0xb2, 0xb6, 0xd9, 0xc8, 0x6c, 0x67, 0xee, 0x15,
-	0xb4, 0xac, 0x6d, 0xb2, 0x6d, 0xa8, 0xba, 0x5e, 0xd8, 0x9b, 0x3b, 0x8e, 0x31, 0x72, 0xac, 0x46,
-	0x76, 0x3b, 0x73, 0xaf, 0xac, 0xc9, 0x20, 0xf6, 0x0a, 0x54, 0x8c, 0x79, 0xe8, 0xe9, 0xb6, 0x3b,
-	0xf6, 0x1b, 0x39, 0xc2, 0x97, 0x11, 0xd0, 0x71, 0xc7, 0x3e, 0xdb, 0x82, 0xc2, 0x99, 0x6d, 0x86,
-	0x27, 0x8d, 0x3c, 0x95, 0xc8, 0x13, 0x08, 0x0d, 0xc6, 0x86, 0x63, 0x35, 0x0a, 0x1c, 0x4a, 0x09,
-	0x84, 0x86, 0x54, 0x49, 0x71, 0x3b, 0x73, 0xaf, 0xa2, 0xf1, 0x04, 0xbb, 0x0d, 0x60, 0xb9, 0xf3,
-	0xe9, 0x0b, 0xc3, 0x99, 0x5b, 0x41, 0xa3, 0x44, 0x28, 0x09, 0xa2, 0xfe, 0x04, 0x2a, 0xd3, 0xe0,
-	0x78, 0xdf, 0x32, 0x4c, 0xcb, 0x67, 0xd7, 0xa1, 0x34, 0x0d, 0x8e, 0xf5, 0xd0, 0x38, 0x16, 0x5d,
-	0x28, 0x4e, 0x83, 0xe3, 0xa1, 0x71, 0xcc, 0x6e, 0x40, 0x99, 0x10, 0x17, 0x33, 0xde, 0x87, 0x82,
-	0x86, 0x84, 0xd8, 0x63, 0xf5, 0xcf, 0x0b, 0x50, 0xea, 0xda, 0xa1, 0xe5, 0x1b, 0x0e, 0xbb, 0x06,
-	0x45, 0x3b, 0x70, 0xe7, 0x8e, 0x43, 0xd9, 0xcb, 0x9a, 0x48, 0xb1, 0x6b, 0x50, 0xb0, 0x1f, 0xbf,
-	0x30, 0x1c, 0x9e, 0x77, 0x7f, 0x4d, 0xe3, 0x49, 0xd6, 0x80, 0xa2, 0xfd, 0xfe, 0x47, 0x88, 0xc8,
-	0x09, 0x84, 0x48, 0x13, 0xe6, 0xd1, 0x43, 0xc4, 0xe4, 0x63, 0x0c, 0xa5, 0x09, 0xf3, 0xd1, 0x07,
-	0x88, 0xc1, 0xde, 0xe7, 0x08, 0x43, 0x69, 0xac, 0x65, 0x4e, 0xb5, 0xe0, 0x00, 0xac, 0x63, 0x2d,
-	0xf3, 0xa8, 0x96, 0x39, 0xaf, 0xa5, 0x24, 0x10, 0x22, 0x4d, 0x18, 0x5e, 0x4b, 0x39, 0xc6, 0xc4,
-	0xb5, 0xcc, 0x79, 0x2d, 0x95, 0xed, 0xcc, 0xbd, 0x3c, 0x61, 0x78, 0x2d, 0x5b, 0x90, 0x37, 0x11,
-	0x0e, 0xdb, 0x99, 0x7b, 0x99, 0xfd, 0x35, 0x8d, 0x52, 0x08, 0x0d, 0x10, 0x5a, 0xc5, 0x01, 0x46,
-	0x68, 0x20, 0xa0, 0x23, 0x84, 0xd6, 0x70, 0x34, 0x10, 0x3a, 0x12, 0xd0, 0x09, 0x42, 0xd7, 0xb7,
-	0x33, 0xf7, 0xb2, 0x08, 0xc5, 0x14, 0xbb, 0x09, 0x25, 0xd3, 0x08, 0x2d, 0x44, 0xd4, 0x45, 0x97,
-	0x23, 0x00, 0xe2, 0x70, 0xc5, 0x21, 0x6e, 0x43, 0x74, 0x3a, 0x02, 0x30, 0x15, 0xaa, 0x48, 0x16,
-	0xe1, 0x15, 0x81, 0x97, 0x81, 0xec, 0x43, 0xa8, 0x99, 0xd6, 0xd8, 0x9e, 0x1a, 0x0e, 0xef, 0xd3,
-	0xe6, 0x76, 0xe6, 0x5e, 0xf5, 0xe1, 0xc6, 0x03, 0xda, 0x13, 0x31, 0x66, 0x7f, 0x4d, 0x4b, 0x91,
-	0xb1, 0xc7, 0xb0, 0x2e, 0xd2, 0xef, 0x3f, 0xa4, 0x81, 0x65, 0x94, 0x4f, 0x49, 0xe5, 0x7b, 0xff,
-	0xe1, 0xe3, 0xfd, 0x35, 0x2d, 0x4d, 0xc8, 0xee, 0x42, 0x2d, 0xde, 0x22, 0x98, 0xf1, 0x8a, 0x68,
-	0x55, 0x0a, 0x8a, 0xdd, 0xfa, 0x2a, 0xf0, 0x5c, 0x24, 0xd8, 0x12, 0xe3, 0x16, 0x01, 0xd8, 0x36,
-	0x80, 0x69, 0x4d, 0x8c, 0xb9, 0x13, 0x22, 0xfa, 0xaa, 0x18, 0x40, 0x09, 0xc6, 0x6e, 0x43, 0x65,
-	0x3e, 0xc3, 0x5e, 0x3e, 0x33, 0x9c, 0xc6, 0x35, 0x41, 0x90, 0x80, 0xb0, 0x74, 0x5c, 0xe7, 0x88,
-	0xbd, 0x2e, 0x66, 0x37, 0x02, 0xe0, 0x5e, 0xb1, 0x83, 0x1d, 0xdb, 0x6d, 0x34, 0x68, 0x9d, 0xf2,
-	0x04, 0xbb, 0x05, 0xb9, 0xc0, 0x1f, 0x37, 0x6e, 0x50, 0x2f, 0x81, 0xf7, 0xb2, 0x7d, 0x3e, 0xf3,
-	0x35, 0x04, 0xef, 0x94, 0xa0, 0x40, 0x7b, 0x46, 0xbd, 0x05, 0xe5, 0x43, 0xc3, 0x37, 0xa6, 0x9a,
-	0x35, 0x61, 0x0a, 0xe4, 0x66, 0x5e, 0x20, 0x76, 0x0b, 0x7e, 0xaa, 0x5d, 0x28, 0x3e, 0x33, 0x7c,
-	0xc4, 0x31, 0xc8, 0xbb, 0xc6, 0xd4, 0x22, 0x64, 0x45, 0xa3, 0x6f, 0xdc, 0x21, 0xc1, 0x45, 0x10,
-	0x5a, 0x53, 0xc1, 0x0a, 0x44, 0x0a, 0xe1, 0xc7, 0x8e, 0x37, 0x12, 0x3b, 0xa1, 0xac, 0x89, 0x94,
-	0xfa, 0xd7, 0x33, 0x50, 0x6c, 0x79, 0x0e, 0x16, 0x77, 0x1d, 0x4a, 0xbe, 0xe5, 0xe8, 0x49, 0x75,
-	0x45, 0xdf, 0x72, 0x0e, 0xbd, 0x00, 0x11, 0x63, 0x8f, 0x23, 0xf8, 0xde, 0x2c, 0x8e, 0x3d, 0x42,
-	0x44, 0x0d, 0xc8, 0x49, 0x0d, 0xb8, 0x01, 0xe5, 0x70, 0xe4, 0xe8, 0x04, 0xcf, 0x13, 0xbc, 0x14,
-	0x8e, 0x9c, 0x1e, 0xa2, 0xae, 0x43, 0xc9, 0x1c, 0x71, 0x4c, 0x81, 0x30, 0x45, 0x73, 0x84, 0x08,
-	0xf5, 0x13, 0xa8, 0x68, 0xc6, 0x99, 0x68, 0xc6, 0x55, 0x28, 0x62, 0x01, 0x82, 0xcb, 0xe5, 0xb5,
-	0x42, 0x38, 0x72, 0x3a, 0x26, 0x82, 0xb1, 0x11, 0xb6, 0x49, 0x6d, 0xc8, 0x6b, 0x85, 0xb1, 0xe7,
-	0x74, 0x4c, 0x75, 0x08, 0xd0, 0xf2, 0x7c, 0xff, 0x7b, 0x77, 0x61, 0x0b, 0x0a, 0xa6, 0x35, 0x0b,
-	0x4f, 0x38, 0x83, 0xd0, 0x78, 0x42, 0xbd, 0x0f, 0x65, 0x9c, 0x97, 0xae, 0x1d, 0x84, 0xec, 0x36,
-	0xe4, 0x1d, 0x3b, 0x08, 0x1b, 0x99, 0xed, 0xdc, 0xc2, 0xac, 0x11, 0x5c, 0xdd, 0x86, 0xf2, 0x81,
-	0x71, 0xfe, 0x0c, 0x67, 0x0e, 0x4b, 0xa3, 0x29, 0x14, 0x53, 0x22, 0xe6, 0xb3, 0x06, 0x30, 0x34,
-	0xfc, 0x63, 0x2b, 0x24, 0x7e, 0xf6, 0x17, 0x19, 0xa8, 0x0e, 0xe6, 0xa3, 0xaf, 0xe7, 0x96, 0x7f,
-	0x81, 0x6d, 0xbe, 0x07, 0xb9, 0xf0, 0x62, 0x46, 0x39, 0xea, 0x0f, 0xaf, 0xf1, 0xe2, 0x25, 0xfc,
-	0x03, 0xcc, 0xa4, 0x21, 0x09, 0x76, 0xc2, 0xf5, 0x4c, 0x2b, 0x1a, 0x83, 0x82, 0x56, 0xc4, 0x64,
-	0xc7, 0x44, 0xa1, 0xe0, 0xcd, 0xc4, 0x2c, 0x64, 0xbd, 0x19, 0xdb, 0x86, 0xc2, 0xf8, 0xc4, 0x76,
-	0x4c, 0x9a, 0x80, 0x74, 0x9b, 0x39, 0x02, 0x67, 0xc9, 0xf7, 0xce, 0xf4, 0xc0, 0xfe, 0x26, 0x62,
-	0xf2, 0x25, 0xdf, 0x3b, 0x1b, 0xd8, 0xdf, 0x58, 0xea, 0x50, 0x48, 0x1a, 0x80, 0xe2, 0xa0, 0xd5,
-	0xec, 0x36, 0x35, 0x65, 0x0d, 0xbf, 0xdb, 0x5f, 0x74, 0x06, 0xc3, 0x81, 0x92, 0x61, 0x75, 0x80,
-	0x5e, 0x7f, 0xa8, 0x8b, 0x74, 0x96, 0x15, 0x21, 0xdb, 0xe9, 0x29, 0x39, 0xa4, 0x41, 0x78, 0xa7,
-	0xa7, 0xe4, 0x59, 0x09, 0x72, 0xcd, 0xde, 0x97, 0x4a, 0x81, 0x3e, 0xba, 0x5d, 0xa5, 0xa8, 0xfe,
-	0x51, 0x16, 0x2a, 0xfd, 0xd1, 0x57, 0xd6, 0x38, 0xc4, 0x3e, 0xe3, 0x2a, 0xb5, 0xfc, 0x17, 0x96,
-	0x4f, 0xdd, 0xce, 0x69, 0x22, 0x85, 0x1d, 0x31, 0x47, 0xd4, 0xb9, 0x9c, 0x96, 0x35, 0x47, 0x44,
-	0x37, 0x3e, 0xb1, 0xa6, 0x06, 0x75, 0x0e, 0xe9, 0x28, 0x85, 0xbb, 0xc2, 0x1b, 0x7d, 0x45, 0xdd,
-	0xcb, 0x69, 0xf8, 0xc9, 0xee, 0x40, 0x95, 0x97, 0x21, 0xaf, 0x2f, 0xe0, 0xa0, 0xc5, 0xc5, 0x57,
-	0x94, 0x17, 0x1f, 0xe5, 0xa4, 0x52, 0x39, 0x52, 0x48, 0x30, 0x0e, 0xea, 0x89, 0x15, 0xed, 0x8d,
-	0xbe, 0xe2, 0xd8, 0x32, 0x5f, 0xd1, 0xde, 0xe8, 0x2b, 0x42, 0xfd, 0x00, 0x36, 0x83, 0xf9, 0x28,
-	0x18, 0xfb, 0xf6, 0x2c, 0xb4, 0x3d, 0x97, 0xd3, 0x54, 0x88, 0x46, 0x91, 0x11, 0x44, 0x7c, 0x0f,
-	0xca, 0xb3, 0xf9, 0x48, 0xb7, 0xdd, 0x89, 0x47, 0xcc, 0xbd, 0xfa, 0x70, 0x9d, 0x4f, 0xcc, 0xe1,
-	0x7c, 0xd4, 0x71, 0x27, 0x9e, 0x56, 0x9a, 0xf1, 0x0f, 0xf5, 0x4d, 0x28, 0x09, 0x18, 0x4a, 0xef,
-	0xd0, 0x72, 0x0d, 0x37, 0xd4, 0x63, 0xb1, 0x5f, 0xe6, 0x80, 0x8e, 0xa9, 0xfe, 0xfd, 0x0c, 0x28,
-	0x03, 0xa9, 0x9a, 0x03, 0x2b, 0x34, 0x56, 0x72, 0x85, 0x57, 0x01, 0x8c, 0xf1, 0xd8, 0x9b, 0xf3,
-	0x62, 0xf8, 0xe2, 0xa9, 0x08, 0x48, 0xc7, 0x94, 0xc7, 0x26, 0x97, 0x1a, 0x9b, 0xd7, 0xa0, 0x16,
-	0xe5, 0x93, 0x36, 0x74, 0x55, 0xc0, 0xa2, 0xd1, 0x09, 0xe6, 0xa9, 0x5d, 0x5d, 0x0a, 0xe6, 0x7c,
-	0x5b, 0xff, 0xed, 0x2c, 0x94, 0xf7, 0xe6, 0xee, 0x18, 0x9b, 0xc6, 0x5e, 0x87, 0xfc, 0x64, 0xee,
-	0x8e, 0xa9, 0x59, 0xb1, 0x68, 0x88, 0x57, 0x84, 0x46, 0x48, 0xdc, 0x6b, 0x86, 0x7f, 0x8c, 0x7b,
-	0x74, 0x69, 0xaf, 0x21, 0x5c, 0xfd, 0x17, 0x19, 0x5e, 0xe2, 0x9e, 0x63, 0x1c, 0xb3, 0x32, 0xe4,
-	0x7b, 0xfd, 0x5e, 0x5b, 0x59, 0x63, 0x35, 0x28, 0x77, 0x7a, 0xc3, 0xb6, 0xd6, 0x6b, 0x76, 0x95,
-	0x0c, 0x2d, 0xdc, 0x61, 0x73, 0xa7, 0xdb, 0x56, 0xb2, 0x88, 0x79, 0xd6, 0xef, 0x36, 0x87, 0x9d,
-	0x6e, 0x5b, 0xc9, 0x73, 0x8c, 0xd6, 0x69, 0x0d, 0x95, 0x32, 0x53, 0xa0, 0x76, 0xa8, 0xf5, 0x77,
-	0x8f, 0x5a, 0x6d, 0xbd, 0x77, 0xd4, 0xed, 0x2a, 0x0a, 0xbb, 0x02, 0x1b, 0x31, 0xa4, 0xcf, 0x81,
-	0xdb, 0x98, 0xe5, 0x59, 0x53, 0x6b, 0x6a, 0x4f, 0x94, 0xcf, 0x58, 0x19, 0x72, 0xcd, 0x27, 0x4f,
-	0x94, 0x9f, 0xe3, 0x1e, 0xa8, 0x3c, 0xef, 0xf4, 0xf4, 0x67, 0xcd, 0xee, 0x51, 0x5b, 0xf9, 0x79,
-	0x36, 0x4a, 0xf7, 0xb5, 0xdd, 0xb6, 0xa6, 0xfc, 0x3c, 0xcf, 0x36, 0xa1, 0xf6, 0xb3, 0x7e, 0xaf,
-	0x7d, 0xd0, 0x3c, 0x3c, 0xa4, 0x86, 0xfc, 0xbc, 0xac, 0xfe, 0x49, 0x1e, 0xf2, 0xd8, 0x13, 0xa6,
-	0x26, 0xfb, 0x3d, 0xee, 0x22, 0x6e, 0xb8, 0x9d, 0xfc, 0x9f, 0xfc, 0xd9, 0x9d, 0x35, 0xbe, 0xd3,
-	0x5f, 0x83, 0x9c, 0x63, 0x87, 0x34, 0x51, 0xf1, 0x2a, 0x11, 0x3a, 0xd0, 0xfe, 0x9a, 0x86, 0x38,
-	0x76, 0x1b, 0x32, 0x7c, 0xcb, 0x57, 0x1f, 0xd6, 0xc5, 0x32, 0x12, 0x32, 0x63, 0x7f, 0x4d, 0xcb,
-	0xcc, 0xd8, 0x2d, 0xc8, 0xbc, 0x10, 0xfb, 0xbf, 0xc6, 0xf1, 0x5c, 0x6a, 0x20, 0xf6, 0x05, 0xdb,
-	0x86, 0xdc, 0xd8, 0xe3, 0x1a, 0x4e, 0x8c, 0xe7, 0x3c, 0x14, 0xcb, 0x1f, 0x7b, 0x0e, 0x7b, 0x1d,
-	0x72, 0xbe, 0x71, 0x46, 0x7b, 0x25, 0x9e, 0xae, 0x98, 0x49, 0x23, 0x91, 0x6f, 0x9c, 0x61, 0x23,
-	0x26, 0xb4, 0x63, 0xe2, 0x46, 0x44, 0xf3, 0x8d, 0xd5, 0x4c, 0xd8, 0x36, 0x64, 0xce, 0x68, 0xcf,
-	0xc4, 0x42, 0xfd, 0xb9, 0xed, 0x9a, 0xde, 0xd9, 0x60, 0x66, 0x8d, 0x91, 0xe2, 0x8c, 0xbd, 0x01,
-	0xb9, 0x60, 0x3e, 0xa2, 0x3d, 0x53, 0x7d, 0xb8, 0xb9, 0xc4, 0xfd, 0xb0, 0xa2, 0x60, 0x3e, 0x62,
-	0x6f, 0x42, 0x7e, 0xec, 0xf9, 0xbe, 0xd8, 0x37, 0x4a, 0xd4, 0xe0, 0x88, 0xf1, 0xa3, 0x92, 0x83,
-	0x78, 0xac, 0x30, 0x24, 0x1d, 0x29, 0x26, 0x4a, 0x38, 0x2f, 0x56, 0x18, 0xb2, 0xbb, 0x82, 0x9d,
-	0xd7, 0xe4, 0x56, 0x47, 0xcc, 0x1e, 0xcb, 0x41, 0x2c, 0x4e, 0xd2, 0xd4, 0x38, 0x27, 0x0d, 0x2a,
-	0x26, 0x8a, 0xb8, 0x3c, 0xb6, 0x69, 0x6a, 0x9c, 0xb3, 0xbb, 0x90, 0x7b, 0x61, 0x8d, 0x49, 0x99,
-	0x8a, 0x6b, 0x13, 0x93, 0xf4, 0x8c, 0xba, 0x87, 0x68, 0x94, 0x5b, 0xc6, 0xfc, 0x1c, 0xb7, 0xdd,
-	0x06, 0x97, 0x30, 0xc6, 0xfc, 0xbc, 0x63, 0x22, 0x07, 0x73, 0xcd, 0x17, 0xa4, 0x4d, 0x65, 0x34,
-	0xfc, 0x44, 0x4d, 0x3e, 0xb0, 0x1c, 0x6b, 0x1c, 0xda, 0x2f, 0xec, 0xf0, 0x82, 0x54, 0xa8, 0x8c,
-	0x26, 0x83, 0x76, 0x8a, 0x90, 0xb7, 0xce, 0x67, 0xbe, 0xfa, 0x10, 0x20, 0xa9, 0x07, 0x4b, 0x72,
-	0x2c, 0x37, 0xd2, 0x10, 0x1c, 0xcb, 0x45, 0x0e, 0x60, 0x1a, 0xa1, 0x41, 0xcb, 0xa7, 0xa6, 0xd1,
-	0xb7, 0x7a, 0x03, 0x2a, 0xb1, 0xea, 0xc5, 0x6a, 0x90, 0x31, 0x04, 0xe7, 0xcd, 0x18, 0xea, 0x3d,
-	0xd4, 0x84, 0x22, 0xe5, 0x2a, 0x8d, 0xc3, 0x54, 0xc4, 0x8f, 0x33, 0x23, 0xf5, 0x47, 0x50, 0xd3,
-	0xac, 0x60, 0xee, 0x84, 0x2d, 0xcf, 0xd9, 0xb5, 0x26, 0xec, 0x1d, 0x80, 0x38, 0x1d, 0x08, 0x01,
-	0x99, 0x2c, 0xa6, 0x5d, 0x6b, 0xa2, 0x49, 0x78, 0xf5, 0xf7, 0xf2, 0xa4, 0x6a, 0xec, 0x72, 0x19,
-	0x2f, 0x84, 0x79, 0x46, 0x12, 0xe6, 0x31, 0xeb, 0xca, 0xa6, 0x15, 0x9a, 0x13, 0xdb, 0x34, 0x2d,
-	0x37, 0x52, 0x5c, 0x78, 0x0a, 0x47, 0xdf, 0x70, 0x8e, 0x69, 0x85, 0xd7, 0x1f, 0xb2, 0xa8, 0xd2,
-	0xe9, 0xcc, 0xb7, 0x82, 0x80, 0x8b, 0x4c, 0xc3, 0x39, 0x8e, 0x36, 0x5b, 0xe1, 0x57, 0x6d, 0xb6,
-	0x1b, 0x50, 0x76, 0xbd, 0x50, 0x27, 0xb3, 0xa2, 0x48, 0x75, 0x94, 0x84, 0xfd, 0xc4, 0xde, 0x82,
-	0x92, 0x50, 0x08, 0xc5, 0x2a, 0x17, 0x7b, 0x71, 0x97, 0x03, 0xb5, 0x08, 0xcb, 0x1a, 0xa8, 0x5f,
-	0x4c, 0xa7, 0x96, 0x1b, 0x46, 0x22, 0x42, 0x24, 0xd9, 0x0f, 0xa0, 0xe2, 0xb9, 0x3a, 0xd7, 0x1a,
-	0xc5, 0x32, 0x17, 0xeb, 0xa9, 0xef, 0x1e, 0x11, 0x54, 0x2b, 0x7b, 0xe2, 0x0b, 0x9b, 0xe2, 0x78,
-	0x67, 0xfa, 0xd8, 0xf0, 0x4d, 0x5a, 0xea, 0x65, 0xad, 0xe4, 0x78, 0x67, 0x2d, 0xc3, 0x37, 0xb9,
-	0xc8, 0xfc, 0xda, 0x9d, 0x4f, 0x69, 0x79, 0xaf, 0x6b, 0x22, 0xc5, 0x6e, 0x41, 0x65, 0xec, 0xcc,
-	0x83, 0xd0, 0xf2, 0x77, 0x2e, 0xb8, 0x1d, 0xa0, 0x25, 0x00, 0x6c, 0xd7, 0xcc, 0xb7, 0xa7, 0x86,
-	0x7f, 0x41, 0x6b, 0xb9, 0xac, 0x45, 0x49, 0x54, 0x55, 0x66, 0xa7, 0xb6, 0x79, 0xce, 0x8d, 0x01,
-	0x8d, 0x27, 0x90, 0xfe, 0x84, 0x4c, 0xb5, 0x80, 0x96, 0x6b, 0x59, 0x8b, 0x92, 0x34, 0x0f, 0xf4,
-	0x49, 0x6b, 0xb6, 0xa2, 0x89, 0x54, 0x4a, 0xdf, 0xdb, 0xbc, 0x54, 0xdf, 0x63, 0x29, 0x7d, 0xef,
-	0x6b, 0x28, 0x89, 0x11, 0x44, 0x8e, 0x8f, 0x6b, 0x3a, 0xcd, 0x0e, 0x39, 0xc7, 0x47, 0x38, 0x7b,
-	0x1d, 0xd6, 0x3d, 0xdf, 0x3e, 0xb6, 0x5d, 0x3d, 0x08, 0x7d, 0xdb, 0x3d, 0x16, 0x6b, 0xa3, 0xc6,
-	0x81, 0x03, 0x82, 0xa1, 0x98, 0xc2, 0xd9, 0xd3, 0x8d, 0x91, 0xed, 0xe0, 0xde, 0xc9, 0x09, 0x2b,
-	0x78, 0xee, 0x38, 0x4d, 0x0e, 0x52, 0xfb, 0x50, 0x8e, 0xc6, 0xfb, 0x37, 0x52, 0xa7, 0xfa, 0x5b,
-	0x50, 0xed, 0xb8, 0xa6, 0x75, 0xde, 0x27, 0xc9, 0xcb, 0xde, 0x01, 0x36, 0xf6, 0x2d, 0x23, 0xb4,
-	0x74, 0xeb, 0x3c, 0xf4, 0x0d, 0x9d, 0x5b, 0xca, 0xdc, 0x4a, 0x55, 0x38, 0xa6, 0x8d, 0x88, 0x21,
-	0xc2, 0xd5, 0xff, 0x9c, 0x81, 0xf5, 0x43, 0x3e, 0x11, 0x4f, 0xad, 0x8b, 0x5d, 0xae, 0xcb, 0x8f,
-	0xa3, 0x4d, 0x94, 0xd7, 0xe8, 0x9b, 0xdd, 0x86, 0xea, 0xec, 0xd4, 0xba, 0xd0, 0x53, 0x7a, 0x6f,
-	0x05, 0x41, 0x2d, 0xda, 0x2e, 0x6f, 0x43, 0xd1, 0xa3, 0xda, 0x85, 0x1c, 0x10, 0xec, 0x53, 0x6a,
-	0x96, 0x26, 0x08, 0x98, 0x0a, 0xeb, 0x71, 0x51, 0xb2, 0x24, 0x17, 0x85, 0xd1, 0x74, 0x6d, 0x41,
-	0x01, 0x51, 0x41, 0xa3, 0xb0, 0x9d, 0x43, 0xe5, 0x95, 0x12, 0xec, 0x3d, 0x58, 0x1f, 0x7b, 0xd3,
-	0x99, 0x1e, 0x65, 0x17, 0x12, 0x21, 0xbd, 0xcd, 0xab, 0x48, 0x72, 0xc8, 0xcb, 0x52, 0x7f, 0x3f,
-	0x07, 0x65, 0x6a, 0x83, 0xd8, 0xe9, 0xb6, 0x79, 0x1e, 0xed, 0xf4, 0x8a, 0x56, 0xb0, 0x4d, 0x64,
-	0x7f, 0xaf, 0x02, 0xd8, 0x48, 0xa2, 0x4b, 0xfb, 0xbd, 0x42, 0x90, 0xa8, 0x29, 0x33, 0xc3, 0x0f,
-	0x83, 0x46, 0x8e, 0x37, 0x85, 0x12, 0xb8, 0x04, 0xe7, 0xae, 0xfd, 0xf5, 0x9c, 0xb7, 0xbe, 0xac,
-	0x89, 0x14, 0xbb, 0x07, 0x0a, 0x2f, 0x8c, 0x06, 0x5d, 0x56, 0x45, 0xea, 0x04, 0xa7, 0x31, 0x8f,
-	0x74, 0x3d, 0x4e, 0x63, 0x9d, 0xa3, 0x0c, 0xe0, 0xbb, 0x1d, 0x08, 0xd4, 0x46, 0x88, 0xbc, 0x8f,
-	0x4b, 0xe9, 0x7d, 0xdc, 0x80, 0xd2, 0x0b, 0x3b, 0xb0, 0x71, 0x56, 0xcb, 0x7c, 0x67, 0x88, 0xa4,
-	0x34, 0x0d, 0x95, 0x97, 0x4d, 0x43, 0xdc, 0x6d, 0xc3, 0x39, 0xe6, 0x4a, 0x60, 0xd4, 0xed, 0xa6,
-	0x73, 0xec, 0xb1, 0xf7, 0xe1, 0x6a, 0x82, 0x16, 0xbd, 0x21, 0x97, 0x08, 0x59, 0xfd, 0x1a, 0x8b,
-	0x29, 0xa9, 0x47, 0xa4, 0xa5, 0xdf, 0x87, 0x4d, 0x29, 0xcb, 0x0c, 0x55, 0x80, 0x80, 0xd8, 0x40,
-	0x45, 0xdb, 0x88, 0xc9, 0x49, 0x33, 0x08, 0xd4, 0x7f, 0x93, 0x85, 0xf5, 0x3d, 0xcf, 0xb7, 0xec,
-	0x63, 0x37, 0x59, 0x75, 0x4b, 0xba, 0x62, 0xb4, 0x12, 0xb3, 0xd2, 0x4a, 0xbc, 0x03, 0xd5, 0x09,
-	0xcf, 0xa8, 0x87, 0x23, 0x6e, 0x42, 0xe6, 0x35, 0x10, 0xa0, 0xe1, 0xc8, 0xc1, 0x1d, 0x18, 0x11,
-	0x50, 0xe6, 0x3c, 0x65, 0x8e, 0x32, 0x21, 0xfb, 0x67, 0x9f, 0x12, 0x23, 0x34, 0x2d, 0xc7, 0x0a,
-	0xf9, 0xf4, 0xd4, 0x1f, 0xbe, 0x2a, 0x74, 0x06, 0xb9, 0x4d, 0x0f, 0x34, 0x6b, 0xd2, 0x24, 0x15,
-	0x02, 0xf9, 0xe2, 0x2e, 0x91, 0x8b, 0xbc, 0x82, 0x89, 0x16, 0xbf, 0x65, 0x5e, 0xbe, 0xdb, 0xd5,
-	0x21, 0x54, 0x62, 0x30, 0xea, 0x83, 0x5a, 0x5b, 0xe8, 0x80, 0x6b, 0xac, 0x0a, 0xa5, 0x56, 0x73,
-	0xd0, 0x6a, 0xee, 0xb6, 0x95, 0x0c, 0xa2, 0x06, 0xed, 0x21, 0xd7, 0xfb, 0xb2, 0x6c, 0x03, 0xaa,
-	0x98, 0xda, 0x6d, 0xef, 0x35, 0x8f, 0xba, 0x43, 0x25, 0xc7, 0xd6, 0xa1, 0xd2, 0xeb, 0xeb, 0xcd,
-	0xd6, 0xb0, 0xd3, 0xef, 0x29, 0x79, 0xf5, 0x33, 0x28, 0xb7, 0x4e, 0xac, 0xf1, 0xe9, 0x65, 0xa3,
-	0x48, 0x26, 0x98, 0x35, 0x3e, 0x15, 0x3a, 0xdc, 0x82, 0x09, 0x66, 0x8d, 0x4f, 0xd5, 0x67, 0x50,
-	0x6b, 0x45, 0x7c, 0xfa, 0xb2, 0x52, 0x1e, 0x42, 0x9d, 0x36, 0xdf, 0x78, 0x14, 0xed, 0xbe, 0xec,
-	0x8a, 0xdd, 0x57, 0x43, 0x9a, 0xd6, 0x48, 0x6c, 0xbf, 0x0f, 0xa1, 0x7a, 0xe8, 0x7b, 0x33, 0xcb,
-	0x0f, 0xa9, 0x58, 0x05, 0x72, 0xa7, 0xd6, 0x85, 0x28, 0x15, 0x3f, 0x13, 0x23, 0x35, 0x2b, 0x1b,
-	0xa9, 0x0f, 0xa1, 0x1c, 0x65, 0xfb, 0xd6, 0x79, 0x7e, 0x82, 0x5c, 0x8c, 0xf2, 0xd8, 0x56, 0x80,
-	0x95, 0x3d, 0x00, 0x98, 0xc5, 0x00, 0xa1, 0x10, 0x44, 0xda, 0xa9, 0x28, 0x5c, 0x93, 0x28, 0xd4,
-	0xbf, 0xc8, 0x41, 0xfd, 0xd0, 0xf0, 0x43, 0x1b, 0x27, 0x87, 0x0f, 0xc3, 0x5b, 0x90, 0xa7, 0x25,
-	0xcf, 0xed, 0xe1, 0x2b, 0xb1, 0x6a, 0xcb, 0x69, 0x48, 0xb2, 0x13, 0x01, 0xfb, 0x14, 0xea, 0xb3,
-	0x08, 0xac, 0x13, 0x3f, 0xe7, 0x63, 0xb3, 0x98, 0x85, 0xc6, 0x7c, 0x7d, 0x26, 0x27, 0xd9, 0x8f,
-	0x61, 0x2b, 0x9d, 0xd7, 0x0a, 0x82, 0x84, 0x8f, 0xca, 0x93, 0x75, 0x25, 0x95, 0x91, 0x93, 0xb1,
-	0x16, 0x6c, 0x26, 0xd9, 0xc7, 0x9e, 0x33, 0x9f, 0xba, 0x81, 0xd0, 0xb5, 0xaf, 0x2d, 0xd4, 0xde,
-	0xe2, 0x58, 0x4d, 0x99, 0x2d, 0x40, 0x98, 0x0a, 0xb5, 0x18, 0xd6, 0x9b, 0x4f, 0x69, 0x4b, 0xe4,
-	0xb5, 0x14, 0x8c, 0x3d, 0x02, 0x88, 0xd3, 0x41, 0xa3, 0x48, 0xe3, 0xb9, 0xd8, 0xbf, 0x4e, 0x68,
-	0x4d, 0x35, 0x89, 0x0c, 0x35, 0x02, 0x64, 0x06, 0xbe, 0x1d, 0x9e, 0x4c, 0x89, 0x8b, 0xe5, 0xb4,
-	0x04, 0x40, 0xcc, 0x32, 0xd0, 0xd1, 0x64, 0x8b, 0xb3, 0x08, 0x86, 0x56, 0xb7, 0x83, 0xc1, 0x7c,
-	0x14, 0x97, 0x8b, 0x62, 0x30, 0xe9, 0xe5, 0x34, 0x38, 0x16, 0x86, 0x6d, 0xd2, 0xc2, 0x83, 0xe0,
-	0x98, 0x3d, 0x84, 0xab, 0x09, 0x51, 0xc2, 0x7f, 0x83, 0x06, 0x10, 0xe7, 0x4e, 0x86, 0x2f, 0x66,
-	0xc2, 0x81, 0xfa, 0x39, 0xac, 0xa7, 0x66, 0xe7, 0xa5, 0x02, 0xf9, 0x06, 0x94, 0xf1, 0x3f, 0x8a,
-	0x63, 0xb1, 0x00, 0x4b, 0x98, 0x1e, 0x84, 0xbe, 0x6a, 0x81, 0xb2, 0x38, 0xd6, 0xec, 0x2e, 0x39,
-	0x7b, 0x68, 0x52, 0x96, 0x9d, 0x36, 0x11, 0x0a, 0x6d, 0xf7, 0xe5, 0x49, 0xcc, 0x52, 0xab, 0x97,
-	0x26, 0x4b, 0xfd, 0x87, 0x59, 0xa9, 0xcd, 0x38, 0xe2, 0xec, 0x0d, 0x79, 0xf9, 0x49, 0x1b, 0x37,
-	0x19, 0x33, 0x92, 0x38, 0x6f, 0x83, 0xe2, 0xf9, 0xa6, 0xed, 0x1a, 0xe4, 0x7c, 0xe2, 0xc3, 0x9d,
-	0x25, 0x05, 0x6e, 0x43, 0xc0, 0x0f, 0x05, 0x18, 0x0d, 0x00, 0xd3, 0x8a, 0x6d, 0x79, 0x61, 0x89,
-	0xcb, 0x20, 0x59, 0x3a, 0xe5, 0xd3, 0xd2, 0xe9, 0x2d, 0xa8, 0x38, 0x56, 0x10, 0xe8, 0xe1, 0x89,
-	0xe1, 0x92, 0xfc, 0x4e, 0x77, 0xba, 0x8c, 0xc8, 0xe1, 0x89, 0xe1, 0x22, 0xa1, 0xed, 0xea, 0xc2,
-	0x5b, 0x5f, 0x5c, 0x26, 0xb4, 0x5d, 0xb2, 0x71, 0x50, 0xee, 0x6f, 0xad, 0x9a, 0x58, 0x21, 0x16,
-	0xd9, 0xf2, 0xbc, 0xaa, 0xaf, 0x42, 0xe9, 0x99, 0x6d, 0x9d, 0x09, 0x5e, 0xf6, 0xc2, 0xb6, 0xce,
-	0x22, 0x5e, 0x86, 0xdf, 0xea, 0x7f, 0x2a, 0x43, 0x99, 0x88, 0x77, 0x2f, 0x77, 0xf2, 0x7d, 0x17,
-	0x03, 0x60, 0x5b, 0xc8, 0xa9, 0xfc, 0x0a, 0xb3, 0x83, 0x4b, 0xad, 0x57, 0x01, 0x24, 0x19, 0xca,
-	0x35, 0x82, 0x4a, 0x18, 0x8b, 0x4e, 0xd4, 0x9c, 0x49, 0x31, 0x0b, 0xbe, 0x76, 0x84, 0x4f, 0x28,
-	0x01, 0xb0, 0x07, 0x5c, 0xaf, 0x25, 0x9f, 0x45, 0x49, 0x66, 0x2c, 0xd4, 0x87, 0xc8, 0xcc, 0x25,
-	0x65, 0x17, 0x13, 0xa4, 0x1f, 0x58, 0x7e, 0x10, 0x6d, 0xa7, 0x75, 0x2d, 0x4a, 0x22, 0x47, 0x43,
-	0xe5, 0x49, 0x98, 0xa5, 0xd1, 0xf6, 0x95, 0xb5, 0x3f, 0x8d, 0x08, 0xd8, 0x3d, 0x28, 0x91, 0xc8,
-	0xb6, 0x50, 0x82, 0x4b, 0xac, 0x33, 0x52, 0xa6, 0xb4, 0x08, 0xcd, 0xde, 0x86, 0xc2, 0xe4, 0xd4,
-	0xba, 0x08, 0x1a, 0xeb, 0x32, 0x4b, 0x48, 0xc9, 0x42, 0x8d, 0x53, 0xb0, 0xbb, 0x50, 0xf7, 0xad,
-	0x89, 0x4e, 0x6e, 0x3f, 0x14, 0xde, 0x41, 0xa3, 0x4e, 0xb2, 0xb9, 0xe6, 0x5b, 0x93, 0x16, 0x02,
-	0x87, 0x23, 0x27, 0x60, 0x6f, 0x42, 0x91, 0xa4, 0x12, 0xaa, 0xfd, 0x52, 0xcd, 0x91, 0x88, 0xd3,
-	0x04, 0x96, 0x3d, 0x84, 0x4a, 0xc2, 0x36, 0xae, 0x52, 0x87, 0xb6, 0x16, 0xf8, 0x11, 0xb1, 0x71,
-	0x2d, 0x21, 0x63, 0xef, 0x03, 0x08, 0x83, 0x44, 0x1f, 0x5d, 0x90, 0x23, 0xbd, 0x1a, 0x1b, 0x6c,
-	0x92, 0x00, 0x94, 0xcd, 0x96, 0xb7, 0xa0, 0x80, 0x52, 0x22, 0x68, 0x5c, 0xa7, 0xd6, 0x6c, 0xa6,
-	0x45, 0x08, 0xf5, 0x8e, 0xf0, 0xec, 0x1e, 0x94, 0x71, 0x71, 0xe9, 0x38, 0x85, 0x0d, 0xd9, 0x42,
-	0x13, 0x2b, 0x11, 0xb5, 0x34, 0xeb, 0x6c, 0xf0, 0xb5, 0xc3, 0xee, 0x43, 0xde, 0xb4, 0x26, 0x41,
-	0xe3, 0x06, 0x95, 0x78, 0x4d, 0x9a, 0x4b, 0x54, 0x1c, 0x76, 0xad, 0x09, 0x17, 0x2d, 0x48, 0xc3,
-	0xf6, 0xa1, 0x8e, 0x4b, 0xef, 0x21, 0x29, 0xde, 0x38, 0xe4, 0x8d, 0x9b, 0x94, 0xeb, 0xb5, 0x85,
-	0x5c, 0x3d, 0x41, 0x44, 0x13, 0xd4, 0x76, 0x43, 0xff, 0x42, 0x5b, 0x77, 0x65, 0x18, 0xbb, 0x09,
-	0x65, 0x3b, 0xe8, 0x7a, 0xe3, 0x53, 0xcb, 0x6c, 0xbc, 0xc2, 0xcf, 0xde, 0xa2, 0x34, 0xfb, 0x04,
-	0xd6, 0x69, 0x31, 0x62, 0x12, 0x2b, 0x6f, 0xdc, 0x92, 0x45, 0xde, 0x50, 0x46, 0x69, 0x69, 0x4a,
-	0x54, 0xb7, 0xec, 0x40, 0x0f, 0xad, 0xe9, 0xcc, 0xf3, 0xd1, 0xb6, 0x7b, 0x95, 0x1b, 0x3c, 0x76,
-	0x30, 0x8c, 0x40, 0xc8, 0xe7, 0xe3, 0x63, 0x3f, 0xdd, 0x9b, 0x4c, 0x02, 0x2b, 0x6c, 0xdc, 0xa6,
-	0xbd, 0x56, 0x8f, 0x4e, 0xff, 0xfa, 0x04, 0x25, 0xa5, 0x34, 0xd0, 0xcd, 0x0b, 0xd7, 0x98, 0xda,
-	0xe3, 0xc6, 0x1d, 0x6e, 0x42, 0xda, 0xc1, 0x2e, 0x07, 0xc8, 0x56, 0xdc, 0xb6, 0x6c, 0xc5, 0xdd,
-	0x7c, 0x42, 0x56, 0x1c, 0xb5, 0xe7, 0xc3, 0x05, 0xb9, 0x9f, 0x5a, 0xe8, 0x92, 0x82, 0xb0, 0xbf,
-	0x26, 0x8b, 0xff, 0x9d, 0x02, 0xe4, 0x4c, 0x6b, 0x72, 0xf3, 0x33, 0x60, 0xcb, 0x23, 0xf9, 0x32,
-	0x25, 0xa4, 0x20, 0x94, 0x90, 0x4f, 0xb3, 0x8f, 0x33, 0xea, 0x27, 0xb0, 0x9e, 0xda, 0x96, 0x2b,
-	0x95, 0x29, 0x6e, 0x54, 0x18, 0x53, 0xe1, 0x17, 0xe1, 0x09, 0xf5, 0xdf, 0xe7, 0xa0, 0xb6, 0x6f,
-	0x04, 0x27, 0x07, 0xc6, 0x6c, 0x10, 0x1a, 0x61, 0x80, 0x63, 0x7b, 0x62, 0x04, 0x27, 0x53, 0x63,
-	0xc6, 0xdd, 0xe3, 0x19, 0xee, 0x88, 0x11, 0xb0, 0x81, 0xfd, 0x8d, 0x85, 0xb3, 0x8a, 0xc9, 0xbe,
-	0x7b, 0xf8, 0x54, 0x1c, 0xb3, 0xc4, 0x69, 0xe4, 0x03, 0xc1, 0xc9, 0x7c, 0x32, 0x71, 0x2c, 0xc1,
-	0xaf, 0xa2, 0x24, 0xbb, 0x0b, 0xeb, 0xe2, 0x93, 0xcc, 0xb7, 0x73, 0x71, 0xe6, 0x9a, 0x06, 0xb2,
-	0x47, 0x50, 0x15, 0x80, 0x61, 0xc4, 0xb5, 0xea, 0xb1, 0x63, 0x2c, 0x41, 0x68, 0x32, 0x15, 0xfb,
-	0x29, 0x5c, 0x95, 0x92, 0x7b, 0x9e, 0x7f, 0x30, 0x77, 0x42, 0xbb, 0xd5, 0x13, 0xba, 0xf2, 0x2b,
-	0x4b, 0xd9, 0x13, 0x12, 0x6d, 0x75, 0xce, 0x74, 0x6b, 0x0f, 0x6c, 0x57, 0x68, 0x12, 0x69, 0xe0,
-	0x02, 0x95, 0x71, 0x4e, 0xbc, 0x2f, 0x4d, 0x65, 0x9c, 0xe3, 0x4a, 0x17, 0x80, 0x03, 0x2b, 0x3c,
-	0xf1, 0x4c, 0xd2, 0x24, 0xe2, 0x95, 0x3e, 0x90, 0x51, 0x5a, 0x9a, 0x12, 0x87, 0x13, 0xcd, 0xf8,
-	0xb1, 0x1b, 0x92, 0xb9, 0x94, 0xd3, 0xa2, 0x24, 0xca, 0x05, 0xdf, 0x70, 0x8f, 0xad, 0xa0, 0x51,
-	0xdd, 0xce, 0xdd, 0xcb, 0x68, 0x22, 0xa5, 0xfe, 0xb5, 0x2c, 0x14, 0xf8, 0x4c, 0xbe, 0x02, 0x95,
-	0x91, 0xe3, 0x8d, 0x4f, 0x75, 0x77, 0x3e, 0x8d, 0x7c, 0xe7, 0x04, 0x40, 0xd5, 0x8a, 0xcc, 0x9c,
-	0x80, 0xfb, 0x58, 0x33, 0x1a, 0x7d, 0x63, 0x91, 0xde, 0x3c, 0xc4, 0xba, 0x72, 0x04, 0x15, 0x29,
-	0x6c, 0x84, 0xef, 0x9d, 0xd1, 0x6a, 0xc8, 0x13, 0x22, 0x4a, 0x92, 0x7b, 0x9e, 0x44, 0x0c, 0x66,
-	0x2a, 0x10, 0xae, 0x4c, 0x80, 0x96, 0x1b, 0x2e, 0x7a, 0xf4, 0x8a, 0x4b, 0x1e, 0x3d, 0x76, 0x1b,
-	0xd0, 0x88, 0x1a, 0x5b, 0x7d, 0xd7, 0x6a, 0xf5, 0x68, 0x84, 0xcb, 0x9a, 0x04, 0x61, 0x1f, 0xc5,
-	0x6b, 0x91, 0x7a, 0x24, 0x5c, 0xa9, 0x82, 0x79, 0xca, 0xab, 0x56, 0x4b, 0xd1, 0xa9, 0xcf, 0x01,
-	0x34, 0xef, 0x2c, 0xb0, 0x42, 0x52, 0xaf, 0xae, 0x53, 0xf3, 0x53, 0xa7, 0x62, 0xde, 0xd9, 0xa1,
-	0x17, 0x44, 0x87, 0x8b, 0xd9, 0xf8, 0x70, 0x31, 0xd6, 0xc4, 0x72, 0xab, 0x35, 0x31, 0xf5, 0x5d,
-	0x28, 0xa1, 0x88, 0x35, 0x42, 0x83, 0xdd, 0x15, 0x5e, 0x46, 0xae, 0x62, 0x09, 0xff, 0x67, 0x52,
-	0xab, 0xf0, 0x3b, 0x76, 0xa3, 0x96, 0x50, 0x9e, 0xd7, 0x24, 0x2f, 0x47, 0xcc, 0xaa, 0x45, 0x81,
-	0x42, 0x68, 0xbf, 0x02, 0x15, 0x6c, 0x2c, 0x1d, 0x30, 0x88, 0x96, 0x95, 0x7d, 0xef, 0xac, 0x85,
-	0x69, 0xf5, 0xbf, 0x64, 0xa0, 0xda, 0xf7, 0x4d, 0x94, 0x11, 0x83, 0x99, 0x35, 0x7e, 0xa9, 0xe2,
-	0x88, 0x22, 0xde, 0x73, 0x1c, 0x23, 0x56, 0xbb, 0x50, 0xc4, 0x47, 0x00, 0xf6, 0x3e, 0xe4, 0x27,
-	0x8e, 0x71, 0x4c, 0x9d, 0x8d, 0x0d, 0x4a, 0xa9, 0xf8, 0xe8, 0x7b, 0xcf, 0x31, 0x8e, 0x35, 0x22,
-	0x55, 0x7f, 0x27, 0xae, 0x9f, 0x8e, 0x20, 0xe4, 0x83, 0x87, 0x35, 0x3a, 0xec, 0x1a, 0xb4, 0x94,
-	0x0c, 0x2b, 0x43, 0x7e, 0xb7, 0x3d, 0x68, 0x71, 0x33, 0x12, 0x0d, 0xca, 0x81, 0xbe, 0xd7, 0xd1,
-	0x06, 0x43, 0x25, 0x4f, 0xa7, 0x67, 0x04, 0xe8, 0x36, 0x07, 0x43, 0xa5, 0xcc, 0x00, 0x8a, 0x47,
-	0xbd, 0xce, 0x4f, 0x8f, 0xda, 0x8a, 0xa2, 0xfe, 0xc7, 0x0c, 0x40, 0xe2, 0x1f, 0x67, 0x3f, 0x80,
-	0xea, 0x19, 0xa5, 0x74, 0xe9, 0xe0, 0x44, 0xee, 0x23, 0x70, 0x34, 0xa9, 0x1f, 0x3f, 0x94, 0xac,
-	0x09, 0x14, 0xb3, 0xcb, 0x27, 0x28, 0xd5, 0x59, 0x22, 0xa1, 0xd9, 0x3b, 0x50, 0xf6, 0xb0, 0x1f,
-	0x48, 0x9a, 0x93, 0x65, 0xac, 0xd4, 0x7d, 0xad, 0xe4, 0xf1, 0x04, 0x8a, 0xe3, 0x89, 0x1f, 0x79,
-	0x8d, 0x62, 0xd2, 0x3d, 0x04, 0xb5, 0x1c, 0x63, 0x1e, 0x58, 0x1a, 0xc7, 0xc7, 0x6c, 0xb7, 0x90,
-	0xb0, 0x5d, 0xf5, 0x67, 0x50, 0x1f, 0x18, 0xd3, 0x19, 0x67, 0xce, 0xd4, 0x31, 0x06, 0x79, 0x5c,
-	0x13, 0x62, 0x31, 0xd2, 0x37, 0x6e, 0xb1, 0x43, 0xcb, 0x1f, 0x5b, 0x6e, 0xb4, 0x23, 0xa3, 0x24,
-	0x32, 0xdb, 0xa3, 0xc0, 0x76, 0x8f, 0x35, 0xef, 0x2c, 0x0a, 0x5f, 0x89, 0xd2, 0xea, 0x3f, 0xce,
-	0x40, 0x55, 0x6a, 0x06, 0x7b, 0x37, 0x65, 0x3c, 0xbe, 0xb2, 0xd4, 0x4e, 0xfe, 0x2d, 0x19, 0x91,
-	0x6f, 0x42, 0x21, 0x08, 0x0d, 0x3f, 0x3a, 0x6a, 0x51, 0xa4, 0x1c, 0x3b, 0xde, 0xdc, 0x35, 0x35,
-	0x8e, 0x66, 0x2a, 0xe4, 0x2c, 0xd7, 0x14, 0xdb, 0x62, 0x99, 0x0a, 0x91, 0xea, 0x36, 0x54, 0xe2,
-	0xe2, 0x71, 0x09, 0x68, 0xfd, 0xe7, 0x03, 0x65, 0x8d, 0x55, 0xa0, 0xa0, 0x35, 0x7b, 0x4f, 0xda,
-	0x4a, 0x46, 0xfd, 0xe7, 0x19, 0x80, 0x24, 0x17, 0x7b, 0x90, 0x6a, 0xed, 0xcd, 0xc5, 0x52, 0x1f,
-	0xd0, 0x5f, 0xa9, 0xb1, 0xb7, 0xa0, 0x32, 0x77, 0x09, 0x68, 0x99, 0x42, 0xee, 0x24, 0x00, 0x76,
-	0x0b, 0x72, 0x51, 0xa0, 0xcb, 0x42, 0x70, 0xc1, 0x0b, 0xc3, 0x51, 0x3f, 0x85, 0x4a, 0x5c, 0x1c,
-	0x5b, 0x87, 0xca, 0x5e, 0xbf, 0xdb, 0xed, 0x3f, 0xef, 0xf4, 0x9e, 0x28, 0x6b, 0x98, 0x3c, 0xd4,
-	0xda, 0xad, 0xf6, 0x2e, 0x26, 0x33, 0xb8, 0x66, 0x5b, 0x47, 0x9a, 0xd6, 0xee, 0x0d, 0x75, 0xad,
-	0xff, 0x5c, 0xc9, 0xaa, 0x7f, 0x23, 0x0f, 0x9b, 0x7d, 0x77, 0x77, 0x3e, 0x73, 0xec, 0xb1, 0x11,
-	0x5a, 0x4f, 0xad, 0x8b, 0x56, 0x78, 0x8e, 0xe2, 0xd4, 0x08, 0x43, 0x9f, 0x6f, 0xe6, 0x8a, 0xc6,
-	0x13, 0xdc, 0x17, 0x17, 0x58, 0x7e, 0x48, 0xae, 0x46, 0x79, 0x17, 0xd7, 0x39, 0xbc, 0xe5, 0x39,
-	0xb4, 0x97, 0xd9, 0x8f, 0xe1, 0x2a, 0xf7, 0xdf, 0x71, 0x4a, 0xd4, 0x2f, 0x75, 0xc1, 0x7b, 0x16,
-	0x97, 0x2e, 0xe3, 0x84, 0x98, 0x15, 0xc9, 0x88, 0xa9, 0xdd, 0x81, 0x6a, 0x92, 0x9d, 0x5b, 0x01,
-	0x15, 0x0d, 0x62, 0x42, 0x6a, 0x89, 0xe7, 0xea, 0x66, 0xd4, 0x6a, 0xdd, 0x36, 0xcf, 0xc9, 0x32,
-	0x2a, 0x68, 0x75, 0x2f, 0xe9, 0x0c, 0x8a, 0xdc, 0x2f, 0x60, 0x33, 0x45, 0x49, 0xad, 0xe0, 0xb6,
-	0xd1, 0x3b, 0x91, 0xab, 0x7e, 0xa1, 0xf7, 0x32, 0x04, 0x9b, 0xc3, 0x95, 0xbf, 0x0d, 0x2f, 0x0d,
-	0x45, 0x66, 0x66, 0x07, 0xba, 0x7d, 0xec, 0x7a, 0xbe, 0x25, 0xd8, 0x7b, 0xd9, 0x0e, 0x3a, 0x94,
-	0x4e, 0xcc, 0x13, 0xe9, 0x64, 0x99, 0x4b, 0x93, 0xe8, 0x60, 0x95, 0xa3, 0x6d, 0x2e, 0x2f, 0xf3,
-	0x5a, 0x89, 0xd2, 0x1d, 0x13, 0x2d, 0x73, 0x8e, 0x8a, 0x2c, 0x0e, 0x20, 0x8b, 0xa3, 0x46, 0xc0,
-	0x67, 0x1c, 0x76, 0xb3, 0x07, 0x5b, 0xab, 0x1a, 0xb9, 0x42, 0xaf, 0xda, 0x96, 0xf5, 0xaa, 0x05,
-	0x5f, 0x55, 0xa2, 0x63, 0xfd, 0xcb, 0x2c, 0x54, 0x3a, 0x7c, 0x0a, 0xc3, 0x73, 0xf6, 0x1a, 0xe4,
-	0x7c, 0x6b, 0x72, 0xd9, 0x69, 0x2e, 0xe2, 0xd8, 0x7d, 0xd8, 0x34, 0x4c, 0x53, 0x37, 0x26, 0x13,
-	0x6b, 0x1c, 0x5a, 0xa6, 0x8e, 0x32, 0x53, 0x2c, 0xdb, 0x0d, 0xc3, 0x34, 0x9b, 0x02, 0x4e, 0xdb,
-	0x9f, 0x7b, 0x25, 0x22, 0x33, 0x81, 0x3b, 0xcf, 0x73, 0x91, 0x57, 0x42, 0x58, 0x09, 0xa4, 0xe1,
-	0xb1, 0x1f, 0x44, 0x12, 0xd7, 0xb4, 0x26, 0x82, 0x1f, 0xd5, 0xd3, 0x6a, 0xb9, 0x90, 0xc0, 0xdc,
-	0x1f, 0x75, 0x65, 0xd1, 0x88, 0xb5, 0x4d, 0xee, 0xe0, 0xce, 0x6b, 0x9b, 0x69, 0x1b, 0xb6, 0x63,
-	0x06, 0x97, 0x7b, 0x33, 0x8a, 0x97, 0x7a, 0x33, 0xd2, 0x6e, 0x12, 0x5c, 0x64, 0x25, 0x5a, 0xee,
-	0x09, 0x3b, 0xee, 0x98, 0xe7, 0xea, 0x3f, 0xc8, 0x01, 0x68, 0xd6, 0xcc, 0x31, 0xc6, 0xd6, 0xff,
-	0x3f, 0xa3, 0x77, 0x07, 0xaa, 0xdc, 0x5d, 0xab, 0x8f, 0x3d, 0xd7, 0x8c, 0x62, 0x2a, 0x38, 0xa8,
-	0xe5, 0x11, 0x03, 0x5b, 0x39, 0xbc, 0xc5, 0xef, 0x3c, 0xbc, 0xa5, 0xef, 0x30, 0xbc, 0xe5, 0xe5,
-	0xe1, 0x65, 0x9f, 0xc1, 0xab, 0xbe, 0x75, 0xe6, 0xdb, 0xa1, 0xa5, 0x4f, 0x7c, 0x6f, 0xaa, 0xa7,
-	0xb6, 0x33, 0xae, 0xf6, 0x0a, 0x8d, 0xc6, 0x0d, 0x41, 0xb4, 0xe7, 0x7b, 0xd3, 0xf4, 0x96, 0x56,
-	0xff, 0x77, 0x1e, 0xaa, 0x4d, 0xd7, 0x70, 0x2e, 0xbe, 0xb1, 0x28, 0xee, 0x82, 0x3c, 0xf5, 0xb3,
-	0x79, 0xc8, 0xc7, 0x9d, 0x9f, 0x87, 0x56, 0x08, 0x42, 0x23, 0x7e, 0x07, 0xaa, 0xde, 0x3c, 0x8c,
-	0xf1, 0xfc, 0x84, 0x14, 0x38, 0x88, 0x08, 0xe2, 0xfc, 0xa4, 0x35, 0xe6, 0xa4, 0xfc, 0x64, 0x41,
-	0x24, 0xf9, 0x63, 0xad, 0x32, 0xce, 0x4f, 0x04, 0xb8, 0xc5, 0xed, 0x29, 0x8d, 0x7c, 0x30, 0x9f,
-	0x5a, 0x7c, 0xf4, 0x73, 0x3c, 0xbe, 0xad, 0x25, 0x60, 0x58, 0xca, 0xd4, 0x9a, 0x7a, 0xfe, 0x05,
-	0x2f, 0xa5, 0xc8, 0x4b, 0xe1, 0x20, 0x2a, 0xe5, 0x1d, 0x60, 0x67, 0x86, 0x1d, 0xea, 0xe9, 0xa2,
-	0xb8, 0x26, 0xaf, 0x20, 0x66, 0x28, 0x17, 0x77, 0x0d, 0x8a, 0xa6, 0x1d, 0x9c, 0x76, 0xfa, 0x42,
-	0x8b, 0x17, 0x29, 0xe4, 0x62, 0xc1, 0xa3, 0x4e, 0x5f, 0x1f, 0x5d, 0x88, 0x23, 0xcc, 0x9c, 0x56,
-	0x46, 0xc0, 0xce, 0x45, 0x48, 0x87, 0x2f, 0x84, 0xe4, 0xbd, 0xe5, 0x0c, 0x9f, 0x6b, 0xea, 0x75,
-	0x84, 0x77, 0x10, 0xcc, 0x19, 0xfe, 0x7d, 0xd8, 0x24, 0x4a, 0xd1, 0x71, 0x4e, 0x5a, 0x25, 0xd2,
-	0x0d, 0x44, 0xf4, 0x09, 0xce, 0x69, 0x6f, 0x41, 0xc5, 0xb5, 0xc2, 0x33, 0xcf, 0xc7, 0xd6, 0xd4,
-	0xf8, 0xe8, 0xc5, 0x00, 0x54, 0x09, 0x82, 0xb1, 0xe1, 0x62, 0xe3, 0xe9, 0x58, 0x13, 0xdb, 0x23,
-	0xd2, 0xa8, 0x52, 0x73, 0x41, 0x43, 0xd8, 0x3a, 0x1f, 0x92, 0x04, 0xc2, 0x3e, 0x81, 0x1b, 0xa9,
-	0xd1, 0xd0, 0x0d, 0xdf, 0x37, 0x2e, 0xf4, 0xa9, 0xf1, 0x95, 0xe7, 0x93, 0xf3, 0x23, 0xa7, 0x5d,
-	0x93, 0x07, 0xb9, 0x89, 0xe8, 0x03, 0xc4, 0x5e, 0x9a, 0xd5, 0x76, 0x3d, 0xbf, 0xa1, 0x5c, 0x96,
-	0x15, 0xb1, 0x64, 0xb0, 0xd3, 0x00, 0x91, 0xfd, 0x11, 0xd0, 0x49, 0x69, 0x4e, 0xab, 0x12, 0x6c,
-	0x87, 0x40, 0xaa, 0x2f, 0xb9, 0xc2, 0x0f, 0xfd, 0xb9, 0x6b, 0x71, 0xe7, 0x01, 0x7d, 0x9a, 0xe2,
-	0x24, 0x31, 0x4e, 0xb3, 0x5d, 0xb8, 0xc2, 0x0d, 0x09, 0xcb, 0xd4, 0x25, 0x17, 0x71, 0xf6, 0x72,
-	0x17, 0x31, 0x8b, 0xe8, 0x63, 0x70, 0xa0, 0xfe, 0x3c, 0x03, 0x37, 0xfb, 0x74, 0xaa, 0x49, 0x3b,
-	0xee, 0xc0, 0x0a, 0x02, 0xe3, 0x18, 0xad, 0xc0, 0xbd, 0xf9, 0x37, 0xdf, 0x5c, 0xb0, 0x7b, 0xb0,
-	0x71, 0x68, 0xf8, 0x96, 0x1b, 0xc6, 0xfb, 0x51, 0x88, 0x8d, 0x45, 0x30, 0x7b, 0x4c, 0x6e, 0x58,
-	0xcb, 0x0d, 0x8f, 0x62, 0x01, 0x2c, 0xda, 0x92, 0x76, 0xcc, 0x2d, 0x51, 0xa9, 0xff, 0xeb, 0x15,
-	0xc8, 0xf7, 0x3c, 0xd3, 0x62, 0xef, 0x41, 0x85, 0xa2, 0xdb, 0x96, 0xbd, 0xff, 0x88, 0xa6, 0x3f,
-	0xa4, 0x0b, 0x95, 0x5d, 0xf1, 0x75, 0x79, 0x3c, 0xdc, 0x6b, 0xa4, 0xd5, 0xd1, 0xf1, 0x21, 0x72,
-	0xb8, 0xaa, 0xb0, 0x33, 0xc9, 0x50, 0xe2, 0x18, 0x1c, 0x5b, 0x72, 0x89, 0xf9, 0x96, 0x4b, 0xba,
-	0x43, 0x41, 0x8b, 0xd3, 0xa4, 0x4b, 0xfb, 0x1e, 0x72, 0x63, 0x9d, 0x42, 0x45, 0x0a, 0x2b, 0x74,
-	0x69, 0x8e, 0xa7, 0x00, 0xc1, 0xf7, 0xa0, 0xf2, 0x95, 0x67, 0xbb, 0xbc, 0xe1, 0xc5, 0xa5, 0x86,
-	0x7f, 0xee, 0xd9, 0xfc, 0xd8, 0xa2, 0xfc, 0x95, 0xf8, 0x62, 0xaf, 0x43, 0xc9, 0x73, 0x79, 0xd9,
-	0xa5, 0xa5, 0xb2, 0x8b, 0x9e, 0xdb, 0xe5, 0x21, 0x28, 0xeb, 0xa3, 0xb9, 0xed, 0x98, 0xc8, 0xcc,
-	0x1c, 0x6b, 0x12, 0x0a, 0x2f, 0x7d, 0x95, 0x80, 0x7d, 0xb7, 0x6b, 0x4d, 0x42, 0x34, 0x11, 0x26,
-	0xb6, 0x83, 0x4c, 0x9f, 0x0a, 0xab, 0x2c, 0x15, 0x06, 0x1c, 0x4d, 0x05, 0xbe, 0x01, 0xe5, 0x63,
-	0xdf, 0x9b, 0xcf, 0x50, 0xe7, 0x87, 0x65, 0xbf, 0x38, 0xe1, 0x76, 0x2e, 0xb0, 0xf7, 0xf4, 0x69,
-	0xbb, 0xc7, 0x7a, 0x60, 0x85, 0x64, 0x5d, 0x2f, 0xf4, 0x3e, 0xc2, 0x0f, 0x2c, 0x2a, 0xd5, 0x38,
-	0x3e, 0xd6, 0x45, 0x4c, 0xcd, 0x52, 0xa9, 0xc6, 0xf1, 0x31, 0x55, 0xfe, 0x00, 0xd6, 0xcf, 0x6c,
-	0x57, 0x0f, 0x66, 0xd6, 0x98, 0xd3, 0xae, 0x2f, 0x17, 0x7b, 0x66, 0xbb, 0x68, 0x1f, 0x10, 0xbd,
-	0x6c, 0xa0, 0xd4, 0x5f, 0x6a, 0xa0, 0x6c, 0x43, 0xc1, 0xb1, 0xa7, 0x76, 0x48, 0x41, 0x0b, 0x0b,
-	0x1a, 0x0c, 0x21, 0x98, 0x0a, 0x45, 0xe1, 0x04, 0x53, 0x96, 0x48, 0x04, 0x26, 0x2d, 0x1c, 0x37,
-	0x5f, 0x22, 0x1c, 0xef, 0x41, 0xc9, 0x1b, 0x7d, 0xa5, 0xa3, 0x18, 0x67, 0xab, 0xc5, 0x78, 0xd1,
-	0x1b, 0x7d, 0xa5, 0x59, 0x13, 0xf6, 0x21, 0x9d, 0x14, 0x58, 0x6e, 0xa8, 0x47, 0x19, 0xae, 0xac,
-	0xce, 0x50, 0xe3, 0x64, 0x7d, 0x9e, 0xed, 0x7d, 0xa8, 0xfa, 0x64, 0x39, 0xeb, 0x64, 0x66, 0x6f,
-	0xc9, 0xa6, 0x47, 0x62, 0x52, 0x6b, 0xe0, 0x27, 0xe6, 0xf5, 0xeb, 0xb0, 0xce, 0xa3, 0x0f, 0xf8,
-	0x71, 0x73, 0x40, 0xce, 0xd6, 0x8a, 0x56, 0x23, 0x20, 0x3f, 0x8a, 0x0e, 0xd8, 0x03, 0x80, 0x48,
-	0xaa, 0x87, 0xe7, 0x14, 0xb9, 0x1c, 0x37, 0x85, 0x9f, 0xb6, 0xb6, 0xc2, 0x73, 0xad, 0x62, 0x46,
-	0x9f, 0xc8, 0xba, 0x46, 0xb6, 0x6b, 0xe2, 0x72, 0x08, 0x8d, 0xe3, 0xa0, 0xd1, 0xa0, 0xdd, 0x52,
-	0x15, 0xb0, 0xa1, 0x71, 0x1c, 0xb0, 0x0f, 0xa0, 0x66, 0x70, 0xd9, 0xc9, 0xa3, 0x1b, 0x6f, 0xc8,
-	0x66, 0xa2, 0x24, 0x55, 0xb5, 0xaa, 0x21, 0x89, 0xd8, 0x8f, 0x81, 0x45, 0x1e, 0x76, 0x52, 0xb9,
-	0xf9, 0xba, 0xb8, 0xb9, 0xb4, 0x2e, 0x36, 0x84, 0x8b, 0x3d, 0x8e, 0xc8, 0xfd, 0x18, 0xd6, 0xd3,
-	0xba, 0xce, 0xad, 0x15, 0x3e, 0x65, 0x9a, 0x32, 0xad, 0x36, 0x96, 0xb5, 0x9f, 0xd7, 0x61, 0xdd,
-	0xf5, 0x42, 0x7d, 0x6c, 0x8c, 0x4f, 0x2c, 0xca, 0xc8, 0xfd, 0xa6, 0x35, 0xd7, 0x0b, 0x5b, 0x11,
-	0x0c, 0xc7, 0x27, 0xb2, 0x60, 0xc2, 0x73, 0x72, 0x99, 0xc6, 0xe3, 0x13, 0xab, 0xbf, 0x28, 0xca,
-	0x23, 0x4d, 0x18, 0xe7, 0x89, 0x6b, 0x76, 0x94, 0xe1, 0x4e, 0x6a, 0x9e, 0x62, 0x95, 0x4f, 0x03,
-	0x3f, 0x51, 0xff, 0xee, 0x40, 0x35, 0xf0, 0xe6, 0xfe, 0xd8, 0xd2, 0x83, 0xd0, 0x9a, 0x35, 0xb6,
-	0x69, 0x44, 0x81, 0x83, 0x06, 0xa1, 0x35, 0x63, 0x8f, 0xa1, 0x3e, 0xf3, 0x2d, 0x5d, 0x9a, 0xa7,
-	0xd7, 0xe4, 0x2e, 0x1e, 0xfa, 0x56, 0x32, 0x55, 0xb5, 0x99, 0x94, 0x8a, 0x72, 0x4a, 0x3d, 0x50,
-	0x17, 0x72, 0x26, 0x9d, 0xc0, 0x9c, 0x89, 0x46, 0xff, 0x13, 0xd8, 0x94, 0x72, 0xce, 0x4f, 0x29,
-	0xf3, 0xeb, 0x29, 0x17, 0x7f, 0x44, 0x7e, 0x74, 0x8a, 0xd9, 0xeb, 0xb3, 0x54, 0x9a, 0x35, 0x17,
-	0x0c, 0x2e, 0xd4, 0xbb, 0xee, 0x52, 0xfe, 0xeb, 0x97, 0x58, 0x51, 0x29, 0x4b, 0xec, 0x29, 0xf7,
-	0xf0, 0x76, 0x82, 0xb6, 0x6b, 0x36, 0xde, 0xe0, 0x61, 0xf3, 0x94, 0x60, 0x8f, 0xa0, 0x46, 0x6e,
-	0xbc, 0x90, 0x42, 0xf9, 0x82, 0xc6, 0x9b, 0xb2, 0xc7, 0x89, 0x7c, 0xe2, 0x84, 0xd0, 0xaa, 0x4e,
-	0xfc, 0x1d, 0xb0, 0x8f, 0x60, 0x93, 0x3b, 0xff, 0x64, 0x06, 0xf9, 0xd6, 0xf2, 0xe2, 0x22, 0xa2,
-	0xbd, 0x84, 0x4b, 0x6a, 0x70, 0xc3, 0x9f, 0xbb, 0x24, 0xe7, 0x45, 0xce, 0x99, 0xef, 0x8d, 0x2c,
-	0x9e, 0xff, 0x1e, 0xe5, 0x17, 0xdd, 0xd1, 0x38, 0x19, 0xcf, 0x4b, 0xfc, 0xe8, 0x9a, 0x2f, 0x83,
-	0x0e, 0x31, 0xdf, 0x25, 0x65, 0x72, 0xce, 0x4e, 0x65, 0xbe, 0xfd, 0x5d, 0xca, 0xdc, 0xc1, 0x7c,
-	0x54, 0x26, 0x83, 0xfc, 0x7c, 0x6e, 0x9b, 0x8d, 0xfb, 0x3c, 0xc8, 0x0f, 0xbf, 0xd9, 0x1b, 0x50,
-	0xf7, 0xad, 0xf1, 0xdc, 0x0f, 0xec, 0x17, 0x96, 0x1e, 0xd8, 0xee, 0x69, 0xe3, 0x07, 0x34, 0x8e,
-	0xeb, 0x31, 0x74, 0x60, 0xbb, 0xa7, 0xb8, 0x62, 0xad, 0xf3, 0xd0, 0xf2, 0x5d, 0x1d, 0xb5, 0xa6,
-	0xc6, 0x3b, 0xf2, 0x8a, 0x6d, 0x13, 0x62, 0x30, 0x36, 0x5c, 0x0d, 0xac, 0xf8, 0x9b, 0xfd, 0x18,
-	0x36, 0x12, 0x2d, 0x7c, 0x86, 0x2a, 0x48, 0xe3, 0x87, 0x2b, 0x4f, 0x7f, 0x48, 0x3d, 0xd1, 0x92,
-	0xa3, 0x51, 0xae, 0xc9, 0xa4, 0xd7, 0x56, 0xc0, 0xd7, 0xd6, 0x83, 0x6f, 0xb5, 0xb6, 0x06, 0xb4,
-	0xb6, 0xde, 0x84, 0xb2, 0xed, 0x86, 0x96, 0xff, 0xc2, 0x70, 0x1a, 0xef, 0x2e, 0x31, 0xf0, 0x18,
-	0xc7, 0xee, 0x42, 0x29, 0x70, 0x6c, 0x64, 0x4c, 0x8d, 0xf7, 0x96, 0xc8, 0x22, 0x14, 0x4a, 0xec,
-	0x89, 0xed, 0x38, 0x5c, 0x62, 0xbf, 0xbf, 0x24, 0xb1, 0xf7, 0x6c, 0xc7, 0xe1, 0x12, 0x7b, 0x22,
-	0xbe, 0x50, 0xca, 0x51, 0x0e, 0xac, 0xff, 0xe1, 0xb2, 0x94, 0x43, 0xdc, 0x33, 0xba, 0x07, 0x53,
-	0x0d, 0xc8, 0xd7, 0xc5, 0x5d, 0x76, 0x8f, 0xe4, 0x1e, 0xa6, 0x9d, 0x60, 0x1a, 0x04, 0x71, 0x1a,
-	0x8d, 0x05, 0xe1, 0xe9, 0x43, 0x03, 0xe7, 0x03, 0x1e, 0x9e, 0xcd, 0x21, 0x68, 0xdd, 0xbc, 0x07,
-	0xeb, 0x51, 0x34, 0x0b, 0x56, 0x17, 0x34, 0x3e, 0x5c, 0x6a, 0x41, 0x9a, 0x80, 0xed, 0x42, 0x6d,
-	0x82, 0x1a, 0xdc, 0x94, 0x2b, 0x74, 0x8d, 0x8f, 0xa8, 0x21, 0xdb, 0x91, 0x04, 0xbd, 0x4c, 0xe1,
-	0xd3, 0x52, 0xb9, 0xd8, 0x03, 0x60, 0xf6, 0x84, 0xcf, 0x02, 0x5a, 0x4c, 0x5c, 0x69, 0x6b, 0x7c,
-	0x4c, 0x4b, 0x6a, 0x05, 0x86, 0x3d, 0x82, 0xf5, 0xc0, 0x72, 0x4d, 0x7d, 0x1a, 0x08, 0x7d, 0xe0,
-	0x31, 0xb5, 0x53, 0x30, 0xcf, 0xf8, 0x16, 0x98, 0x56, 0x45, 0xaa, 0x83, 0x80, 0x2b, 0x06, 0x8f,
-	0x00, 0x57, 0xe7, 0x8b, 0x24, 0xd3, 0x27, 0x97, 0x64, 0x42, 0x2a, 0x29, 0x13, 0x2e, 0x5d, 0x3d,
-	0x70, 0x8d, 0x59, 0x70, 0xe2, 0x85, 0x8d, 0x4f, 0x65, 0x69, 0x3d, 0x10, 0x50, 0xad, 0x86, 0x44,
-	0x51, 0x4a, 0xfd, 0x45, 0x01, 0xca, 0x91, 0x16, 0xc9, 0xaa, 0x50, 0x3a, 0xea, 0x3d, 0xed, 0xf5,
-	0x9f, 0xf7, 0x94, 0x35, 0x56, 0x07, 0xa0, 0x50, 0x6e, 0x7d, 0xd0, 0x6a, 0xf6, 0xf8, 0x15, 0x07,
-	0x0a, 0x20, 0xe7, 0xe9, 0x2c, 0xdb, 0x84, 0xf5, 0xbd, 0xa3, 0x1e, 0x85, 0xfe, 0x70, 0x50, 0x0e,
-	0x41, 0xed, 0x2f, 0xb8, 0xe7, 0x97, 0x83, 0xf2, 0x08, 0x3a, 0x68, 0x0e, 0xdb, 0x5a, 0x27, 0x02,
-	0x15, 0x28, 0x8a, 0xa8, 0x7f, 0xa4, 0xb5, 0x44, 0x49, 0x45, 0xac, 0xf6, 0x50, 0xeb, 0x7f, 0xde,
-	0x6e, 0x0d, 0x15, 0x60, 0x57, 0x61, 0x33, 0x2e, 0x23, 0x2a, 0x5f, 0xa9, 0xb2, 0x1a, 0x94, 0xa3,
-	0x72, 0x94, 0x2d, 0x2c, 0x55, 0x6b, 0xb7, 0x8e, 0xb4, 0x41, 0xe7, 0x59, 0x5b, 0x6f, 0x0d, 0xdb,
-	0xca, 0x55, 0x56, 0x86, 0xfc, 0xa0, 0xd3, 0x7b, 0xaa, 0x5c, 0x63, 0xeb, 0x50, 0xc1, 0x2f, 0x5e,
-	0xfa, 0x75, 0xc6, 0xa0, 0x9e, 0xd0, 0x12, 0xac, 0x41, 0x4e, 0xe9, 0x27, 0x4f, 0x94, 0xdb, 0x58,
-	0xec, 0x6e, 0x67, 0x30, 0xec, 0xf4, 0x5a, 0x43, 0xe5, 0x0e, 0x03, 0x28, 0xee, 0x75, 0xba, 0xc3,
-	0xb6, 0xa6, 0x6c, 0x63, 0x79, 0x9f, 0xf7, 0x3b, 0x3d, 0xe5, 0x35, 0x0a, 0x90, 0x6f, 0x1e, 0x1c,
-	0x76, 0xdb, 0x8a, 0x4a, 0xb5, 0xf4, 0xb5, 0xa1, 0xf2, 0x3a, 0xab, 0x40, 0xe1, 0xa8, 0x87, 0x6d,
-	0xbb, 0x8b, 0x15, 0xd2, 0xa7, 0xde, 0xec, 0x76, 0x95, 0x37, 0x24, 0xef, 0xf5, 0x9b, 0xf8, 0xfd,
-	0xbc, 0xd3, 0xdb, 0xed, 0x3f, 0x57, 0xde, 0x42, 0xb2, 0x1d, 0xad, 0xdf, 0xdc, 0x6d, 0x35, 0x07,
-	0x43, 0xe5, 0x1e, 0x16, 0x30, 0x38, 0xec, 0x76, 0x86, 0xca, 0xdb, 0x48, 0xf5, 0xa4, 0x39, 0xdc,
-	0x6f, 0x6b, 0xca, 0x7d, 0xfc, 0x6e, 0x0e, 0x06, 0x6d, 0x6d, 0xa8, 0x3c, 0xc4, 0xef, 0x4e, 0x8f,
-	0xbe, 0x1f, 0xe1, 0xf7, 0x6e, 0xbb, 0xdb, 0x1e, 0xb6, 0x95, 0x0f, 0x70, 0xc0, 0xb4, 0xf6, 0x61,
-	0xb7, 0xd9, 0x6a, 0x2b, 0x1f, 0x62, 0xa2, 0xdb, 0x6f, 0x3d, 0xd5, 0xfb, 0x87, 0xca, 0x47, 0x58,
-	0x07, 0xf9, 0xde, 0x07, 0x38, 0x98, 0x1f, 0xe3, 0x38, 0xc5, 0x49, 0x6a, 0xdd, 0x63, 0xac, 0xf6,
-	0xa0, 0xd3, 0x3b, 0x1a, 0x28, 0x9f, 0x20, 0x31, 0x7d, 0x12, 0xe6, 0x53, 0xb6, 0x05, 0x4a, 0xbf,
-	0xa7, 0xef, 0x1e, 0x1d, 0x76, 0x3b, 0xad, 0xe6, 0xb0, 0xad, 0x3f, 0x6d, 0x7f, 0xa9, 0xfc, 0x16,
-	0x4e, 0xfb, 0xa1, 0xd6, 0xd6, 0x45, 0x3b, 0x7e, 0x14, 0xa5, 0x45, 0x5b, 0x7e, 0x8c, 0x55, 0x24,
-	0x78, 0xfd, 0xe8, 0xa9, 0xf2, 0xdb, 0x0b, 0xa0, 0xc1, 0x53, 0xe5, 0x27, 0x38, 0xe7, 0xc3, 0xce,
-	0x41, 0x5b, 0x17, 0x83, 0xf1, 0x19, 0x0e, 0xe4, 0x5e, 0xa7, 0xdb, 0x55, 0x9a, 0xe4, 0x68, 0x6d,
-	0x6a, 0xc3, 0x0e, 0x4d, 0xf4, 0x0e, 0x53, 0xa0, 0xb6, 0x77, 0xf4, 0xb3, 0x9f, 0x7d, 0xa9, 0x8b,
-	0x99, 0x68, 0xa9, 0xbf, 0x0b, 0xe5, 0xc8, 0x5c, 0xc0, 0xd6, 0x77, 0x7a, 0xbd, 0xb6, 0xa6, 0xac,
-	0x61, 0x09, 0xdd, 0xf6, 0xde, 0x50, 0xc9, 0x90, 0x33, 0xb9, 0xf3, 0x64, 0x7f, 0xa8, 0x64, 0xf1,
-	0xb3, 0x7f, 0x84, 0xd9, 0x72, 0x34, 0x55, 0xed, 0x83, 0x8e, 0x92, 0xc7, 0xaf, 0x66, 0x6f, 0xd8,
-	0x51, 0x0a, 0x34, 0x95, 0x9d, 0xde, 0x93, 0x6e, 0x5b, 0x29, 0x22, 0xf4, 0xa0, 0xa9, 0x3d, 0x55,
-	0x4a, 0x98, 0xa9, 0x79, 0x78, 0xd8, 0xfd, 0x52, 0x29, 0xf3, 0xf2, 0x77, 0xdb, 0x5f, 0x28, 0x15,
-	0x56, 0x84, 0x6c, 0xf7, 0xa1, 0x02, 0xea, 0x3d, 0x28, 0x35, 0x8f, 0x8f, 0x0f, 0xd0, 0x1a, 0xc3,
-	0x46, 0x1f, 0x75, 0xbb, 0xfc, 0xee, 0xcf, 0x4e, 0x7f, 0x38, 0xec, 0x1f, 0x28, 0x19, 0x5c, 0x4c,
-	0xc3, 0xfe, 0xa1, 0x92, 0x55, 0x3b, 0x50, 0x8e, 0xb8, 0xa4, 0x74, 0x0f, 0xa3, 0x0c, 0xf9, 0x43,
-	0xad, 0xfd, 0x8c, 0x9f, 0x80, 0xf4, 0xda, 0x5f, 0x60, 0x33, 0xf1, 0x0b, 0x0b, 0xca, 0x61, 0x85,
-	0xfc, 0xc2, 0x04, 0x5d, 0xc4, 0xe8, 0x76, 0x7a, 0xed, 0xa6, 0xa6, 0x14, 0xd4, 0xbf, 0x0a, 0xe5,
-	0x68, 0x8b, 0xb2, 0xbb, 0x90, 0x1d, 0x0e, 0x84, 0x5b, 0x6c, 0xeb, 0x41, 0x72, 0x09, 0x76, 0x18,
-	0x7d, 0x69, 0xd9, 0xe1, 0x80, 0xbd, 0x03, 0x45, 0x7e, 0x05, 0x46, 0x38, 0x2c, 0xb7, 0xd2, 0xdb,
-	0x7e, 0x48, 0x38, 0x4d, 0xd0, 0xa8, 0x5d, 0xa8, 0xa7, 0x31, 0xec, 0x36, 0x00, 0xc7, 0x49, 0x16,
-	0xad, 0x04, 0x41, 0xdb, 0x50, 0x5c, 0xb1, 0xd9, 0x15, 0xb1, 0x3a, 0x71, 0x5a, 0xfd, 0x1f, 0x19,
-	0x80, 0x44, 0x46, 0xa2, 0x14, 0x8e, 0xed, 0xd5, 0x82, 0x70, 0xd3, 0xcb, 0xe1, 0xf7, 0x15, 0x7e,
-	0x0c, 0xc6, 0xae, 0x41, 0x71, 0xe2, 0xf9, 0x53, 0x23, 0x8c, 0x2e, 0xd8, 0xf0, 0x14, 0x6a, 0xa4,
-	0xdc, 0x3b, 0x8c, 0xca, 0x80, 0x6b, 0xf1, 0x28, 0xb2, 0xbc, 0x56, 0x13, 0xc0, 0x2e, 0xc2, 0x50,
-	0x5d, 0xb4, 0xdc, 0xb1, 0xe3, 0x05, 0x96, 0x89, 0xe6, 0x50, 0x81, 0x24, 0x3e, 0x44, 0xa0, 0x9d,
-	0x0b, 0xde, 0x21, 0x7f, 0x6a, 0xbb, 0x46, 0x68, 0x99, 0x22, 0x94, 0x45, 0x82, 0xb0, 0x57, 0xa0,
-	0xf2, 0x55, 0xe0, 0x09, 0x0b, 0x95, 0x07, 0xf0, 0x94, 0x11, 0x40, 0xd3, 0xf7, 0x2a, 0x80, 0x15,
-	0x8c, 0x8d, 0x19, 0x2f, 0xbc, 0x4c, 0x85, 0x57, 0x04, 0x64, 0xe7, 0x42, 0xfd, 0xa7, 0x39, 0x80,
-	0x44, 0xc7, 0x4a, 0x79, 0xa5, 0x33, 0x69, 0xaf, 0xf4, 0x43, 0xb8, 0x26, 0x82, 0xcb, 0x45, 0xc4,
-	0xf2, 0xb9, 0x6e, 0xbb, 0xfa, 0xc8, 0x88, 0x0e, 0x00, 0x98, 0xc0, 0xf2, 0x83, 0xee, 0x8e, 0xbb,
-	0x63, 0x84, 0xec, 0x31, 0x6c, 0xc8, 0x79, 0xc2, 0x8b, 0x59, 0xfa, 0x00, 0x43, 0x8a, 0xd5, 0x5f,
-	0x4f, 0xb2, 0x0f, 0x2f, 0x66, 0xec, 0x3d, 0xb8, 0xea, 0x5b, 0x13, 0xdf, 0x0a, 0x4e, 0xf4, 0x30,
-	0x90, 0x2b, 0xe3, 0xa7, 0xea, 0x9b, 0x02, 0x39, 0x0c, 0xe2, 0xba, 0xde, 0x83, 0xab, 0x42, 0xfb,
-	0x5a, 0x68, 0x1e, 0xbf, 0x00, 0xb7, 0xc9, 0x91, 0x72, 0xeb, 0x5e, 0x05, 0x10, 0x8a, 0x67, 0x74,
-	0xed, 0xb9, 0xac, 0x55, 0xb8, 0x92, 0x89, 0x96, 0xc2, 0x3b, 0xc0, 0xec, 0x40, 0x5f, 0xf0, 0x68,
-	0x0a, 0x37, 0xbf, 0x62, 0x07, 0x87, 0x29, 0x6f, 0xe6, 0x65, 0xce, 0xd2, 0xf2, 0x65, 0xce, 0xd2,
-	0x2d, 0x28, 0x90, 0x6e, 0x2a, 0x7c, 0x97, 0x3c, 0xc1, 0x54, 0xc8, 0xe3, 0xfe, 0x24, 0x17, 0x5b,
-	0xfd, 0x61, 0xfd, 0x01, 0x5d, 0x1a, 0xc7, 0xf9, 0x41, 0xa8, 0x46, 0x38, 0xf5, 0x0f, 0x32, 0x50,
-	0x4f, 0xeb, 0x53, 0x3c, 0x72, 0x2c, 0x09, 0x89, 0x2b, 0x24, 0x61, 0x70, 0xaf, 0x40, 0x65, 0x76,
-	0x2a, 0xe2, 0xdf, 0xa2, 0xf3, 0xd6, 0xd9, 0x29, 0x8f, 0x7b, 0x63, 0x6f, 0x43, 0x69, 0x76, 0xca,
-	0x97, 0xcd, 0x65, 0xd3, 0x52, 0x9c, 0xf1, 0x90, 0x94, 0xb7, 0xa1, 0x34, 0x17, 0xa4, 0xf9, 0xcb,
-	0x48, 0xe7, 0x44, 0xaa, 0x6e, 0x43, 0x4d, 0xb6, 0x60, 0x98, 0x02, 0x39, 0xd4, 0x7b, 0x78, 0xc3,
-	0xf0, 0x13, 0x7b, 0x50, 0x93, 0x4d, 0x95, 0x6f, 0xe3, 0x30, 0x4f, 0x59, 0xef, 0xd9, 0x97, 0x58,
-	0xef, 0xdb, 0x74, 0xb0, 0xae, 0x53, 0x84, 0xcc, 0xd8, 0x8b, 0x2e, 0xc5, 0xc2, 0x89, 0x11, 0x34,
-	0xe7, 0xa1, 0xd7, 0xf2, 0x1c, 0x71, 0x74, 0x23, 0x42, 0x8e, 0xf3, 0x91, 0xf7, 0x4d, 0xc4, 0x14,
-	0xff, 0xa3, 0x0c, 0x6c, 0x2e, 0xa9, 0xea, 0xd8, 0x8f, 0xe4, 0x66, 0x3b, 0x7e, 0xa2, 0xed, 0x3c,
-	0x35, 0xc2, 0xf1, 0x89, 0x3e, 0xf3, 0xad, 0x89, 0x7d, 0x1e, 0x5d, 0xcf, 0x27, 0xd8, 0x21, 0x81,
-	0xe8, 0x1c, 0x6b, 0x36, 0x23, 0x03, 0x65, 0x6a, 0x87, 0xe2, 0x1a, 0x2a, 0x10, 0xa8, 0x4b, 0x9e,
-	0x8b, 0xe8, 0x8c, 0x3b, 0x7f, 0xc9, 0x19, 0x77, 0x03, 0x4a, 0x27, 0x86, 0x6b, 0x3a, 0xc2, 0x47,
-	0x5c, 0xd6, 0xa2, 0xa4, 0x7a, 0x0b, 0x8a, 0x9d, 0xd8, 0x58, 0x88, 0xef, 0xb0, 0xe6, 0xc4, 0xbd,
-	0x55, 0x0f, 0x2a, 0x2d, 0xba, 0x03, 0x7b, 0x60, 0xcc, 0xd8, 0x7d, 0xc8, 0x4d, 0x8d, 0x99, 0x38,
-	0x97, 0x6f, 0xc4, 0x2e, 0x3b, 0x8e, 0x7d, 0x70, 0x60, 0xcc, 0xf8, 0x01, 0x17, 0x12, 0xdd, 0xfc,
-	0x08, 0xca, 0x11, 0xe0, 0x3b, 0x05, 0xe9, 0xfc, 0xd7, 0x1c, 0x54, 0x76, 0x65, 0xb7, 0x02, 0x6a,
-	0x70, 0xa1, 0x3f, 0x77, 0xd1, 0xfa, 0x13, 0x0e, 0xce, 0xea, 0xd8, 0x70, 0x87, 0x02, 0x14, 0x4d,
-	0x7a, 0xf6, 0x57, 0x4c, 0xfa, 0x2d, 0x00, 0x9f, 0xd4, 0x66, 0xd2, 0x9c, 0x73, 0x71, 0xb8, 0x40,
-	0xc7, 0x44, 0xc5, 0x79, 0xe5, 0x19, 0x4a, 0xfe, 0xdb, 0x9f, 0xa1, 0x14, 0x56, 0x9e, 0xa1, 0xfc,
-	0x3f, 0x73, 0xea, 0xf1, 0x66, 0xc2, 0x3c, 0x4f, 0xad, 0x0b, 0x22, 0xab, 0xf0, 0x90, 0xa2, 0x59,
-	0x1c, 0x5d, 0x88, 0x74, 0x9f, 0x42, 0x3d, 0x1a, 0x66, 0xd1, 0x31, 0x48, 0x05, 0x34, 0x0a, 0x1c,
-	0xf7, 0x98, 0xac, 0x87, 0x72, 0x32, 0xbd, 0xab, 0xaa, 0xbf, 0x7a, 0x57, 0xa9, 0x7f, 0x9a, 0x85,
-	0xc2, 0x4f, 0xe7, 0x96, 0x7f, 0xc1, 0x3e, 0x82, 0x4a, 0x10, 0x4e, 0x43, 0xd9, 0x99, 0x7b, 0x83,
-	0x67, 0x23, 0x3c, 0xf9, 0x62, 0xad, 0xa9, 0xe5, 0x86, 0xdc, 0xce, 0x42, 0x5a, 0xe2, 0x22, 0x5b,
-	0x50, 0x08, 0x42, 0x6b, 0xc6, 0x9d, 0xc7, 0x05, 0x8d, 0x27, 0xd8, 0x36, 0x14, 0x5c, 0xcf, 0xb4,
-	0x82, 0xf4, 0xd1, 0x30, 0xea, 0xf2, 0x1a, 0x47, 0x30, 0x15, 0x8a, 0xe2, 0xee, 0x43, 0x7e, 0xd9,
-	0xa1, 0xca, 0x31, 0x14, 0xb5, 0x65, 0x19, 0x68, 0x00, 0x46, 0x57, 0x5c, 0xe2, 0x34, 0x6e, 0x22,
-	0xc7, 0x33, 0xcc, 0xa1, 0x71, 0x1c, 0x5d, 0x01, 0x13, 0x49, 0x94, 0xad, 0xa6, 0x15, 0x5a, 0xe3,
-	0x70, 0xf0, 0xb5, 0x13, 0x4d, 0x99, 0x04, 0x51, 0x4d, 0x58, 0x4f, 0x75, 0x26, 0x6d, 0x59, 0xa0,
-	0x16, 0xd6, 0xee, 0xa2, 0x86, 0x9a, 0x91, 0x54, 0xdc, 0xac, 0xac, 0xd6, 0xe6, 0x24, 0x7d, 0x97,
-	0x34, 0xa3, 0xa3, 0xc3, 0xdd, 0xe6, 0xb0, 0xad, 0x14, 0x48, 0x7f, 0x6d, 0x6b, 0x4f, 0xda, 0x4a,
-	0x51, 0xfd, 0xc3, 0x2c, 0x6c, 0x0e, 0x7d, 0xc3, 0x0d, 0x0c, 0x1e, 0x95, 0xec, 0x86, 0xbe, 0xe7,
-	0xb0, 0x4f, 0xa1, 0x1c, 0x8e, 0x1d, 0x79, 0x90, 0xef, 0x44, 0x53, 0xba, 0x40, 0xfa, 0x60, 0x38,
-	0xe6, 0x26, 0x6d, 0x29, 0xe4, 0x1f, 0xec, 0x87, 0x50, 0x18, 0x59, 0xc7, 0xb6, 0x2b, 0xb6, 0xd7,
-	0xd5, 0xc5, 0x8c, 0x3b, 0x88, 0xdc, 0x5f, 0xd3, 0x38, 0x15, 0x7b, 0x0f, 0x8a, 0x63, 0x6f, 0x1a,
-	0x71, 0xa8, 0x24, 0x80, 0x52, 0xaa, 0x08, 0xb1, 0xfb, 0x6b, 0x9a, 0xa0, 0x63, 0x1f, 0x41, 0xd9,
-	0xf7, 0x1c, 0x67, 0x64, 0x8c, 0x4f, 0x05, 0xef, 0x6a, 0x2c, 0xe6, 0xd1, 0x04, 0x7e, 0x7f, 0x4d,
-	0x8b, 0x69, 0xd5, 0x07, 0x50, 0x12, 0x8d, 0xc5, 0x01, 0xd8, 0x69, 0x3f, 0xe9, 0x88, 0x81, 0x6c,
-	0xf5, 0x0f, 0x0e, 0x3a, 0x43, 0x7e, 0x53, 0x43, 0xeb, 0x77, 0xbb, 0x3b, 0xcd, 0xd6, 0x53, 0x25,
-	0xbb, 0x53, 0x86, 0xa2, 0x41, 0x41, 0x7f, 0xea, 0xdf, 0xcc, 0xc0, 0xc6, 0x42, 0x07, 0xd8, 0x63,
-	0xc8, 0x4f, 0x51, 0x5e, 0xf2, 0xe1, 0xb9, 0xbb, 0xb2, 0x97, 0x52, 0x9a, 0x4b, 0x51, 0xcc, 0xa1,
-	0x7e, 0x02, 0xf5, 0x34, 0x5c, 0x52, 0x74, 0xd7, 0xa1, 0xa2, 0xb5, 0x9b, 0xbb, 0x7a, 0xbf, 0xd7,
-	0xfd, 0x92, 0xdb, 0x8b, 0x94, 0x7c, 0xae, 0x75, 0x86, 0x6d, 0x25, 0xab, 0xfe, 0x0e, 0x28, 0x8b,
-	0x03, 0xc3, 0x9e, 0xc0, 0xc6, 0xd8, 0x43, 0x8b, 0x9f, 0xb3, 0x81, 0x64, 0xca, 0x6e, 0xaf, 0x18,
-	0x49, 0x41, 0x46, 0x33, 0x56, 0x1f, 0xa7, 0xd2, 0xea, 0x5f, 0x01, 0xb6, 0x3c, 0x82, 0xbf, 0xb9,
-	0xe2, 0xff, 0x67, 0x06, 0xf2, 0x87, 0x8e, 0xe1, 0xb2, 0xd7, 0xa1, 0x40, 0xb7, 0x70, 0x85, 0xd0,
-	0xad, 0x4a, 0xdb, 0x17, 0x97, 0x05, 0xe1, 0xd8, 0x0f, 0x20, 0x17, 0x8e, 0xa3, 0x5b, 0x29, 0xd7,
-	0x2f, 0x59, 0x7c, 0xfb, 0x6b, 0x1a, 0x52, 0xb1, 0x7b, 0x90, 0x33, 0xcd, 0x28, 0x42, 0x45, 0x28,
-	0xed, 0xbb, 0x46, 0x68, 0xec, 0x5a, 0x13, 0xdb, 0xb5, 0xc5, 0xad, 0x61, 0x24, 0x61, 0x6f, 0x40,
-	0xce, 0x1c, 0x3b, 0xe9, 0x70, 0x23, 0xa4, 0x94, 0x0a, 0x34, 0xc7, 0x0e, 0x53, 0x61, 0x3d, 0xf4,
-	0x2f, 0x74, 0x7f, 0xee, 0xd2, 0x09, 0x67, 0x20, 0x34, 0xb9, 0x2a, 0x8a, 0xaa, 0x39, 0x1d, 0x07,
-	0x06, 0x22, 0xba, 0x75, 0xe6, 0x5b, 0x33, 0xc3, 0x8f, 0x75, 0x38, 0x3b, 0x38, 0xe4, 0x80, 0x9d,
-	0x22, 0xd0, 0x63, 0x3d, 0xea, 0x3b, 0x74, 0x23, 0x15, 0x75, 0x1e, 0x35, 0xfa, 0x5a, 0x71, 0x79,
-	0x40, 0x60, 0xd4, 0x3f, 0xcb, 0x41, 0x55, 0x6a, 0x0f, 0xfb, 0x00, 0xca, 0x66, 0x7a, 0x23, 0xde,
-	0x58, 0x6a, 0xf4, 0x83, 0xdd, 0x68, 0x0b, 0x9a, 0x62, 0x79, 0x7f, 0x02, 0xeb, 0x81, 0x15, 0xea,
-	0x2f, 0x0c, 0xdf, 0x46, 0x0e, 0x1a, 0x88, 0x61, 0x14, 0xae, 0xda, 0x81, 0x15, 0x3e, 0x8b, 0x30,
-	0xfb, 0x6b, 0x5a, 0x2d, 0x90, 0xd2, 0xa4, 0x98, 0x89, 0x2e, 0xe5, 0x52, 0x4f, 0x09, 0x70, 0xe0,
-	0xfe, 0x9a, 0x16, 0xe1, 0x91, 0xd4, 0x3a, 0xb7, 0xc6, 0xf3, 0x30, 0x52, 0xcc, 0xd6, 0xa3, 0x0e,
-	0x11, 0x90, 0x5e, 0x2d, 0xe1, 0x9f, 0xec, 0x21, 0xf2, 0x3a, 0xc3, 0x71, 0x3c, 0x92, 0xc8, 0x05,
-	0xd9, 0x2f, 0xb8, 0x1b, 0xc3, 0xf9, 0x2b, 0x29, 0x51, 0x8a, 0xbd, 0x09, 0x05, 0x2f, 0x3c, 0xb1,
-	0x7c, 0x71, 0x2f, 0x30, 0xba, 0xdb, 0x8a, 0xa0, 0xdd, 0x56, 0x17, 0x57, 0x0a, 0xa1, 0xd5, 0x5f,
-	0x64, 0xa0, 0x24, 0x46, 0x00, 0xad, 0xe6, 0x41, 0x7b, 0xa8, 0x3f, 0x6b, 0x6a, 0x9d, 0xe6, 0x4e,
-	0xb7, 0x2d, 0xa2, 0xa4, 0x9e, 0x68, 0xcd, 0x9e, 0xe0, 0x93, 0x5a, 0xfb, 0x59, 0xff, 0x69, 0x9b,
-	0x5b, 0x8f, 0xbb, 0xed, 0xde, 0x97, 0x4a, 0x8e, 0x7b, 0x4e, 0xda, 0x87, 0x4d, 0x0d, 0xb9, 0x64,
-	0x15, 0x4a, 0xed, 0x2f, 0xda, 0xad, 0x23, 0x62, 0x93, 0x75, 0x80, 0xdd, 0x76, 0xb3, 0xdb, 0xed,
-	0xa3, 0x29, 0xaf, 0x14, 0x19, 0x83, 0x7a, 0x4b, 0x6b, 0xa3, 0x59, 0xdf, 0x6c, 0xb5, 0xfa, 0x47,
-	0xbd, 0xa1, 0x52, 0xc2, 0x1a, 0x9b, 0x68, 0x63, 0xc7, 0x20, 0x7a, 0x00, 0x60, 0x57, 0xeb, 0x1f,
-	0xc6, 0x90, 0xca, 0x4e, 0x05, 0x95, 0x64, 0x9a, 0x2b, 0xf5, 0x0f, 0xea, 0x50, 0x4f, 0x2f, 0x4d,
-	0xf6, 0x31, 0x94, 0x4d, 0x33, 0x35, 0xc7, 0xb7, 0x56, 0x2d, 0xe1, 0x07, 0xbb, 0x66, 0x34, 0xcd,
-	0xfc, 0x83, 0xbd, 0x16, 0x6d, 0xa4, 0xec, 0xd2, 0x46, 0x8a, 0xb6, 0xd1, 0x4f, 0x60, 0x43, 0xdc,
-	0x1e, 0x45, 0x6b, 0x71, 0x64, 0x04, 0x56, 0x7a, 0x97, 0xb4, 0x08, 0xb9, 0x2b, 0x70, 0xfb, 0x6b,
-	0x5a, 0x7d, 0x9c, 0x82, 0xb0, 0x1f, 0x41, 0xdd, 0x20, 0xd3, 0x26, 0xce, 0x9f, 0x97, 0x45, 0x7c,
-	0x13, 0x71, 0x52, 0xf6, 0x75, 0x43, 0x06, 0xe0, 0x42, 0x34, 0x7d, 0x6f, 0x96, 0x64, 0x2e, 0xc8,
-	0x0b, 0x71, 0xd7, 0xf7, 0x66, 0x52, 0xde, 0x9a, 0x29, 0xa5, 0xd9, 0x47, 0x50, 0x13, 0x2d, 0x4f,
-	0x8c, 0xa4, 0x78, 0xcb, 0xf2, 0x66, 0x93, 0xa2, 0xb0, 0xbf, 0xa6, 0x55, 0xc7, 0x49, 0x92, 0x3d,
-	0x82, 0x2a, 0x6f, 0x70, 0x62, 0x34, 0xc5, 0x6b, 0x8d, 0x5a, 0x1b, 0xe5, 0x02, 0x23, 0x4e, 0xb1,
-	0xf7, 0x00, 0xa8, 0x9d, 0x3c, 0x4f, 0x39, 0x75, 0x74, 0xe5, 0x7b, 0xb3, 0x28, 0x4b, 0xc5, 0x8c,
-	0x12, 0x52, 0xf3, 0x78, 0x1c, 0x7f, 0x65, 0xb9, 0x79, 0x14, 0x72, 0x9e, 0x34, 0x8f, 0xc7, 0xed,
-	0xc7, 0xcd, 0xe3, 0xd9, 0x60, 0xa9, 0x79, 0x51, 0x2e, 0xde, 0x3c, 0x9e, 0x29, 0x6a, 0x1e, 0xcf,
-	0x53, 0x5d, 0x6c, 0x5e, 0x94, 0x85, 0x9a, 0xc7, 0x73, 0xfc, 0x68, 0x49, 0x33, 0xab, 0x5d, 0xaa,
-	0x99, 0xe1, 0xb4, 0xa5, 0x75, 0xb3, 0x1f, 0x41, 0x3d, 0x38, 0xf1, 0xce, 0x24, 0x06, 0xb2, 0x2e,
-	0xe7, 0x1e, 0x9c, 0x78, 0x67, 0x32, 0x07, 0x59, 0x0f, 0x64, 0x00, 0xb6, 0x96, 0x77, 0x91, 0x6e,
-	0xea, 0xd4, 0xe5, 0xd6, 0x52, 0x0f, 0x9f, 0xd9, 0xd6, 0x19, 0xb6, 0xd6, 0x88, 0x12, 0x38, 0x28,
-	0x89, 0x39, 0x1c, 0x88, 0xb3, 0xd6, 0xd4, 0x31, 0x8c, 0xa8, 0x09, 0x62, 0x1b, 0x39, 0xc0, 0xb5,
-	0x35, 0x77, 0xe5, 0x6c, 0x8a, 0xbc, 0xb6, 0x8e, 0xdc, 0x54, 0xc6, 0x1a, 0x27, 0x15, 0x59, 0x93,
-	0x5d, 0x11, 0x58, 0x5f, 0xcf, 0x2d, 0x77, 0x6c, 0x89, 0x53, 0xd9, 0xd4, 0xae, 0x18, 0x08, 0x5c,
-	0xb2, 0x2b, 0x22, 0x48, 0xbc, 0xae, 0xe3, 0xec, 0x6c, 0x71, 0x5d, 0x4b, 0x99, 0x69, 0x5d, 0xc7,
-	0x59, 0xe3, 0x0d, 0x15, 0xe7, 0xbd, 0xb2, 0xb4, 0xa1, 0xa4, 0xcc, 0x7c, 0x43, 0xc5, 0xb9, 0x1f,
-	0x81, 0x58, 0x4d, 0x7c, 0x70, 0x53, 0x67, 0xb7, 0xbc, 0xd5, 0x62, 0x74, 0x61, 0x1c, 0xa7, 0xd4,
-	0xbf, 0x57, 0x80, 0x92, 0x60, 0x1e, 0xec, 0x0a, 0x6c, 0x08, 0x1e, 0xb6, 0xdb, 0x1c, 0x36, 0x77,
-	0x9a, 0x03, 0xd4, 0x3a, 0x18, 0xd4, 0x39, 0x13, 0x8b, 0x61, 0x19, 0x64, 0x6c, 0xc4, 0xc5, 0x62,
-	0x50, 0x16, 0x19, 0x9b, 0xc8, 0xcb, 0x5f, 0x41, 0xc9, 0xb1, 0x0d, 0xa8, 0xf2, 0x8c, 0x1c, 0x40,
-	0x51, 0xc8, 0x94, 0x8b, 0xa7, 0x0b, 0x52, 0x16, 0xee, 0x12, 0x2c, 0x26, 0x59, 0x38, 0xa0, 0x14,
-	0x67, 0xe1, 0xe9, 0x32, 0x36, 0x66, 0xa8, 0x1d, 0xf5, 0x5a, 0x49, 0x3d, 0x15, 0x8a, 0x1c, 0xe5,
-	0xc5, 0x3c, 0xeb, 0xb4, 0x9f, 0x2b, 0x80, 0x99, 0x78, 0x29, 0x94, 0xae, 0xa2, 0xde, 0x44, 0x85,
-	0x50, 0xb2, 0xc6, 0xae, 0xc3, 0x95, 0xc1, 0x7e, 0xff, 0xb9, 0xce, 0x33, 0xc5, 0x5d, 0x58, 0x67,
-	0x5b, 0xa0, 0x48, 0x08, 0x5e, 0x7c, 0x1d, 0xab, 0x24, 0x68, 0x44, 0x38, 0x50, 0x36, 0xc8, 0xc3,
-	0x8e, 0xb0, 0x21, 0x17, 0x24, 0x0a, 0x76, 0x85, 0x67, 0xed, 0x77, 0x8f, 0x0e, 0x7a, 0x03, 0x65,
-	0x13, 0x1b, 0x41, 0x10, 0xde, 0x72, 0x16, 0x17, 0x93, 0x88, 0x9f, 0x2b, 0x24, 0x91, 0x10, 0xf6,
-	0xbc, 0xa9, 0xf5, 0x3a, 0xbd, 0x27, 0x03, 0x65, 0x2b, 0x2e, 0xb9, 0xad, 0x69, 0x7d, 0x6d, 0xa0,
-	0x5c, 0x8d, 0x01, 0x83, 0x61, 0x73, 0x78, 0x34, 0x50, 0xae, 0xc5, 0xad, 0x3c, 0xd4, 0xfa, 0xad,
-	0xf6, 0x60, 0xd0, 0xed, 0x0c, 0x86, 0xca, 0x75, 0x76, 0x15, 0x36, 0x93, 0x16, 0x45, 0xc4, 0x0d,
-	0xa9, 0xa1, 0xda, 0x93, 0xf6, 0x50, 0xb9, 0x11, 0x37, 0xa3, 0xd5, 0xef, 0x76, 0x9b, 0xe4, 0x11,
-	0xbe, 0x89, 0x44, 0xe4, 0xe0, 0x16, 0xbd, 0x79, 0x05, 0xdb, 0x75, 0xd4, 0x93, 0x41, 0xb7, 0xa4,
-	0xa5, 0x31, 0x68, 0xff, 0xf4, 0xa8, 0xdd, 0x6b, 0xb5, 0x95, 0x57, 0x93, 0xa5, 0x11, 0xc3, 0x6e,
-	0xc7, 0x4b, 0x23, 0x06, 0xdd, 0x89, 0xeb, 0x8c, 0x40, 0x03, 0x65, 0x1b, 0xcb, 0x13, 0xed, 0xe8,
-	0xf5, 0xda, 0xad, 0x21, 0xf6, 0xf5, 0xb5, 0x78, 0x14, 0x8f, 0x0e, 0x9f, 0x68, 0xcd, 0xdd, 0xb6,
-	0xa2, 0xee, 0xd4, 0xe8, 0xbd, 0x34, 0x21, 0xe4, 0xd4, 0xcf, 0x81, 0xc9, 0x0f, 0x0f, 0x89, 0x47,
-	0x10, 0x18, 0xe4, 0x27, 0xbe, 0x37, 0x8d, 0x6e, 0xdd, 0xe0, 0x37, 0xdb, 0x86, 0xea, 0x6c, 0x3e,
-	0xa2, 0x23, 0xde, 0x24, 0x08, 0x5f, 0x06, 0xa9, 0xff, 0x24, 0x03, 0xf5, 0xb4, 0x80, 0x43, 0xc5,
-	0xce, 0x9e, 0xe8, 0xae, 0x17, 0xf2, 0x8b, 0xfa, 0x41, 0xe4, 0x1d, 0xb0, 0x27, 0x3d, 0x2f, 0xa4,
-	0x9b, 0xfa, 0x64, 0xce, 0xc5, 0xf2, 0x8a, 0x97, 0x1a, 0xa7, 0x59, 0x07, 0xae, 0xa4, 0xde, 0x65,
-	0x4a, 0x3d, 0x93, 0xd0, 0x88, 0x5f, 0x99, 0x59, 0x68, 0xbf, 0xc6, 0x82, 0xe5, 0x3e, 0x29, 0x90,
-	0x0b, 0xbe, 0x76, 0xc4, 0x7d, 0x4b, 0xfc, 0x54, 0xf7, 0x61, 0x3d, 0x25, 0x4f, 0xc9, 0x55, 0x34,
-	0x49, 0xb7, 0xb4, 0x6c, 0x4f, 0x5e, 0xde, 0x4c, 0xf5, 0x8f, 0x32, 0x50, 0x93, 0xa5, 0xeb, 0xf7,
-	0x2e, 0x89, 0x42, 0x35, 0xc5, 0xb7, 0x6e, 0x9b, 0xd1, 0x05, 0xfd, 0x08, 0xd4, 0xa1, 0x77, 0x22,
-	0xb9, 0x2f, 0x6b, 0xef, 0x74, 0x10, 0x77, 0x47, 0x06, 0xa1, 0xa1, 0x4b, 0x41, 0xd8, 0x7b, 0x4f,
-	0x91, 0x40, 0x04, 0x7b, 0x26, 0x10, 0xf5, 0x0e, 0x54, 0xf6, 0x4e, 0xa3, 0xb7, 0x22, 0xe4, 0xe7,
-	0x2a, 0x2a, 0xfc, 0xe6, 0x86, 0xfa, 0xc7, 0x19, 0xa8, 0x27, 0x57, 0x10, 0x29, 0xc4, 0x83, 0xbf,
-	0xe7, 0xc5, 0x97, 0x43, 0xd6, 0x1c, 0x25, 0x4f, 0x48, 0x66, 0xe5, 0x27, 0x24, 0x5f, 0x17, 0x85,
-	0xe5, 0x64, 0x19, 0x14, 0xd7, 0x25, 0xee, 0x85, 0x3c, 0x82, 0x1a, 0xfe, 0xd7, 0xac, 0x89, 0xe5,
-	0xfb, 0x56, 0xf4, 0xb4, 0xd9, 0x12, 0x71, 0x8a, 0x88, 0xec, 0x08, 0x6b, 0x22, 0xd4, 0x99, 0x95,
-	0xb7, 0x24, 0x11, 0xaf, 0xfe, 0x9d, 0x3c, 0x54, 0x25, 0x5d, 0xe5, 0x5b, 0x2d, 0xbf, 0x5b, 0x50,
-	0x49, 0xee, 0xdf, 0x89, 0x60, 0xfc, 0x18, 0x90, 0x9a, 0xab, 0xdc, 0xc2, 0x5c, 0x35, 0xa0, 0x24,
-	0x62, 0x41, 0x84, 0x2f, 0x2a, 0x4a, 0xa6, 0x9d, 0x2d, 0x85, 0x97, 0xb8, 0x30, 0xdf, 0x87, 0x9a,
-	0xf4, 0xea, 0x45, 0x74, 0x99, 0x77, 0x91, 0xbe, 0x9a, 0xbc, 0x80, 0x11, 0xb0, 0xab, 0x50, 0x9c,
-	0x9c, 0xea, 0xe6, 0x28, 0xf2, 0x63, 0x14, 0x26, 0xa7, 0xbb, 0x23, 0x72, 0x01, 0x4f, 0x62, 0xf1,
-	0x5c, 0xe6, 0x9e, 0x91, 0x49, 0x24, 0x84, 0xef, 0x41, 0x69, 0x72, 0xca, 0x63, 0xec, 0x2b, 0xf2,
-	0xc9, 0x6c, 0x32, 0xe4, 0xc5, 0xc9, 0x29, 0x05, 0xdc, 0x7f, 0x02, 0xca, 0x82, 0x9f, 0x2b, 0x10,
-	0x71, 0x66, 0x8b, 0x8d, 0xda, 0x48, 0xbb, 0xbc, 0x02, 0xf6, 0x2e, 0x6c, 0x09, 0x79, 0x69, 0x04,
-	0x3a, 0x8f, 0x53, 0xa4, 0x2b, 0x9d, 0xfc, 0xdd, 0x8b, 0x4d, 0x8e, 0x6b, 0x06, 0x03, 0xc2, 0xe0,
-	0x62, 0x55, 0xa1, 0x26, 0xad, 0x5d, 0x7e, 0x5f, 0xb6, 0xa2, 0xa5, 0x60, 0xec, 0x31, 0xd4, 0x26,
-	0xa7, 0x7c, 0x2d, 0x0c, 0xbd, 0x03, 0x4b, 0x44, 0x9c, 0x6d, 0x2d, 0xae, 0x02, 0x0a, 0x4c, 0x4a,
-	0x51, 0xaa, 0xff, 0x2a, 0x03, 0xf5, 0x44, 0x09, 0xc5, 0x1d, 0xca, 0xee, 0xcb, 0xaf, 0xf4, 0x35,
-	0x16, 0xf5, 0x54, 0x24, 0x79, 0x30, 0xbc, 0x98, 0xf1, 0x07, 0x85, 0x56, 0xdd, 0x62, 0x5e, 0xf5,
-	0x46, 0x49, 0x6e, 0xd5, 0x1b, 0x25, 0xea, 0x13, 0xc8, 0x0d, 0x2f, 0x66, 0xdc, 0xe1, 0x81, 0x22,
-	0x8c, 0x1b, 0x47, 0x5c, 0x78, 0xd1, 0x09, 0xdc, 0xd3, 0xf6, 0x97, 0xfc, 0x66, 0xd1, 0xa1, 0xd6,
-	0x39, 0x68, 0x6a, 0x5f, 0xd2, 0xe9, 0x25, 0x09, 0xf9, 0xbd, 0xbe, 0xd6, 0xee, 0x3c, 0xe9, 0x11,
-	0x20, 0x4f, 0xee, 0x90, 0xa4, 0x89, 0x4d, 0xd3, 0xdc, 0x3b, 0x95, 0x2f, 0x73, 0x66, 0x52, 0x2f,
-	0xbd, 0xa5, 0x2f, 0x23, 0x64, 0x17, 0x2f, 0x23, 0xb0, 0x78, 0x8b, 0xc6, 0xfb, 0x9d, 0xbd, 0x05,
-	0xf9, 0xc9, 0xa9, 0x75, 0x91, 0xb6, 0x34, 0xd2, 0xbb, 0x8b, 0x08, 0xd4, 0x5f, 0x66, 0x80, 0xa5,
-	0x1a, 0xc2, 0x95, 0xdf, 0xef, 0xdb, 0x96, 0x8f, 0xa1, 0x21, 0x9e, 0xe7, 0xe1, 0x54, 0x92, 0x0f,
-	0x54, 0x0c, 0xe9, 0x55, 0x2f, 0x09, 0x71, 0x48, 0x2e, 0x5a, 0xb3, 0x77, 0x81, 0xbf, 0xb5, 0x82,
-	0x33, 0x9e, 0xf6, 0x2d, 0x48, 0x9b, 0x5f, 0x4b, 0x68, 0x92, 0xc7, 0x55, 0xe4, 0x47, 0x63, 0xb8,
-	0x53, 0x78, 0x23, 0x99, 0x35, 0x62, 0x08, 0xea, 0xef, 0x67, 0xe0, 0x4a, 0x7a, 0x41, 0xfc, 0x7a,
-	0xbd, 0x4c, 0xbf, 0x90, 0x93, 0x5b, 0x7c, 0x21, 0x67, 0xd5, 0x7a, 0xca, 0xaf, 0x5c, 0x4f, 0xbf,
-	0x97, 0x81, 0x2d, 0x69, 0xf4, 0x13, 0x73, 0xe5, 0x2f, 0xa9, 0x65, 0xd2, 0x43, 0x39, 0xf9, 0xd4,
-	0x43, 0x39, 0xea, 0x1f, 0x66, 0xe0, 0xda, 0x42, 0x4b, 0x34, 0xeb, 0x2f, 0xb5, 0x2d, 0xe9, 0x07,
-	0x75, 0xc8, 0x0f, 0xcc, 0x83, 0x4c, 0x78, 0xc0, 0x3d, 0x4b, 0xbf, 0x90, 0xd3, 0xc5, 0x79, 0xfc,
-	0xd7, 0xe9, 0x46, 0x9a, 0x49, 0xb8, 0x34, 0xfb, 0x10, 0xaa, 0x89, 0x0a, 0x14, 0x5d, 0x62, 0x5c,
-	0x19, 0x6b, 0x2d, 0xd3, 0xad, 0xe4, 0x8b, 0xd9, 0x6f, 0xc7, 0x17, 0x1f, 0x43, 0x2d, 0x2e, 0x78,
-	0xd7, 0x9a, 0xa4, 0x9d, 0x02, 0x0b, 0x37, 0xee, 0x53, 0x94, 0xea, 0x07, 0xb0, 0x99, 0xf4, 0xa2,
-	0x25, 0x5e, 0x89, 0xb8, 0x03, 0x55, 0xd7, 0x3a, 0xd3, 0xa3, 0x37, 0x24, 0xc4, 0xc9, 0xb7, 0x6b,
-	0x9d, 0x09, 0x02, 0x75, 0x4f, 0xe6, 0x7b, 0xf1, 0xe3, 0x97, 0x8e, 0x29, 0xcf, 0x4c, 0xc9, 0x73,
-	0xcc, 0x08, 0x85, 0xa5, 0x49, 0x13, 0x53, 0x72, 0xad, 0x33, 0x5a, 0x73, 0x67, 0xa2, 0x9c, 0xa6,
-	0x69, 0x8a, 0x93, 0xc4, 0x55, 0x17, 0xb2, 0x6f, 0x40, 0x79, 0xe6, 0xa7, 0x66, 0xb6, 0x34, 0xf3,
-	0x79, 0xb5, 0x77, 0xc5, 0xb9, 0xfa, 0x65, 0xa7, 0x8e, 0xfc, 0xa4, 0x5d, 0xdc, 0x5f, 0xcd, 0x27,
-	0x8f, 0xe3, 0x7e, 0x28, 0x58, 0x1e, 0xee, 0x3f, 0x51, 0x73, 0x7c, 0xba, 0x98, 0xb9, 0xb7, 0x4e,
-	0xa7, 0x8b, 0xa4, 0xd2, 0x59, 0x5f, 0x8b, 0xa3, 0x7d, 0xfc, 0x54, 0xff, 0x00, 0x00, 0x92, 0x8e,
-	0xa7, 0xa4, 0x77, 0x66, 0x41, 0x7a, 0x7f, 0xa7, 0x63, 0xc6, 0x0f, 0xa0, 0x3e, 0xf6, 0x66, 0x17,
-	0x7a, 0x92, 0x23, 0xb7, 0x32, 0x47, 0x0d, 0xa9, 0x86, 0x49, 0x68, 0xf1, 0xf2, 0x51, 0x54, 0x7e,
-	0xe5, 0x51, 0xd4, 0xfb, 0x50, 0xe2, 0xbe, 0xef, 0x40, 0x04, 0xa9, 0x5f, 0x5f, 0x94, 0x4c, 0x0f,
-	0xc4, 0x7b, 0x48, 0x11, 0x1d, 0x6b, 0xa3, 0x61, 0x2b, 0x1e, 0x83, 0x91, 0x43, 0xd6, 0x6f, 0x2f,
-	0xe7, 0x8c, 0xc8, 0xf8, 0x0b, 0x04, 0x86, 0x9c, 0x94, 0x24, 0x76, 0x38, 0x15, 0x0e, 0x19, 0x92,
-	0xd8, 0x25, 0x59, 0x62, 0x0f, 0xa7, 0xdc, 0x0d, 0x83, 0x12, 0xfb, 0x87, 0x70, 0x45, 0x84, 0xff,
-	0x61, 0x06, 0x1c, 0x4e, 0xa2, 0xe7, 0xd7, 0xde, 0xc4, 0x9d, 0xc1, 0xe1, 0x94, 0x54, 0x61, 0x24,
-	0xff, 0x02, 0xb6, 0xc6, 0x27, 0x86, 0x7b, 0x6c, 0xe9, 0xe1, 0xc8, 0xd1, 0xe9, 0x79, 0x40, 0x7d,
-	0x6a, 0xcc, 0x84, 0x0e, 0xf2, 0xd6, 0x52, 0x63, 0x5b, 0x44, 0x3c, 0x1c, 0x39, 0x74, 0x7e, 0x1f,
-	0x1f, 0x58, 0x6e, 0x8e, 0x17, 0xe1, 0x0b, 0x07, 0x3a, 0xb0, 0x78, 0xa0, 0xb3, 0xa4, 0x5a, 0x54,
-	0x97, 0x55, 0x8b, 0x9b, 0x7f, 0x9a, 0x87, 0xa2, 0x78, 0x51, 0xea, 0x3e, 0xe4, 0x4d, 0xdf, 0x9b,
-	0xc5, 0x41, 0x2b, 0x2b, 0x34, 0x03, 0x7a, 0xc4, 0x1b, 0x95, 0x88, 0x07, 0x50, 0x34, 0x4c, 0x53,
-	0x9f, 0x9c, 0xa6, 0x0f, 0x5d, 0x16, 0x84, 0xf4, 0xfe, 0x9a, 0x56, 0x30, 0x48, 0x5a, 0x7f, 0x0c,
-	0x15, 0xa4, 0xe7, 0xfe, 0xa4, 0x94, 0xf1, 0xb2, 0x2c, 0x4e, 0xf7, 0xd7, 0xb4, 0xb2, 0x11, 0x89,
-	0xd6, 0x1f, 0xa7, 0xdd, 0x57, 0x5c, 0xd6, 0xdd, 0x5c, 0xca, 0x7a, 0x99, 0x23, 0xeb, 0xb7, 0x81,
-	0xfb, 0x33, 0x62, 0x4e, 0x51, 0x90, 0xfd, 0xfb, 0x4b, 0x7c, 0x65, 0x7f, 0x4d, 0xab, 0x19, 0x3c,
-	0x76, 0x82, 0xf3, 0x99, 0x0f, 0x23, 0xd7, 0x52, 0xfc, 0xdc, 0xee, 0x8a, 0x91, 0xc1, 0x7d, 0x1e,
-	0xfb, 0x97, 0x68, 0xd3, 0x63, 0x36, 0xd3, 0x8c, 0x62, 0x11, 0x4a, 0x4b, 0xd9, 0x62, 0x6e, 0x42,
-	0xd9, 0x62, 0xd6, 0xf2, 0x18, 0xaa, 0xe4, 0xe5, 0x11, 0xf9, 0xca, 0x4b, 0x43, 0x9b, 0x30, 0x03,
-	0xf2, 0x5d, 0x27, 0xac, 0xa1, 0x15, 0xf5, 0xd3, 0xb7, 0x64, 0xf7, 0xe0, 0xad, 0x95, 0x03, 0xa5,
-	0xc5, 0x9e, 0x42, 0xde, 0x59, 0x8d, 0xe7, 0x61, 0x3b, 0x50, 0x33, 0x24, 0x29, 0x21, 0x7c, 0x85,
-	0xb7, 0x56, 0xcc, 0x53, 0x4c, 0x43, 0x65, 0x48, 0xe9, 0xe4, 0x0c, 0xeb, 0xa6, 0x06, 0xd7, 0x56,
-	0x2f, 0x65, 0xf9, 0xa8, 0x3d, 0xcf, 0x8f, 0xda, 0xd5, 0xf4, 0xbd, 0xcd, 0xf4, 0x4d, 0x1b, 0xe9,
-	0xe0, 0xfd, 0x33, 0x34, 0x58, 0xe5, 0xcd, 0x5b, 0x85, 0x52, 0xf4, 0xb0, 0x19, 0x05, 0x6d, 0xb5,
-	0xfa, 0x87, 0x5f, 0x2a, 0x19, 0x04, 0x77, 0x7a, 0x83, 0x61, 0xb3, 0x27, 0x4e, 0x28, 0x3b, 0x3d,
-	0x71, 0x42, 0xa9, 0xfe, 0xbb, 0x1c, 0x54, 0x62, 0x0f, 0xeb, 0xf7, 0xb7, 0x52, 0x63, 0xf3, 0x2f,
-	0x27, 0x9b, 0x7f, 0x0b, 0x5a, 0x16, 0x3f, 0x1b, 0xe7, 0xf7, 0x79, 0x37, 0xd2, 0xba, 0x4c, 0xb0,
-	0x1c, 0xfa, 0x5f, 0xf8, 0x96, 0xa1, 0xff, 0x72, 0xdc, 0x52, 0x31, 0x1d, 0xb7, 0xb4, 0xf0, 0xb8,
-	0x5d, 0x89, 0xce, 0xf1, 0xe5, 0xc7, 0xed, 0x2e, 0x3d, 0xc0, 0x2f, 0x5f, 0x7e, 0x80, 0x4f, 0xbf,
-	0x54, 0xf0, 0xcc, 0xb6, 0xce, 0x44, 0xf8, 0x8e, 0x48, 0xa5, 0xc5, 0x07, 0xbc, 0x44, 0x7c, 0x7c,
-	0x0b, 0x56, 0xc4, 0x1e, 0xc2, 0xd6, 0xe4, 0x34, 0x7e, 0xc8, 0x27, 0xb1, 0x76, 0x6a, 0xd4, 0x8d,
-	0x95, 0x38, 0xf5, 0xef, 0x66, 0x00, 0x12, 0x37, 0xe4, 0xaf, 0xed, 0x6d, 0x91, 0x0c, 0xda, 0xdc,
-	0xaf, 0x30, 0x68, 0x5f, 0x72, 0xdd, 0x54, 0xfd, 0x1a, 0x2a, 0xb1, 0xe3, 0xf9, 0xfb, 0xaf, 0xb1,
-	0xef, 0x54, 0xe5, 0xef, 0x46, 0x9e, 0xa7, 0xd8, 0x73, 0xfb, 0xeb, 0x8e, 0x45, 0xaa, 0xfa, 0xdc,
-	0x4b, 0xaa, 0x3f, 0xe7, 0xee, 0x9f, 0xb8, 0xf2, 0xdf, 0xf0, 0xc6, 0x92, 0xd7, 0x7c, 0x3e, 0xb5,
-	0xe6, 0xd5, 0xb9, 0xf0, 0x61, 0xfd, 0xfa, 0x55, 0x7f, 0xa7, 0x0e, 0xff, 0x79, 0x26, 0x72, 0xb4,
-	0xc4, 0xcf, 0x23, 0x5d, 0xaa, 0x68, 0xad, 0xf6, 0x15, 0x7d, 0x97, 0xea, 0x7e, 0xa5, 0xa5, 0x98,
-	0xff, 0x55, 0x96, 0xe2, 0x5b, 0x50, 0xe0, 0x02, 0xa1, 0x70, 0x99, 0x95, 0xc8, 0xf1, 0x2f, 0x7d,
-	0x50, 0x54, 0x55, 0x85, 0x62, 0xc9, 0xfb, 0xbb, 0x15, 0x95, 0x1b, 0x3d, 0x86, 0x8a, 0x09, 0x34,
-	0xd4, 0x2b, 0x89, 0xc1, 0xf8, 0xdd, 0xc7, 0xe4, 0x37, 0x66, 0x2a, 0xfe, 0xb3, 0x2c, 0xac, 0xa7,
-	0xce, 0x9c, 0xbe, 0x47, 0x63, 0x56, 0x72, 0xf3, 0xdc, 0x6a, 0x6e, 0x7e, 0x29, 0x63, 0xcd, 0x5f,
-	0xce, 0x58, 0xff, 0xaf, 0x48, 0x00, 0x1e, 0x0c, 0x28, 0xde, 0x2e, 0x2d, 0x47, 0xc1, 0x80, 0x3c,
-	0x98, 0x0d, 0xb9, 0x69, 0x2d, 0xa5, 0x95, 0xaf, 0xd2, 0xdf, 0x33, 0x2b, 0xf5, 0xf7, 0xdb, 0xf1,
-	0xbb, 0xfc, 0x9d, 0x5d, 0x6e, 0x14, 0xae, 0x6b, 0x12, 0x84, 0x7d, 0x02, 0x37, 0xb8, 0x56, 0xc3,
-	0x15, 0x39, 0xdd, 0x9b, 0xe8, 0xf1, 0xab, 0xfd, 0x22, 0xda, 0xed, 0x1a, 0x27, 0xe0, 0xaf, 0xcd,
-	0x4e, 0x9a, 0x11, 0x56, 0xed, 0xc0, 0x7a, 0xea, 0x00, 0x50, 0xfa, 0x05, 0x90, 0x8c, 0xfc, 0x0b,
-	0x20, 0x6c, 0x1b, 0x0a, 0x67, 0x27, 0x96, 0x6f, 0xad, 0x78, 0x32, 0x86, 0x23, 0xd4, 0x1f, 0x41,
-	0x4d, 0x0e, 0x46, 0x60, 0xef, 0x40, 0xc1, 0x0e, 0xad, 0x69, 0x64, 0x01, 0x5f, 0x5b, 0x8e, 0x57,
-	0x20, 0x23, 0x98, 0x13, 0xa9, 0xbf, 0xc8, 0x80, 0xb2, 0x88, 0x93, 0x7e, 0xa6, 0x24, 0x73, 0xc9,
-	0xcf, 0x94, 0x64, 0x53, 0x8d, 0x5c, 0xf5, 0x4b, 0x23, 0xf1, 0xb3, 0x15, 0xf9, 0x4b, 0x9e, 0xad,
-	0x60, 0x6f, 0x42, 0xd9, 0xb7, 0xe8, 0x37, 0x20, 0xcc, 0xf4, 0x13, 0xe0, 0xfc, 0xd6, 0x50, 0x84,
-	0x53, 0xff, 0x56, 0x06, 0x4a, 0x22, 0x72, 0x62, 0xa5, 0xa1, 0xfa, 0x36, 0x94, 0xf8, 0xef, 0x41,
-	0x44, 0x86, 0xfb, 0x52, 0x30, 0x62, 0x84, 0x67, 0xb7, 0x79, 0x3c, 0x49, 0xda, 0x70, 0x3d, 0x74,
-	0x0c, 0x57, 0x23, 0x38, 0x2e, 0x35, 0xee, 0x86, 0x40, 0xd3, 0x2b, 0x10, 0x57, 0x8f, 0x81, 0x40,
-	0xa8, 0x9a, 0x05, 0xea, 0x8f, 0xa1, 0x24, 0x22, 0x33, 0x56, 0x36, 0xe5, 0x65, 0xbf, 0x90, 0xb0,
-	0x0d, 0x90, 0x84, 0x6a, 0xac, 0x2a, 0x41, 0xbd, 0x0f, 0xe5, 0x28, 0x3a, 0x03, 0xd7, 0x5f, 0x52,
-	0xb5, 0x08, 0xc0, 0x95, 0x1b, 0xe3, 0x88, 0x77, 0xd5, 0xba, 0xde, 0xf8, 0x94, 0x3c, 0x62, 0xef,
-	0x42, 0xd9, 0x89, 0x9e, 0xab, 0xcb, 0x5c, 0xfe, 0x5c, 0x5d, 0x4c, 0xc4, 0xee, 0x43, 0xcc, 0x8e,
-	0x5f, 0x66, 0x2d, 0xab, 0xcd, 0x28, 0xd2, 0x9c, 0x56, 0xd9, 0x23, 0xe1, 0xf9, 0xe9, 0xd2, 0x85,
-	0xf9, 0x94, 0xb3, 0x25, 0xd5, 0x26, 0x4d, 0x22, 0x53, 0xeb, 0x50, 0x93, 0x8f, 0x94, 0xd5, 0x5f,
-	0xe4, 0x41, 0x39, 0xb0, 0x42, 0x03, 0x99, 0xd6, 0x60, 0x6c, 0xb8, 0xd4, 0x89, 0x1b, 0x50, 0x8e,
-	0xdf, 0xc1, 0xce, 0x44, 0xef, 0x68, 0x3a, 0xd1, 0x03, 0xd1, 0x1e, 0x4d, 0xaa, 0xec, 0x95, 0x00,
-	0x0e, 0x22, 0x02, 0xce, 0x09, 0x52, 0x0f, 0x52, 0x96, 0xed, 0x60, 0x9f, 0x3f, 0x49, 0x79, 0x9d,
-	0xdf, 0x09, 0x76, 0xbc, 0x31, 0xad, 0xc9, 0x1a, 0x5d, 0x01, 0xee, 0x7a, 0x63, 0xcc, 0x15, 0x59,
-	0xcb, 0x81, 0x88, 0xdf, 0x2f, 0x0b, 0x13, 0x99, 0xdc, 0xef, 0xe2, 0xa2, 0x67, 0x18, 0x10, 0x67,
-	0xaa, 0x69, 0x65, 0x0e, 0x18, 0x06, 0xd1, 0xdb, 0x5d, 0x63, 0xf1, 0x20, 0x75, 0x8e, 0xde, 0xee,
-	0x6a, 0xb9, 0x14, 0x88, 0x4f, 0x6f, 0x9e, 0x8f, 0xc5, 0x93, 0xf3, 0xe2, 0x65, 0x34, 0x44, 0xbd,
-	0xce, 0x9f, 0xec, 0xf6, 0xad, 0x20, 0xe0, 0x0f, 0x43, 0xf0, 0x37, 0x1b, 0x6a, 0x11, 0x30, 0x7e,
-	0x81, 0x42, 0x3c, 0x72, 0x8e, 0x24, 0x20, 0x5e, 0xa0, 0xe0, 0x4f, 0x9c, 0x23, 0xc1, 0x0d, 0x28,
-	0x7f, 0xe3, 0xb9, 0x16, 0x59, 0xdd, 0x55, 0x6a, 0x55, 0x09, 0xd3, 0x07, 0xc6, 0x4c, 0xfd, 0xb7,
-	0x19, 0xd8, 0x5a, 0x1c, 0x55, 0x9a, 0xed, 0x1a, 0x94, 0x5b, 0xfd, 0xae, 0xde, 0x6b, 0x1e, 0xb4,
-	0x95, 0x35, 0xb6, 0x01, 0xd5, 0xfe, 0xce, 0xe7, 0xed, 0xd6, 0x90, 0x03, 0x32, 0x74, 0x65, 0x67,
-	0xa0, 0xef, 0x77, 0x76, 0x77, 0xdb, 0x3d, 0x6e, 0x62, 0xf4, 0x77, 0x3e, 0xd7, 0xbb, 0xfd, 0x16,
-	0x7f, 0x5f, 0x39, 0x3a, 0xb5, 0x1e, 0x28, 0x79, 0x3a, 0x29, 0xa6, 0x98, 0x48, 0x4c, 0x16, 0x78,
-	0xc8, 0xdf, 0xf3, 0x81, 0xde, 0xea, 0x0d, 0x95, 0x22, 0xa6, 0x7a, 0x47, 0xdd, 0x2e, 0xa5, 0x28,
-	0xb6, 0xa7, 0xd5, 0x3f, 0x38, 0xd4, 0xda, 0x83, 0x81, 0x3e, 0xe8, 0xfc, 0xac, 0xad, 0x94, 0xa9,
-	0x66, 0xad, 0xf3, 0xa4, 0xd3, 0xe3, 0x80, 0x0a, 0x2b, 0x41, 0xee, 0xa0, 0xd3, 0x53, 0x80, 0x3e,
-	0x9a, 0x5f, 0x28, 0x55, 0xfc, 0x18, 0x1c, 0x1d, 0x28, 0xb5, 0xfb, 0xaf, 0x41, 0x4d, 0xfe, 0xdd,
-	0x00, 0x8a, 0xf2, 0xf3, 0x5c, 0x8b, 0xbf, 0xe7, 0xd5, 0xfd, 0xe6, 0x03, 0x25, 0x73, 0xff, 0x77,
-	0xa5, 0xc7, 0x5f, 0x89, 0x46, 0x78, 0xe1, 0xe9, 0xe6, 0x18, 0xbf, 0xc8, 0x42, 0x3e, 0x77, 0xba,
-	0xf7, 0xb2, 0xdf, 0x1c, 0xec, 0x73, 0xff, 0xbc, 0xc0, 0x10, 0x20, 0x97, 0xbc, 0x03, 0x45, 0x37,
-	0xc5, 0xe8, 0x33, 0x3e, 0xa4, 0x2e, 0xd0, 0x15, 0x9f, 0xce, 0x00, 0x3b, 0xa7, 0x40, 0x0d, 0xbf,
-	0x62, 0x5c, 0xe9, 0xbe, 0x0a, 0x55, 0xe9, 0xe9, 0x3e, 0xaa, 0xc3, 0x08, 0x4e, 0xc4, 0xd3, 0x52,
-	0x68, 0x2b, 0x2a, 0x99, 0xfb, 0x1f, 0x22, 0xbb, 0x97, 0x1f, 0xce, 0x03, 0x28, 0xf6, 0x3c, 0x7f,
-	0x6a, 0x38, 0x82, 0xce, 0x9a, 0x07, 0x16, 0x9f, 0x02, 0xcd, 0x12, 0x4f, 0xec, 0x29, 0xd9, 0xfb,
-	0xef, 0xc2, 0xd5, 0x95, 0xaf, 0x02, 0x52, 0xe0, 0xaa, 0x3d, 0x9d, 0x39, 0x16, 0x8f, 0xbd, 0xdc,
-	0xbf, 0x18, 0xf9, 0xb6, 0xa9, 0x64, 0xee, 0x7f, 0x06, 0x8d, 0xcb, 0x42, 0x09, 0xb1, 0x9a, 0xd6,
-	0x7e, 0x93, 0xc2, 0x35, 0x71, 0x86, 0xfa, 0x3a, 0x4f, 0x65, 0x78, 0xb4, 0x6b, 0xb7, 0x4d, 0xe1,
-	0x09, 0xf7, 0x7f, 0x9e, 0x91, 0x98, 0x4a, 0x14, 0x0e, 0x16, 0x03, 0xc4, 0xd0, 0xcb, 0x20, 0xcd,
-	0x32, 0x4c, 0x25, 0xc3, 0xae, 0x01, 0x4b, 0x81, 0xba, 0xde, 0xd8, 0x70, 0x94, 0x2c, 0x05, 0x22,
-	0x44, 0xf0, 0xe7, 0xbe, 0x1d, 0x5a, 0x4a, 0x8e, 0xbd, 0x0a, 0x37, 0x62, 0x58, 0xd7, 0x3b, 0x3b,
-	0xf4, 0x6d, 0xb4, 0x7e, 0x2f, 0x38, 0x3a, 0xbf, 0xf3, 0x93, 0x3f, 0xf9, 0xe5, 0xed, 0xcc, 0x7f,
-	0xf8, 0xe5, 0xed, 0xcc, 0x7f, 0xfb, 0xe5, 0xed, 0xb5, 0x5f, 0xfc, 0xf7, 0xdb, 0x99, 0x9f, 0xc9,
-	0xbf, 0xd5, 0x37, 0x35, 0x42, 0xdf, 0x3e, 0xe7, 0x3b, 0x21, 0x4a, 0xb8, 0xd6, 0xbb, 0xb3, 0xd3,
-	0xe3, 0x77, 0x67, 0xa3, 0x77, 0x91, 0x01, 0x8d, 0x8a, 0xf4, 0xab, 0x7c, 0x8f, 0xfe, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xfc, 0xb4, 0x38, 0x6c, 0xf5, 0x6f, 0x00, 0x00,
+	// 10616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5b, 0x8c, 0x1b, 0xc7,
+	0x96, 0x98, 0xf8, 0x26, 0x0f, 0x1f, 0xd3, 0xd3, 0x7a, 0x51, 0xb2, 0x2c, 0x8d, 0xdb, 0xbe, 0xb6,
+	0xac, 0xeb, 0x2b, 0xd9, 0x23, 0x3f, 0x64, 0xef, 0xf5, 0xda, 0x1c, 0x0e, 0x25, 0xd1, 0xe2, 0x90,
+	0x73, 0x8b, 0x1c, 0xc9, 0xf6, 0x22, 0x69, 0x34, 0xd9, 0xcd, 0x99, 0xf6, 0x34, 0xbb, 0xe9, 0xee,
+	0xa6, 0x66, 0xc6, 0xc0, 0x02, 0x4e, 0x02, 0x64, 0x91, 0x00, 0xf9, 0x0a, 0xb0, 0x3f, 0xc1, 0x06,
+	0x37, 0x9b, 0xbf, 0x20, 0x01, 0x02, 0x24, 0x40, 0x82, 0xfc, 0x26, 0x1f, 0x37, 0x41, 0xb0, 0x08,
+	0x90, 0x8f, 0x45, 0x12, 0x60, 0x13, 0xdc, 0xfc, 0x67, 0x3f, 0x36, 0xdf, 0x49, 0x70, 0x4e, 0x55,
+	0x77, 0x57, 0x93, 0x1c, 0xcb, 0xf6, 0xbd, 0x8b, 0x24, 0x3f, 0x33, 0x55, 0xe7, 0x9c, 0xaa, 0xae,
+	0xe7, 0x79, 0xd5, 0xa9, 0x22, 0xc0, 0xdc, 0x31, 0xdc, 0xbb, 0x73, 0xdf, 0x0b, 0x3d, 0x35, 0x8f,
+	0xe9, 0xeb, 0x3f, 0x3b, 0xb4, 0xc3, 0xa3, 0xc5, 0xf8, 0xee, 0xc4, 0x9b, 0xdd, 0x3b, 0xf4, 0x0e,
+	0xbd, 0x7b, 0x84, 0x1c, 0x2f, 0xa6, 0x94, 0xa3, 0x0c, 0xa5, 0x78, 0xa1, 0xeb, 0xe0, 0x78, 0x93,
+	0x63, 0x91, 0xde, 0x08, 0xed, 0x99, 0x15, 0x84, 0xc6, 0x6c, 0xce, 0x01, 0xda, 0xbf, 0xc8, 0x40,
+	0x7e, 0x74, 0x36, 0xb7, 0xd4, 0x06, 0x64, 0x6d, 0xb3, 0x99, 0xd9, 0xca, 0xdc, 0x2e, 0xb0, 0xac,
+	0x6d, 0xaa, 0x5b, 0x50, 0x75, 0xbd, 0xb0, 0xbf, 0x70, 0x1c, 0x63, 0xec, 0x58, 0xcd, 0xec, 0x56,
+	0xe6, 0x76, 0x99, 0xc9, 0x20, 0xf5, 0x25, 0xa8, 0x18, 0x8b, 0xd0, 0xd3, 0x6d, 0x77, 0xe2, 0x37,
+	0x73, 0x84, 0x2f, 0x23, 0xa0, 0xeb, 0x4e, 0x7c, 0xf5, 0x12, 0x14, 0x4e, 0x6c, 0x33, 0x3c, 0x6a,
+	0xe6, 0xa9, 0x46, 0x9e, 0x41, 0x68, 0x30, 0x31, 0x1c, 0xab, 0x59, 0xe0, 0x50, 0xca, 0x20, 0x34,
+	0xa4, 0x8f, 0x14, 0xb7, 0x32, 0xb7, 0x2b, 0x8c, 0x67, 0xd4, 0x9b, 0x00, 0x96, 0xbb, 0x98, 0x3d,
+	0x37, 0x9c, 0x85, 0x15, 0x34, 0x4b, 0x84, 0x92, 0x20, 0xda, 0x27, 0x50, 0x99, 0x05, 0x87, 0x8f,
+	0x2d, 0xc3, 0xb4, 0x7c, 0xf5, 0x2a, 0x94, 0x66, 0xc1, 0xa1, 0x1e, 0x1a, 0x87, 0xa2, 0x0b, 0xc5,
+	0x59, 0x70, 0x38, 0x32, 0x0e, 0xd5, 0x6b, 0x50, 0x26, 0xc4, 0xd9, 0x9c, 0xf7, 0xa1, 0xc0, 0x90,
+	0x10, 0x7b, 0xac, 0xfd, 0x79, 0x01, 0x4a, 0x3d, 0x3b, 0xb4, 0x7c, 0xc3, 0x51, 0xaf, 0x40, 0xd1,
+	0x0e, 0xdc, 0x85, 0xe3, 0x50, 0xf1, 0x32, 0x13, 0x39, 0xf5, 0x0a, 0x14, 0xec, 0x07, 0xcf, 0x0d,
+	0x87, 0x97, 0x7d, 0x7c, 0x81, 0xf1, 0xac, 0xda, 0x84, 0xa2, 0xfd, 0xce, 0xfb, 0x88, 0xc8, 0x09,
+	0x84, 0xc8, 0x13, 0xe6, 0xfe, 0x36, 0x62, 0xf2, 0x31, 0xe6, 0xfe, 0x76, 0x84, 0x79, 0xff, 0x5d,
+	0xc4, 0x60, 0xef, 0x73, 0x84, 0xa1, 0x3c, 0x7e, 0x65, 0x41, 0x5f, 0xc1, 0x01, 0xa8, 0xe3, 0x57,
+	0x16, 0xd1, 0x57, 0x16, 0xfc, 0x2b, 0x25, 0x81, 0x10, 0x79, 0xc2, 0xf0, 0xaf, 0x94, 0x63, 0x4c,
+	0xfc, 0x95, 0x05, 0xff, 0x4a, 0x65, 0x2b, 0x73, 0x3b, 0x4f, 0x18, 0xfe, 0x95, 0x4b, 0x90, 0x37,
+	0x11, 0x0e, 0x5b, 0x99, 0xdb, 0x99, 0xc7, 0x17, 0x58, 0xde, 0x14, 0xd0, 0x00, 0xa1, 0x55, 0x1c,
+	0x60, 0x84, 0x06, 0x02, 0x3a, 0x46, 0x68, 0x0d, 0x47, 0x03, 0xa1, 0x63, 0x01, 0x9d, 0x22, 0xb4,
+	0xbe, 0x95, 0xb9, 0x9d, 0x45, 0x28, 0xe6, 0xd4, 0xeb, 0x50, 0x32, 0x8d, 0xd0, 0x42, 0x44, 0x43,
+	0x74, 0x39, 0x02, 0x20, 0x0e, 0x57, 0x1c, 0xe2, 0x36, 0x44, 0xa7, 0x23, 0x80, 0xaa, 0x41, 0x15,
+	0xc9, 0x22, 0xbc, 0x22, 0xf0, 0x32, 0x50, 0x7d, 0x0f, 0x6a, 0xa6, 0x35, 0xb1, 0x67, 0x86, 0xc3,
+	0xfb, 0xb4, 0xb9, 0x95, 0xb9, 0x5d, 0xdd, 0xde, 0xb8, 0x4b, 0x7b, 0x22, 0xc6, 0x3c, 0xbe, 0xc0,
+	0x52, 0x64, 0xea, 0x03, 0xa8, 0x8b, 0xfc, 0x3b, 0xdb, 0x34, 0xb0, 0x2a, 0x95, 0x53, 0x52, 0xe5,
+	0xde, 0xd9, 0x7e, 0xf0, 0xf8, 0x02, 0x4b, 0x13, 0xaa, 0xaf, 0x41, 0x2d, 0xde, 0x22, 0x58, 0xf0,
+	0xa2, 0x68, 0x55, 0x0a, 0x8a, 0xdd, 0xfa, 0x2a, 0xf0, 0x5c, 0x24, 0xb8, 0x24, 0xc6, 0x2d, 0x02,
+	0xa8, 0x5b, 0x00, 0xa6, 0x35, 0x35, 0x16, 0x4e, 0x88, 0xe8, 0xcb, 0x62, 0x00, 0x25, 0x98, 0x7a,
+	0x13, 0x2a, 0x8b, 0x39, 0xf6, 0xf2, 0xa9, 0xe1, 0x34, 0xaf, 0x08, 0x82, 0x04, 0x84, 0xb5, 0xe3,
+	0x3a, 0x47, 0xec, 0x55, 0x31, 0xbb, 0x11, 0x00, 0xf7, 0x8a, 0x1d, 0xec, 0xd8, 0x6e, 0xb3, 0x49,
+	0xeb, 0x94, 0x67, 0xd4, 0x1b, 0x90, 0x0b, 0xfc, 0x49, 0xf3, 0x1a, 0xf5, 0x12, 0x78, 0x2f, 0x3b,
+	0xa7, 0x73, 0x9f, 0x21, 0x78, 0xa7, 0x04, 0x05, 0xda, 0x33, 0xda, 0x0d, 0x28, 0xef, 0x1b, 0xbe,
+	0x31, 0x63, 0xd6, 0x54, 0x55, 0x20, 0x37, 0xf7, 0x02, 0xb1, 0x5b, 0x30, 0xa9, 0xf5, 0xa0, 0xf8,
+	0xd4, 0xf0, 0x11, 0xa7, 0x42, 0xde, 0x35, 0x66, 0x16, 0x21, 0x2b, 0x8c, 0xd2, 0xb8, 0x43, 0x82,
+	0xb3, 0x20, 0xb4, 0x66, 0x82, 0x15, 0x88, 0x1c, 0xc2, 0x0f, 0x1d, 0x6f, 0x2c, 0x76, 0x42, 0x99,
+	0x89, 0x9c, 0xf6, 0xd7, 0x33, 0x50, 0x6c, 0x7b, 0x0e, 0x56, 0x77, 0x15, 0x4a, 0xbe, 0xe5, 0xe8,
+	0xc9, 0xe7, 0x8a, 0xbe, 0xe5, 0xec, 0x7b, 0x01, 0x22, 0x26, 0x1e, 0x47, 0xf0, 0xbd, 0x59, 0x9c,
+	0x78, 0x84, 0x88, 0x1a, 0x90, 0x93, 0x1a, 0x70, 0x0d, 0xca, 0xe1, 0xd8, 0xd1, 0x09, 0x9e, 0x27,
+	0x78, 0x29, 0x1c, 0x3b, 0x7d, 0x44, 0x5d, 0x85, 0x92, 0x39, 0xe6, 0x98, 0x02, 0x61, 0x8a, 0xe6,
+	0x18, 0x11, 0xda, 0x87, 0x50, 0x61, 0xc6, 0x89, 0x68, 0xc6, 0x65, 0x28, 0x62, 0x05, 0x82, 0xcb,
+	0xe5, 0x59, 0x21, 0x1c, 0x3b, 0x5d, 0x13, 0xc1, 0xd8, 0x08, 0xdb, 0xa4, 0x36, 0xe4, 0x59, 0x61,
+	0xe2, 0x39, 0x5d, 0x53, 0x1b, 0x01, 0xb4, 0x3d, 0xdf, 0xff, 0xd1, 0x5d, 0xb8, 0x04, 0x05, 0xd3,
+	0x9a, 0x87, 0x47, 0x9c, 0x41, 0x30, 0x9e, 0xd1, 0xee, 0x40, 0x19, 0xe7, 0xa5, 0x67, 0x07, 0xa1,
+	0x7a, 0x13, 0xf2, 0x8e, 0x1d, 0x84, 0xcd, 0xcc, 0x56, 0x6e, 0x69, 0xd6, 0x08, 0xae, 0x6d, 0x41,
+	0x79, 0xcf, 0x38, 0x7d, 0x8a, 0x33, 0xa7, 0x5e, 0x12, 0x53, 0x28, 0xa6, 0x44, 0xcc, 0x67, 0x0d,
+	0x60, 0x64, 0xf8, 0x87, 0x56, 0x48, 0xfc, 0xec, 0x2f, 0x32, 0x50, 0x1d, 0x2e, 0xc6, 0x5f, 0x2f,
+	0x2c, 0xff, 0x0c, 0xdb, 0x7c, 0x1b, 0x72, 0xe1, 0xd9, 0x9c, 0x4a, 0x34, 0xb6, 0xaf, 0xf0, 0xea,
+	0x25, 0xfc, 0x5d, 0x2c, 0xc4, 0x90, 0x04, 0x3b, 0xe1, 0x7a, 0xa6, 0x15, 0x8d, 0x41, 0x81, 0x15,
+	0x31, 0xdb, 0x35, 0x51, 0x28, 0x78, 0x73, 0x31, 0x0b, 0x59, 0x6f, 0xae, 0x6e, 0x41, 0x61, 0x72,
+	0x64, 0x3b, 0x26, 0x4d, 0x40, 0xba, 0xcd, 0x1c, 0x81, 0xb3, 0xe4, 0x7b, 0x27, 0x7a, 0x60, 0x7f,
+	0x13, 0x31, 0xf9, 0x92, 0xef, 0x9d, 0x0c, 0xed, 0x6f, 0x2c, 0x6d, 0x24, 0x24, 0x0d, 0x40, 0x71,
+	0xd8, 0x6e, 0xf5, 0x5a, 0x4c, 0xb9, 0x80, 0xe9, 0xce, 0xe7, 0xdd, 0xe1, 0x68, 0xa8, 0x64, 0xd4,
+	0x06, 0x40, 0x7f, 0x30, 0xd2, 0x45, 0x3e, 0xab, 0x16, 0x21, 0xdb, 0xed, 0x2b, 0x39, 0xa4, 0x41,
+	0x78, 0xb7, 0xaf, 0xe4, 0xd5, 0x12, 0xe4, 0x5a, 0xfd, 0x2f, 0x94, 0x02, 0x25, 0x7a, 0x3d, 0xa5,
+	0xa8, 0xfd, 0xa3, 0x2c, 0x54, 0x06, 0xe3, 0xaf, 0xac, 0x49, 0x88, 0x7d, 0xc6, 0x55, 0x6a, 0xf9,
+	0xcf, 0x2d, 0x9f, 0xba, 0x9d, 0x63, 0x22, 0x87, 0x1d, 0x31, 0xc7, 0xd4, 0xb9, 0x1c, 0xcb, 0x9a,
+	0x63, 0xa2, 0x9b, 0x1c, 0x59, 0x33, 0xa3, 0x99, 0x13, 0x74, 0x94, 0xc3, 0x5d, 0xe1, 0x8d, 0xbf,
+	0xa2, 0xee, 0xe5, 0x18, 0x26, 0xd5, 0x5b, 0x50, 0xe5, 0x75, 0xc8, 0xeb, 0x0b, 0x38, 0x68, 0x79,
+	0xf1, 0x15, 0xe5, 0xc5, 0x47, 0x25, 0xa9, 0x56, 0x8e, 0x14, 0x12, 0x8c, 0x83, 0xfa, 0x62, 0x45,
+	0x7b, 0xe3, 0xaf, 0x38, 0xb6, 0xcc, 0x57, 0xb4, 0x37, 0xfe, 0x8a, 0x50, 0x3f, 0x85, 0xcd, 0x60,
+	0x31, 0x0e, 0x26, 0xbe, 0x3d, 0x0f, 0x6d, 0xcf, 0xe5, 0x34, 0x15, 0xa2, 0x51, 0x64, 0x04, 0x11,
+	0xdf, 0x86, 0xf2, 0x7c, 0x31, 0xd6, 0x6d, 0x77, 0xea, 0x11, 0x73, 0xaf, 0x6e, 0xd7, 0xf9, 0xc4,
+	0xec, 0x2f, 0xc6, 0x5d, 0x77, 0xea, 0xb1, 0xd2, 0x9c, 0x27, 0xb4, 0xd7, 0xa1, 0x24, 0x60, 0x28,
+	0xbd, 0x43, 0xcb, 0x35, 0xdc, 0x50, 0x8f, 0xc5, 0x7e, 0x99, 0x03, 0xba, 0xa6, 0xf6, 0xcf, 0x33,
+	0xa0, 0x0c, 0xa5, 0xcf, 0xec, 0x59, 0xa1, 0xb1, 0x96, 0x2b, 0xbc, 0x0c, 0x60, 0x4c, 0x26, 0xde,
+	0x82, 0x57, 0xc3, 0x17, 0x4f, 0x45, 0x40, 0xba, 0xa6, 0x3c, 0x36, 0xb9, 0xd4, 0xd8, 0xbc, 0x02,
+	0xb5, 0xa8, 0x9c, 0xb4, 0xa1, 0xab, 0x02, 0x16, 0x8d, 0x4e, 0xb0, 0x48, 0xed, 0xea, 0x52, 0xb0,
+	0xe0, 0xa5, 0xaf, 0x40, 0x91, 0x74, 0x84, 0xa0, 0x59, 0xdc, 0xca, 0x61, 0xad, 0x3c, 0xa7, 0xfd,
+	0xed, 0x2c, 0x94, 0x1f, 0x2e, 0xdc, 0x09, 0x36, 0x59, 0x7d, 0x15, 0xf2, 0xd3, 0x85, 0x3b, 0x69,
+	0x66, 0x64, 0x91, 0x11, 0xaf, 0x14, 0x46, 0x48, 0xdc, 0x83, 0x86, 0x7f, 0x88, 0x7b, 0x77, 0x65,
+	0x0f, 0x22, 0x5c, 0xfb, 0x97, 0x19, 0x5e, 0xe3, 0x43, 0xc7, 0x38, 0x54, 0xcb, 0x90, 0xef, 0x0f,
+	0xfa, 0x1d, 0xe5, 0x82, 0x5a, 0x83, 0x72, 0xb7, 0x3f, 0xea, 0xb0, 0x7e, 0xab, 0xa7, 0x64, 0x68,
+	0x41, 0x8f, 0x5a, 0x3b, 0xbd, 0x8e, 0x92, 0x45, 0xcc, 0xd3, 0x41, 0xaf, 0x35, 0xea, 0xf6, 0x3a,
+	0x4a, 0x9e, 0x63, 0x58, 0xb7, 0x3d, 0x52, 0xca, 0xaa, 0x02, 0xb5, 0x7d, 0x36, 0xd8, 0x3d, 0x68,
+	0x77, 0xf4, 0xfe, 0x41, 0xaf, 0xa7, 0x28, 0xea, 0x45, 0xd8, 0x88, 0x21, 0x03, 0x0e, 0xdc, 0xc2,
+	0x22, 0x4f, 0x5b, 0xac, 0xc5, 0x1e, 0x29, 0x9f, 0xaa, 0x65, 0xc8, 0xb5, 0x1e, 0x3d, 0x52, 0xbe,
+	0xc5, 0xbd, 0x51, 0x79, 0xd6, 0xed, 0xeb, 0x4f, 0x5b, 0xbd, 0x83, 0x8e, 0xf2, 0x6d, 0x36, 0xca,
+	0x0f, 0xd8, 0x6e, 0x87, 0x29, 0xdf, 0xe6, 0xd5, 0x4d, 0xa8, 0x7d, 0x39, 0xe8, 0x77, 0xf6, 0x5a,
+	0xfb, 0xfb, 0xd4, 0x90, 0x6f, 0xcb, 0xda, 0xaf, 0xf2, 0x90, 0xc7, 0x9e, 0xa8, 0x5a, 0xc2, 0x07,
+	0xe2, 0x2e, 0xe2, 0x46, 0xdc, 0xc9, 0xff, 0xea, 0xcf, 0x6e, 0x5d, 0xe0, 0x1c, 0xe0, 0x15, 0xc8,
+	0x39, 0x76, 0xd8, 0xcc, 0xca, 0xab, 0x47, 0xe8, 0x46, 0x8f, 0x2f, 0x30, 0xc4, 0xa9, 0x37, 0x21,
+	0xc3, 0x59, 0x41, 0x75, 0xbb, 0x21, 0x96, 0x97, 0x90, 0x25, 0x8f, 0x2f, 0xb0, 0xcc, 0x5c, 0xbd,
+	0x01, 0x99, 0xe7, 0x82, 0x2f, 0xd4, 0x38, 0x9e, 0x4b, 0x13, 0xc4, 0x3e, 0x57, 0xb7, 0x20, 0x37,
+	0xf1, 0xb8, 0xe6, 0x13, 0xe3, 0x39, 0x6f, 0xc5, 0xfa, 0x27, 0x9e, 0xa3, 0xbe, 0x0a, 0x39, 0xdf,
+	0x38, 0x69, 0x16, 0xe5, 0xe9, 0x8a, 0x99, 0x37, 0x12, 0xf9, 0xc6, 0x09, 0x36, 0x62, 0xda, 0x2c,
+	0xc9, 0x8d, 0x88, 0xe6, 0x1b, 0x3f, 0x33, 0x55, 0xb7, 0x20, 0x73, 0xd2, 0x2c, 0xcb, 0xc2, 0xfe,
+	0x99, 0xed, 0x9a, 0xde, 0xc9, 0x70, 0x6e, 0x4d, 0x90, 0xe2, 0x44, 0xfd, 0x09, 0xe4, 0x82, 0xc5,
+	0x98, 0xf6, 0x52, 0x75, 0x7b, 0x73, 0x85, 0x2b, 0xe2, 0x87, 0x82, 0xc5, 0x58, 0x7d, 0x1d, 0xf2,
+	0x13, 0xcf, 0xf7, 0x9b, 0x20, 0xd7, 0x95, 0x08, 0x04, 0x54, 0x7e, 0x10, 0x8f, 0x1f, 0x0c, 0x9b,
+	0x55, 0x99, 0x28, 0xe1, 0xc8, 0xf8, 0xc1, 0x50, 0x7d, 0x4d, 0xb0, 0xf9, 0x9a, 0xdc, 0xea, 0x48,
+	0x08, 0x60, 0x3d, 0x88, 0xc5, 0x49, 0x9a, 0x19, 0xa7, 0xcd, 0xba, 0x4c, 0x14, 0x71, 0x7f, 0x6c,
+	0xd3, 0xcc, 0x38, 0x55, 0x5f, 0x83, 0xdc, 0x73, 0x6b, 0xd2, 0x6c, 0xc8, 0x5f, 0x13, 0x93, 0xf4,
+	0x94, 0xba, 0x87, 0x68, 0x94, 0x67, 0xc6, 0xe2, 0x14, 0xb7, 0xe3, 0x06, 0x97, 0x3c, 0xc6, 0xe2,
+	0xb4, 0x6b, 0x22, 0x67, 0x73, 0xcd, 0xe7, 0xa4, 0x65, 0x65, 0x18, 0x26, 0x51, 0xc3, 0x0f, 0x2c,
+	0xc7, 0x9a, 0x84, 0xf6, 0x73, 0x3b, 0x3c, 0x23, 0xd5, 0x2a, 0xc3, 0x64, 0xd0, 0x4e, 0x11, 0xf2,
+	0xd6, 0xe9, 0xdc, 0xd7, 0xb6, 0x01, 0x92, 0xef, 0x60, 0x4d, 0x8e, 0xe5, 0x46, 0x9a, 0x83, 0x63,
+	0xb9, 0xc8, 0x19, 0x4c, 0x23, 0x34, 0x68, 0xf9, 0xd4, 0x18, 0xa5, 0xb5, 0x6b, 0x50, 0x89, 0x55,
+	0x32, 0xb5, 0x06, 0x19, 0x43, 0x70, 0xe4, 0x8c, 0xa1, 0xdd, 0x06, 0x10, 0xa8, 0x77, 0xb6, 0x1f,
+	0xa4, 0x71, 0x98, 0x8b, 0xf8, 0x74, 0x66, 0xac, 0xfd, 0x1c, 0x6a, 0xcc, 0x0a, 0x16, 0x4e, 0xd8,
+	0xf6, 0x9c, 0x5d, 0x6b, 0xaa, 0xbe, 0x05, 0x10, 0xe7, 0x03, 0x21, 0x38, 0x93, 0xc5, 0xb4, 0x6b,
+	0x4d, 0x99, 0x84, 0xd7, 0xfe, 0x20, 0x0f, 0x45, 0x51, 0x30, 0x11, 0xf2, 0x19, 0x49, 0xc8, 0xc7,
+	0x2c, 0x2d, 0x9b, 0x56, 0x74, 0x8e, 0x6c, 0xd3, 0xb4, 0xdc, 0x48, 0xa1, 0xe1, 0x39, 0x1c, 0x7d,
+	0xc3, 0x39, 0xa4, 0x15, 0xde, 0xd8, 0x56, 0xa3, 0x8f, 0xce, 0xe6, 0xbe, 0x15, 0x04, 0x5c, 0x94,
+	0x1a, 0xce, 0x61, 0xb4, 0xd9, 0x0a, 0xdf, 0xb5, 0xd9, 0xae, 0x41, 0xd9, 0xf5, 0x42, 0x9d, 0xcc,
+	0x8d, 0x22, 0x7d, 0xa3, 0x24, 0xec, 0x2a, 0xf5, 0x0d, 0x28, 0x09, 0x45, 0xb1, 0x59, 0x92, 0xf7,
+	0xe2, 0x2e, 0x07, 0xb2, 0x08, 0xab, 0x36, 0x51, 0xef, 0x98, 0xcd, 0x2c, 0x37, 0x8c, 0x44, 0x87,
+	0xc8, 0xaa, 0x3f, 0x85, 0x8a, 0xe7, 0xea, 0x5c, 0x9b, 0x6c, 0x56, 0xe4, 0xf5, 0x34, 0x70, 0x0f,
+	0x08, 0xca, 0xca, 0x9e, 0x48, 0x61, 0x53, 0x1c, 0xef, 0x44, 0x9f, 0x18, 0xbe, 0x49, 0x4b, 0xbd,
+	0xcc, 0x4a, 0x8e, 0x77, 0xd2, 0x36, 0x7c, 0x93, 0x8b, 0xd2, 0xaf, 0xdd, 0xc5, 0x8c, 0x96, 0x77,
+	0x9d, 0x89, 0x9c, 0x7a, 0x03, 0x2a, 0x13, 0x67, 0x11, 0x84, 0x96, 0xbf, 0x73, 0xc6, 0xed, 0x03,
+	0x96, 0x00, 0xb0, 0x5d, 0x73, 0xdf, 0x9e, 0x19, 0xfe, 0x19, 0xad, 0xe5, 0x32, 0x8b, 0xb2, 0xa8,
+	0xc2, 0xcc, 0x8f, 0x6d, 0xf3, 0x94, 0x1b, 0x09, 0x8c, 0x67, 0x90, 0xfe, 0x88, 0x4c, 0xb8, 0x80,
+	0x96, 0x6b, 0x99, 0x45, 0x59, 0x9a, 0x07, 0x4a, 0xd2, 0x9a, 0xad, 0x30, 0x91, 0x4b, 0xe9, 0x81,
+	0x9b, 0xe7, 0xea, 0x81, 0x6a, 0x4a, 0x0f, 0xfc, 0x1a, 0x4a, 0x62, 0x04, 0xd5, 0x9b, 0x7c, 0x4d,
+	0xa7, 0xd9, 0x21, 0xe7, 0xf8, 0x08, 0x57, 0x5f, 0x85, 0xba, 0xe7, 0xdb, 0x87, 0xb6, 0xab, 0x07,
+	0xa1, 0x6f, 0xbb, 0x87, 0x62, 0x6d, 0xd4, 0x38, 0x70, 0x48, 0x30, 0x14, 0x5f, 0x38, 0x7b, 0xba,
+	0x31, 0xb6, 0x1d, 0xdc, 0x3b, 0x39, 0x61, 0x1d, 0x2f, 0x1c, 0xa7, 0xc5, 0x41, 0xda, 0x00, 0xca,
+	0xd1, 0x78, 0xff, 0x56, 0xbe, 0xa9, 0xfd, 0x0e, 0x54, 0xbb, 0xae, 0x69, 0x9d, 0x0e, 0x48, 0x22,
+	0xab, 0x6f, 0x81, 0x3a, 0xf1, 0x2d, 0x23, 0xb4, 0x74, 0xeb, 0x34, 0xf4, 0x0d, 0x9d, 0x5b, 0xd0,
+	0xdc, 0x7a, 0x55, 0x38, 0xa6, 0x83, 0x88, 0x11, 0xc2, 0xb5, 0xff, 0x9c, 0x81, 0xfa, 0x3e, 0x9f,
+	0x88, 0x27, 0xd6, 0xd9, 0x2e, 0xd7, 0xf1, 0x27, 0xd1, 0x26, 0xca, 0x33, 0x4a, 0xab, 0x37, 0xa1,
+	0x3a, 0x3f, 0xb6, 0xce, 0xf4, 0x94, 0x3e, 0x5c, 0x41, 0x50, 0x9b, 0xb6, 0xcb, 0x9b, 0x50, 0xf4,
+	0xe8, 0xeb, 0xcd, 0x9c, 0xcc, 0x3e, 0xa5, 0x66, 0x31, 0x41, 0xa0, 0x6a, 0x50, 0x8f, 0xab, 0x92,
+	0x25, 0xbc, 0xa8, 0x8c, 0xa6, 0xeb, 0x12, 0x14, 0x10, 0x15, 0x34, 0x0b, 0x24, 0xc5, 0x79, 0x46,
+	0x7d, 0x1b, 0xea, 0x13, 0x6f, 0x36, 0xd7, 0xa3, 0xe2, 0x42, 0x22, 0xa4, 0xb7, 0x79, 0x15, 0x49,
+	0xf6, 0x79, 0x5d, 0xda, 0x1f, 0xe6, 0xa0, 0x4c, 0x6d, 0x10, 0x3b, 0xdd, 0x36, 0x4f, 0xa3, 0x9d,
+	0x5e, 0x61, 0x05, 0xdb, 0x44, 0xf6, 0xf7, 0x32, 0x80, 0x8d, 0x24, 0xba, 0xb4, 0xdf, 0x2b, 0x04,
+	0x89, 0x9a, 0x32, 0x37, 0xfc, 0x30, 0x68, 0xe6, 0x78, 0x53, 0x28, 0x83, 0x4b, 0x70, 0xe1, 0xda,
+	0x5f, 0x2f, 0x78, 0xeb, 0xcb, 0x4c, 0xe4, 0xd4, 0xdb, 0xa0, 0xf0, 0xca, 0x68, 0xd0, 0x65, 0x15,
+	0xa5, 0x41, 0x70, 0x1a, 0xf3, 0x48, 0x07, 0xe4, 0x34, 0xd6, 0x29, 0xca, 0x00, 0xbe, 0xdb, 0x81,
+	0x40, 0x1d, 0x84, 0xc8, 0xfb, 0xb8, 0x94, 0xde, 0xc7, 0x4d, 0x28, 0x3d, 0xb7, 0x03, 0x1b, 0x67,
+	0xb5, 0xcc, 0x77, 0x86, 0xc8, 0x4a, 0xd3, 0x50, 0x79, 0xd1, 0x34, 0xc4, 0xdd, 0x36, 0x9c, 0x43,
+	0xae, 0x1c, 0x46, 0xdd, 0x6e, 0x39, 0x87, 0x9e, 0xfa, 0x0e, 0x5c, 0x4e, 0xd0, 0xa2, 0x37, 0xe4,
+	0x2a, 0x21, 0x6f, 0x00, 0x53, 0x63, 0x4a, 0xea, 0x11, 0x69, 0xef, 0x77, 0x60, 0x53, 0x2a, 0x32,
+	0x47, 0x15, 0x20, 0x20, 0x36, 0x50, 0x61, 0x1b, 0x31, 0x39, 0x69, 0x06, 0x81, 0xf6, 0x6f, 0xb3,
+	0x50, 0x7f, 0xe8, 0xf9, 0x96, 0x7d, 0xe8, 0x26, 0xab, 0x6e, 0x45, 0x87, 0x8c, 0x56, 0x62, 0x56,
+	0x5a, 0x89, 0xb7, 0xa0, 0x3a, 0xe5, 0x05, 0xf5, 0x70, 0xcc, 0x4d, 0xcb, 0x3c, 0x03, 0x01, 0x1a,
+	0x8d, 0x1d, 0xdc, 0x81, 0x11, 0x01, 0x15, 0xce, 0x53, 0xe1, 0xa8, 0x10, 0xb2, 0x7f, 0xf5, 0x23,
+	0x62, 0x84, 0xa6, 0xe5, 0x58, 0x21, 0x9f, 0x9e, 0xc6, 0xf6, 0xcb, 0x42, 0x67, 0x90, 0xdb, 0x74,
+	0x97, 0x59, 0xd3, 0x16, 0xa9, 0x10, 0xc8, 0x17, 0x77, 0x89, 0x5c, 0xfd, 0x48, 0x66, 0xa2, 0xc5,
+	0xef, 0x59, 0x96, 0xef, 0x76, 0x6d, 0x04, 0x95, 0x18, 0x8c, 0xfa, 0x20, 0xeb, 0x08, 0x1d, 0xf0,
+	0x82, 0x5a, 0x85, 0x52, 0xbb, 0x35, 0x6c, 0xb7, 0x76, 0x3b, 0x4a, 0x06, 0x51, 0xc3, 0xce, 0x88,
+	0xeb, 0x7d, 0x59, 0x75, 0x03, 0xaa, 0x98, 0xdb, 0xed, 0x3c, 0x6c, 0x1d, 0xf4, 0x46, 0x4a, 0x4e,
+	0xad, 0x43, 0xa5, 0x3f, 0xd0, 0x5b, 0xed, 0x51, 0x77, 0xd0, 0x57, 0xf2, 0xda, 0xa7, 0x50, 0x6e,
+	0x1f, 0x59, 0x93, 0xe3, 0xf3, 0x46, 0x91, 0x4c, 0x33, 0x6b, 0x72, 0xdc, 0xcc, 0xae, 0x30, 0x19,
+	0x8e, 0xd0, 0x9e, 0x42, 0xad, 0x1d, 0xf1, 0xe9, 0xf3, 0x6a, 0xd9, 0x86, 0x06, 0x6d, 0xbe, 0xc9,
+	0x38, 0xda, 0x7d, 0xd9, 0x35, 0xbb, 0xaf, 0x86, 0x34, 0xed, 0xb1, 0xd8, 0x7e, 0xef, 0x41, 0x75,
+	0xdf, 0xf7, 0xe6, 0x96, 0x1f, 0x52, 0xb5, 0x0a, 0xe4, 0x8e, 0xad, 0x33, 0x51, 0x2b, 0x26, 0x13,
+	0xe3, 0x35, 0x2b, 0x1b, 0xaf, 0xdb, 0x50, 0x8e, 0x8a, 0x7d, 0xef, 0x32, 0x9f, 0x40, 0x5d, 0x94,
+	0xb1, 0xad, 0x00, 0x3f, 0x76, 0x17, 0x60, 0x1e, 0x03, 0x84, 0x42, 0x10, 0x69, 0xa7, 0xa2, 0x72,
+	0x26, 0x51, 0x68, 0x7f, 0x91, 0x83, 0xc6, 0xbe, 0xe1, 0x87, 0x36, 0x4e, 0x0e, 0x1f, 0x86, 0x37,
+	0x20, 0x4f, 0x4b, 0x9e, 0xdb, 0xc9, 0x17, 0x63, 0xd5, 0x96, 0xd3, 0x90, 0x64, 0x27, 0x02, 0xf5,
+	0x23, 0x68, 0xcc, 0x23, 0xb0, 0x4e, 0xfc, 0x9c, 0x8f, 0xcd, 0x72, 0x11, 0x1a, 0xf3, 0xfa, 0x5c,
+	0xce, 0xaa, 0x1f, 0xc3, 0xa5, 0x74, 0x59, 0x2b, 0x08, 0x12, 0x3e, 0x2a, 0x4f, 0xd6, 0xc5, 0x54,
+	0x41, 0x4e, 0xa6, 0xb6, 0x61, 0x33, 0x29, 0x3e, 0xf1, 0x9c, 0xc5, 0xcc, 0x0d, 0x84, 0xae, 0x7d,
+	0x65, 0xe9, 0xeb, 0x6d, 0x8e, 0x65, 0xca, 0x7c, 0x09, 0xa2, 0x6a, 0x50, 0x8b, 0x61, 0xfd, 0xc5,
+	0x8c, 0xb6, 0x44, 0x9e, 0xa5, 0x60, 0xea, 0x7d, 0x80, 0x38, 0xcf, 0xad, 0xab, 0xd5, 0xfe, 0x75,
+	0x43, 0x6b, 0xc6, 0x24, 0x32, 0xd4, 0x08, 0x90, 0x19, 0xf8, 0x76, 0x78, 0x34, 0x23, 0x2e, 0x96,
+	0x63, 0x09, 0x80, 0x98, 0x65, 0xa0, 0xa3, 0x29, 0x17, 0x17, 0x11, 0x0c, 0xad, 0x61, 0x07, 0xc3,
+	0xc5, 0x38, 0xae, 0x17, 0xc5, 0x60, 0xd2, 0xcb, 0x59, 0x70, 0x28, 0x0c, 0xde, 0xa4, 0x85, 0x7b,
+	0xc1, 0xa1, 0xba, 0x0d, 0x97, 0x13, 0xa2, 0x84, 0xff, 0x06, 0x4d, 0x20, 0xce, 0x9d, 0x0c, 0x5f,
+	0xcc, 0x84, 0x03, 0xed, 0x33, 0xa8, 0xa7, 0x66, 0xe7, 0x85, 0x02, 0xf9, 0x1a, 0x94, 0xf1, 0x3f,
+	0x8a, 0x63, 0xb1, 0x00, 0x4b, 0x98, 0x1f, 0x86, 0xbe, 0x66, 0x81, 0xb2, 0x3c, 0xd6, 0xea, 0x6b,
+	0xe4, 0x04, 0xc2, 0xe4, 0x1a, 0x67, 0x4e, 0x84, 0x42, 0x9b, 0x7e, 0x75, 0x12, 0xb3, 0xd4, 0xea,
+	0x95, 0xc9, 0xd2, 0xfe, 0x41, 0x16, 0xea, 0xa9, 0x11, 0x57, 0x7f, 0x22, 0x2f, 0x3f, 0x69, 0xe3,
+	0x26, 0x63, 0x46, 0x12, 0xe7, 0x4d, 0x50, 0x3c, 0xdf, 0xb4, 0x5d, 0x83, 0x9c, 0x52, 0x7c, 0xb8,
+	0xb3, 0xa4, 0xc0, 0x6d, 0x08, 0xf8, 0xbe, 0x00, 0xa3, 0x01, 0x60, 0x5a, 0xb1, 0x8d, 0x2f, 0x2c,
+	0x74, 0x19, 0x24, 0x4b, 0xa7, 0x7c, 0x5a, 0x3a, 0xbd, 0x01, 0x15, 0xc7, 0x0a, 0x02, 0x3d, 0x3c,
+	0x32, 0xdc, 0x66, 0x61, 0xa5, 0xd3, 0x65, 0x44, 0x8e, 0x8e, 0x0c, 0x17, 0x09, 0x6d, 0x57, 0x17,
+	0x5e, 0xfc, 0xe2, 0x2a, 0xa1, 0xed, 0x92, 0x8d, 0x83, 0x72, 0xff, 0xd2, 0xba, 0x89, 0x15, 0x62,
+	0x51, 0x5d, 0x9d, 0x57, 0xed, 0x65, 0x28, 0x3d, 0xb5, 0xad, 0x13, 0xc1, 0xcb, 0x9e, 0xdb, 0xd6,
+	0x49, 0xc4, 0xcb, 0x30, 0xad, 0xfd, 0xa7, 0x32, 0x94, 0x89, 0x78, 0xf7, 0x7c, 0xe7, 0xdf, 0x0f,
+	0x31, 0x00, 0xb6, 0x20, 0x1f, 0x8b, 0x9a, 0x65, 0x8e, 0x48, 0x18, 0x94, 0xb6, 0x92, 0x0c, 0xe5,
+	0x1a, 0x41, 0x25, 0x8c, 0x45, 0x27, 0x6a, 0xce, 0xa4, 0x98, 0x05, 0x5f, 0x3b, 0xc2, 0x57, 0x94,
+	0x00, 0xd4, 0xbb, 0x5c, 0xaf, 0x25, 0x9f, 0x45, 0x49, 0x66, 0x2c, 0xd4, 0x87, 0xc8, 0xcc, 0x25,
+	0x65, 0x17, 0x33, 0xa4, 0x1f, 0x58, 0x7e, 0x10, 0x6d, 0xa7, 0x3a, 0x8b, 0xb2, 0xc8, 0xd1, 0x50,
+	0x79, 0x6a, 0x56, 0xe5, 0x5a, 0x52, 0xda, 0x1f, 0x23, 0x02, 0xf5, 0x36, 0x94, 0x48, 0x64, 0x5b,
+	0x28, 0xc1, 0x25, 0xd6, 0x19, 0x29, 0x53, 0x2c, 0x42, 0xab, 0x6f, 0x42, 0x61, 0x7a, 0x6c, 0x9d,
+	0x05, 0xcd, 0xba, 0xcc, 0x12, 0x52, 0xb2, 0x90, 0x71, 0x0a, 0xf5, 0x35, 0x68, 0xf8, 0xd6, 0x54,
+	0x27, 0x77, 0x20, 0x0a, 0xef, 0xa0, 0xd9, 0x20, 0xd9, 0x5c, 0xf3, 0xad, 0x69, 0x1b, 0x81, 0xa3,
+	0xb1, 0x13, 0xa8, 0xaf, 0x43, 0x91, 0xa4, 0x12, 0xaa, 0xfd, 0xd2, 0x97, 0x23, 0x11, 0xc7, 0x04,
+	0x56, 0xdd, 0x86, 0x4a, 0xc2, 0x36, 0x2e, 0x53, 0x87, 0x2e, 0x2d, 0xf1, 0x23, 0x62, 0xe3, 0x2c,
+	0x21, 0x53, 0xdf, 0x01, 0x10, 0x06, 0x89, 0x3e, 0x3e, 0x23, 0x07, 0x7b, 0x35, 0x36, 0xd8, 0x24,
+	0x01, 0x28, 0x9b, 0x2d, 0x6f, 0x40, 0x01, 0xa5, 0x44, 0xd0, 0xbc, 0xba, 0x95, 0x4b, 0x34, 0x2a,
+	0x49, 0xac, 0x31, 0x8e, 0x47, 0x5f, 0x1b, 0x2e, 0x2e, 0x1d, 0xa7, 0xb0, 0x29, 0x5b, 0x68, 0x62,
+	0x25, 0xa2, 0x96, 0x66, 0x9d, 0x0c, 0xbf, 0x76, 0xd4, 0x3b, 0x90, 0x37, 0xad, 0x69, 0xd0, 0xbc,
+	0xb6, 0x95, 0x4b, 0xd8, 0x74, 0xb4, 0x1e, 0xd1, 0xa0, 0xe3, 0xa2, 0x05, 0x69, 0xd4, 0xc7, 0xd0,
+	0xc0, 0xa5, 0xb7, 0x4d, 0x8a, 0x37, 0x0e, 0x79, 0xf3, 0x3a, 0x95, 0x7a, 0x65, 0xa9, 0x54, 0x5f,
+	0x10, 0xd1, 0x04, 0x75, 0xdc, 0xd0, 0x3f, 0x63, 0x75, 0x57, 0x86, 0xa9, 0xd7, 0xa1, 0x6c, 0x07,
+	0x3d, 0x6f, 0x72, 0x6c, 0x99, 0xcd, 0x97, 0xf8, 0x99, 0x5c, 0x94, 0x57, 0x3f, 0x84, 0x3a, 0x2d,
+	0x46, 0xcc, 0xe2, 0xc7, 0x9b, 0x37, 0x64, 0x91, 0x37, 0x92, 0x51, 0x2c, 0x4d, 0x89, 0xea, 0x96,
+	0x1d, 0xe8, 0xa1, 0x35, 0x9b, 0x7b, 0x3e, 0xda, 0x76, 0x2f, 0x73, 0x83, 0xc7, 0x0e, 0x46, 0x11,
+	0x08, 0xf9, 0x7c, 0x7c, 0x1c, 0xa8, 0x7b, 0xd3, 0x69, 0x60, 0x85, 0xcd, 0x9b, 0xb4, 0xd7, 0x1a,
+	0xd1, 0xa9, 0xe0, 0x80, 0xa0, 0xa4, 0x94, 0x06, 0xba, 0x79, 0xe6, 0x1a, 0x33, 0x7b, 0xd2, 0xbc,
+	0xc5, 0x4d, 0x48, 0x3b, 0xd8, 0xe5, 0x00, 0xd9, 0x8a, 0xdb, 0x92, 0xad, 0xb8, 0xeb, 0x8f, 0xc8,
+	0x8a, 0xa3, 0xf6, 0xbc, 0xb7, 0x24, 0xf7, 0x53, 0x0b, 0x5d, 0x52, 0x10, 0xf0, 0xe4, 0x25, 0x21,
+	0xdc, 0x29, 0x40, 0xce, 0xb4, 0xa6, 0xd7, 0x3f, 0x05, 0x75, 0x75, 0x24, 0x5f, 0xa4, 0x84, 0x14,
+	0x84, 0x12, 0xf2, 0x51, 0xf6, 0x41, 0x46, 0xfb, 0x10, 0xea, 0xa9, 0x6d, 0xb9, 0x56, 0x99, 0xe2,
+	0x46, 0x85, 0x31, 0x13, 0x7e, 0x11, 0x9e, 0xd1, 0xfe, 0x24, 0x07, 0xb5, 0xc7, 0x46, 0x70, 0xb4,
+	0x67, 0xcc, 0x87, 0xa1, 0x11, 0x06, 0x38, 0xb6, 0x47, 0x46, 0x70, 0x34, 0x33, 0xe6, 0xdc, 0x6d,
+	0x9e, 0xe1, 0x8e, 0x18, 0x01, 0x43, 0xd7, 0x39, 0xce, 0x2a, 0x66, 0x07, 0xee, 0xfe, 0x13, 0x71,
+	0xfc, 0x12, 0xe7, 0x91, 0x0f, 0x04, 0x47, 0x8b, 0xe9, 0xd4, 0xb1, 0x04, 0xbf, 0x8a, 0xb2, 0xea,
+	0x6b, 0x50, 0x17, 0x49, 0x32, 0xdf, 0x4e, 0xc5, 0x59, 0x6c, 0x1a, 0xa8, 0xde, 0x87, 0xaa, 0x00,
+	0x8c, 0x22, 0xae, 0xd5, 0x88, 0x1d, 0x63, 0x09, 0x82, 0xc9, 0x54, 0xea, 0x2f, 0xe0, 0xb2, 0x94,
+	0x7d, 0xe8, 0xf9, 0x7b, 0x0b, 0x27, 0xb4, 0xdb, 0x7d, 0xa1, 0x2b, 0xbf, 0xb4, 0x52, 0x3c, 0x21,
+	0x61, 0xeb, 0x4b, 0xa6, 0x5b, 0xbb, 0x67, 0xbb, 0x42, 0x93, 0x48, 0x03, 0x97, 0xa8, 0x8c, 0xd3,
+	0x66, 0x79, 0x85, 0xca, 0x38, 0xc5, 0x95, 0x2e, 0x00, 0x7b, 0x56, 0x78, 0xe4, 0x99, 0xcd, 0x8a,
+	0xbc, 0xd2, 0x87, 0x32, 0x8a, 0xa5, 0x29, 0x71, 0x38, 0xd1, 0x8c, 0x9f, 0xb8, 0x21, 0x99, 0x4b,
+	0x39, 0x16, 0x65, 0x51, 0x2e, 0xf8, 0x86, 0x7b, 0x68, 0x05, 0xcd, 0xea, 0x56, 0xee, 0x76, 0x86,
+	0x89, 0x9c, 0xf6, 0xd7, 0xb2, 0x50, 0xe0, 0x33, 0xf9, 0x12, 0x54, 0xc6, 0x78, 0xd8, 0xae, 0xa3,
+	0xd7, 0x44, 0xf8, 0xd4, 0x09, 0x80, 0xaa, 0x15, 0x99, 0x39, 0x01, 0xf7, 0xb1, 0x66, 0x18, 0xa5,
+	0xb1, 0x4a, 0x6f, 0x11, 0xe2, 0xb7, 0x72, 0x04, 0x15, 0x39, 0x6c, 0x84, 0xef, 0x9d, 0xd0, 0x6a,
+	0xc8, 0x13, 0x22, 0xca, 0xe2, 0x27, 0xb8, 0x88, 0xc1, 0x42, 0x05, 0xc2, 0x95, 0x09, 0xd0, 0x76,
+	0xc3, 0x65, 0x8f, 0x5e, 0x71, 0xc5, 0xa3, 0x87, 0x87, 0xea, 0x53, 0xcf, 0x9f, 0x58, 0x03, 0xd7,
+	0x6a, 0xf7, 0x69, 0x84, 0xcb, 0x4c, 0x82, 0xa8, 0xef, 0xc7, 0x6b, 0x91, 0x7a, 0xd4, 0x2c, 0xcb,
+	0xcc, 0x53, 0x5e, 0xb5, 0x2c, 0x45, 0xa7, 0x3d, 0x03, 0x60, 0xde, 0x49, 0x60, 0x85, 0xa4, 0x5e,
+	0x5d, 0xa5, 0xe6, 0xa7, 0x4e, 0xcb, 0xbc, 0x13, 0x3c, 0x14, 0x13, 0x87, 0x8e, 0xd9, 0xf8, 0xd0,
+	0x31, 0xd6, 0xc4, 0x72, 0xeb, 0x35, 0x31, 0xed, 0x1e, 0x94, 0x50, 0xc4, 0x1a, 0xa1, 0x81, 0x8e,
+	0x54, 0xf2, 0x32, 0x72, 0x15, 0x4b, 0xf8, 0x3f, 0x93, 0xaf, 0x0a, 0xbf, 0x63, 0x2f, 0x6a, 0x09,
+	0x95, 0x79, 0x45, 0xf2, 0x72, 0xc4, 0xac, 0x5a, 0x54, 0x28, 0x84, 0xf6, 0x4b, 0x50, 0xc1, 0xc6,
+	0xd2, 0xc1, 0x83, 0x68, 0x19, 0x1e, 0x61, 0xb5, 0x31, 0xaf, 0xfd, 0x97, 0x0c, 0x54, 0x07, 0xbe,
+	0x89, 0x32, 0x02, 0x5d, 0xc8, 0x2f, 0x54, 0x1c, 0x51, 0xc4, 0x7b, 0x8e, 0x63, 0xc4, 0x6a, 0x57,
+	0x85, 0x25, 0x00, 0xf5, 0x1d, 0xc8, 0x4f, 0x1d, 0xe3, 0xb0, 0x99, 0x93, 0x0d, 0x4a, 0xa9, 0xfa,
+	0x28, 0x8d, 0xa7, 0x0d, 0x8c, 0x48, 0xb5, 0xdf, 0x83, 0xaa, 0x04, 0x4c, 0x1d, 0x3c, 0x5c, 0xa0,
+	0x43, 0xb0, 0x61, 0x5b, 0xc9, 0xe0, 0xc9, 0xc4, 0x6e, 0x67, 0xd8, 0xe6, 0x66, 0x24, 0x1a, 0x94,
+	0x43, 0xfd, 0x61, 0x97, 0x0d, 0x47, 0x4a, 0x9e, 0x4e, 0xd5, 0x08, 0xd0, 0x6b, 0x0d, 0xf1, 0x18,
+	0x02, 0xa0, 0x78, 0xd0, 0xef, 0xfe, 0xe2, 0xa0, 0xa3, 0x28, 0xda, 0x7f, 0xcc, 0x00, 0x24, 0xfe,
+	0x71, 0xf5, 0xa7, 0x50, 0x3d, 0xa1, 0x9c, 0x2e, 0x1d, 0x9c, 0xc8, 0x7d, 0x04, 0x8e, 0x26, 0xf5,
+	0xe3, 0x67, 0x92, 0x35, 0x81, 0x62, 0x76, 0xf5, 0x04, 0xa5, 0x3a, 0x4f, 0x24, 0xb4, 0xfa, 0x16,
+	0x94, 0x3d, 0xec, 0x07, 0x92, 0xe6, 0x64, 0x19, 0x2b, 0x75, 0x9f, 0x95, 0x3c, 0xdf, 0x8c, 0xc4,
+	0xf1, 0xd4, 0x8f, 0xbc, 0x46, 0x31, 0xe9, 0x43, 0x04, 0xb5, 0x1d, 0x63, 0x11, 0x58, 0x8c, 0xe3,
+	0x63, 0xb6, 0x5b, 0x48, 0xd8, 0xae, 0xf6, 0x25, 0x34, 0x86, 0xc6, 0x6c, 0xce, 0x99, 0x33, 0x75,
+	0x4c, 0x85, 0x3c, 0xae, 0x09, 0xb1, 0x18, 0x29, 0x8d, 0x5b, 0x6c, 0xdf, 0xf2, 0x27, 0x96, 0x1b,
+	0xed, 0xc8, 0x28, 0x8b, 0xcc, 0xf6, 0x20, 0xb0, 0xdd, 0x43, 0xe6, 0x9d, 0x44, 0x61, 0x2d, 0x51,
+	0x5e, 0xfb, 0xc7, 0x19, 0xa8, 0x4a, 0xcd, 0x50, 0xef, 0xa5, 0x8c, 0xc7, 0x97, 0x56, 0xda, 0xc9,
+	0xd3, 0x92, 0x11, 0xf9, 0x3a, 0x14, 0x82, 0xd0, 0xf0, 0xa3, 0xa3, 0x16, 0x45, 0x2a, 0xb1, 0xe3,
+	0x2d, 0x5c, 0x93, 0x71, 0x34, 0xfa, 0x91, 0x2d, 0xd7, 0x6c, 0xe6, 0xce, 0xa1, 0x42, 0xa4, 0xb6,
+	0x05, 0x95, 0xb8, 0x7a, 0x5c, 0x02, 0x6c, 0xf0, 0x6c, 0xa8, 0x5c, 0x50, 0x2b, 0x50, 0x60, 0xad,
+	0xfe, 0xa3, 0x8e, 0x92, 0xc1, 0x73, 0x3c, 0x48, 0x4a, 0xa9, 0x77, 0x53, 0xad, 0xbd, 0xbe, 0x5c,
+	0xeb, 0x5d, 0xfa, 0x2b, 0x35, 0xf6, 0x06, 0x54, 0x16, 0x2e, 0x01, 0x2d, 0x53, 0xc8, 0x9d, 0x04,
+	0x80, 0x41, 0x07, 0x51, 0x00, 0xcc, 0x52, 0xd0, 0xc1, 0x73, 0xc3, 0xd1, 0x3e, 0x82, 0x4a, 0x5c,
+	0x1d, 0xfa, 0x32, 0x1e, 0x0e, 0x7a, 0xbd, 0xc1, 0xb3, 0x6e, 0xff, 0x91, 0x72, 0x01, 0xb3, 0xfb,
+	0xac, 0xd3, 0xee, 0xec, 0x62, 0x36, 0x83, 0x6b, 0xb6, 0x7d, 0xc0, 0x58, 0xa7, 0x3f, 0xd2, 0xd9,
+	0xe0, 0x99, 0x92, 0xd5, 0xfe, 0x46, 0x1e, 0x36, 0x07, 0xee, 0xee, 0x62, 0xee, 0xd8, 0x13, 0x23,
+	0xb4, 0x9e, 0x58, 0x67, 0xed, 0xf0, 0x14, 0xc5, 0xa9, 0x11, 0x86, 0x3e, 0xdf, 0xcc, 0x15, 0xc6,
+	0x33, 0xdc, 0x17, 0x17, 0x58, 0x7e, 0x48, 0xae, 0x46, 0x79, 0x17, 0x37, 0x38, 0xbc, 0xed, 0x39,
+	0xb4, 0x97, 0xd5, 0x8f, 0xe1, 0x32, 0xf7, 0xdf, 0x71, 0x4a, 0xd4, 0x2f, 0x75, 0xc1, 0x7b, 0x96,
+	0x97, 0xae, 0xca, 0x09, 0xb1, 0x28, 0x92, 0x21, 0x0c, 0x5d, 0x52, 0x49, 0x71, 0x6e, 0x05, 0x54,
+	0x18, 0xc4, 0x84, 0xd4, 0x12, 0xf4, 0x37, 0x45, 0xad, 0xd6, 0xd1, 0xd7, 0x8d, 0x96, 0x51, 0x81,
+	0x35, 0xbc, 0xa4, 0x33, 0x28, 0x72, 0x3f, 0x87, 0xcd, 0x14, 0x25, 0xb5, 0x82, 0xdb, 0x46, 0x6f,
+	0x45, 0xae, 0xfa, 0xa5, 0xde, 0xcb, 0x10, 0x6c, 0x0e, 0x57, 0xfe, 0x36, 0xbc, 0x34, 0x14, 0x99,
+	0x99, 0x1d, 0xe8, 0xf6, 0xa1, 0xeb, 0xf9, 0x96, 0x60, 0xef, 0x65, 0x3b, 0xe8, 0x52, 0x3e, 0x31,
+	0x4f, 0xa4, 0x13, 0x67, 0x2e, 0x4d, 0xa2, 0x03, 0x57, 0x8e, 0xb6, 0xb9, 0xbc, 0xcc, 0xb3, 0x12,
+	0xe5, 0xbb, 0x26, 0x5a, 0xe6, 0x1c, 0x15, 0x59, 0x1c, 0x40, 0x16, 0x47, 0x8d, 0x80, 0x4f, 0x39,
+	0xec, 0x7a, 0x1f, 0x2e, 0xad, 0x6b, 0xe4, 0x1a, 0xbd, 0x6a, 0x4b, 0xd6, 0xab, 0x96, 0x7c, 0x55,
+	0x89, 0x8e, 0xf5, 0xaf, 0xb2, 0x50, 0xe9, 0xf2, 0x29, 0x0c, 0x4f, 0xf1, 0x84, 0xd2, 0xb7, 0xa6,
+	0xe7, 0x9d, 0xe6, 0x22, 0x0e, 0x5d, 0x93, 0x86, 0x69, 0xea, 0xc6, 0x74, 0x6a, 0x4d, 0x42, 0xcb,
+	0xd4, 0x51, 0x66, 0x8a, 0x65, 0xbb, 0x61, 0x98, 0x66, 0x4b, 0xc0, 0x69, 0xfb, 0x73, 0xaf, 0x44,
+	0x64, 0x26, 0x50, 0x3f, 0xc4, 0x66, 0x6f, 0xd8, 0x81, 0xb0, 0x12, 0x48, 0xc3, 0xc3, 0xf3, 0x14,
+	0xde, 0x77, 0xd3, 0x9a, 0x0a, 0x7e, 0xd4, 0x48, 0xab, 0xe5, 0x42, 0x02, 0x73, 0x7f, 0xd4, 0xc5,
+	0x65, 0x23, 0xd6, 0x36, 0xb9, 0x83, 0x3b, 0xcf, 0x36, 0xd3, 0x36, 0x6c, 0xd7, 0x0c, 0xce, 0xf7,
+	0x66, 0x14, 0xcf, 0xf5, 0x66, 0xa4, 0xdd, 0x24, 0xb8, 0xc8, 0x4a, 0xb4, 0xdc, 0x13, 0x76, 0xdc,
+	0x35, 0x4f, 0xb5, 0xbf, 0x9f, 0xc3, 0xa3, 0xb2, 0xb9, 0x63, 0x4c, 0xac, 0xff, 0x7f, 0x46, 0xef,
+	0x16, 0x3a, 0x24, 0x1c, 0x2b, 0xc4, 0x2d, 0xe6, 0x9a, 0x51, 0xac, 0x05, 0x07, 0xb5, 0x3d, 0x62,
+	0x60, 0x6b, 0x87, 0xb7, 0xf8, 0x83, 0x87, 0xb7, 0xf4, 0x03, 0x86, 0xb7, 0xbc, 0x3a, 0xbc, 0xea,
+	0xa7, 0xf0, 0xb2, 0x6f, 0x9d, 0xf8, 0x76, 0x68, 0xe9, 0x53, 0xdf, 0x9b, 0xe9, 0xa9, 0xed, 0x8c,
+	0xab, 0xbd, 0x42, 0xa3, 0x71, 0x4d, 0x10, 0x3d, 0xf4, 0xbd, 0x59, 0x7a, 0x4b, 0x6b, 0xff, 0x3b,
+	0x0f, 0xd5, 0x96, 0x6b, 0x38, 0x67, 0xdf, 0x58, 0x14, 0x8f, 0x41, 0x9e, 0xfa, 0xf9, 0x22, 0xe4,
+	0xe3, 0xce, 0xcf, 0x43, 0x2b, 0x04, 0xa1, 0x11, 0xbf, 0x05, 0x55, 0x6f, 0x11, 0xc6, 0x78, 0x7e,
+	0x42, 0x0a, 0x1c, 0x44, 0x04, 0x71, 0x79, 0xd2, 0x1a, 0x73, 0x52, 0x79, 0xb2, 0x20, 0x92, 0xf2,
+	0xb1, 0x56, 0x19, 0x97, 0x27, 0x02, 0xdc, 0xe2, 0xf6, 0x8c, 0x46, 0x3e, 0x58, 0xcc, 0x2c, 0x3e,
+	0xfa, 0x39, 0x1e, 0xf7, 0xd6, 0x16, 0x30, 0xac, 0x65, 0x66, 0xcd, 0x3c, 0xff, 0x8c, 0xd7, 0x52,
+	0xe4, 0xb5, 0x70, 0x10, 0xd5, 0xf2, 0x16, 0xa8, 0x27, 0x86, 0x1d, 0xea, 0xe9, 0xaa, 0xb8, 0x26,
+	0xaf, 0x20, 0x66, 0x24, 0x57, 0x77, 0x05, 0x8a, 0xa6, 0x1d, 0x1c, 0x77, 0x07, 0x42, 0x8b, 0x17,
+	0x39, 0xe4, 0x62, 0xc1, 0xfd, 0xee, 0x40, 0x1f, 0x9f, 0x89, 0x23, 0xcc, 0x1c, 0x2b, 0x23, 0x60,
+	0xe7, 0x2c, 0xa4, 0xc3, 0x17, 0x42, 0xf2, 0xde, 0x72, 0x86, 0xcf, 0x35, 0xf5, 0x06, 0xc2, 0xbb,
+	0x08, 0xe6, 0x0c, 0xff, 0x0e, 0x6c, 0x12, 0xa5, 0xe8, 0x38, 0x27, 0xad, 0x12, 0xe9, 0x06, 0x22,
+	0x06, 0x8b, 0x30, 0xa6, 0xbd, 0x01, 0x15, 0xd7, 0x0a, 0x4f, 0x3c, 0x1f, 0x5b, 0x53, 0xe3, 0xa3,
+	0x17, 0x03, 0x50, 0x25, 0x08, 0x26, 0x86, 0x8b, 0x8d, 0x6f, 0xd6, 0x45, 0x7b, 0x44, 0x1e, 0x55,
+	0x6a, 0x2e, 0x68, 0x08, 0xdb, 0xe0, 0x43, 0x92, 0x40, 0xd4, 0x0f, 0xe1, 0x5a, 0x6a, 0x34, 0x74,
+	0xc3, 0xf7, 0x8d, 0x33, 0x7d, 0x66, 0x7c, 0xe5, 0xf9, 0xe4, 0xfc, 0xc8, 0xb1, 0x2b, 0xf2, 0x20,
+	0xb7, 0x10, 0xbd, 0x87, 0xd8, 0x73, 0x8b, 0xda, 0xae, 0x87, 0xa7, 0xa2, 0xe7, 0x14, 0x45, 0x2c,
+	0x19, 0xec, 0x34, 0x40, 0x64, 0x7f, 0x04, 0x74, 0x52, 0x9a, 0x63, 0x55, 0x82, 0xed, 0x10, 0x48,
+	0xf3, 0x25, 0x57, 0xf8, 0xbe, 0xbf, 0x70, 0x2d, 0xee, 0x3c, 0xa0, 0xa4, 0x29, 0x4e, 0x12, 0xe3,
+	0xbc, 0xba, 0x0b, 0x17, 0xb9, 0x21, 0x61, 0x99, 0xba, 0xe4, 0x22, 0xce, 0x9e, 0xef, 0x22, 0x56,
+	0x23, 0xfa, 0x18, 0x1c, 0x68, 0xdf, 0x66, 0xe0, 0xfa, 0x80, 0x4e, 0x35, 0x69, 0xc7, 0xed, 0x59,
+	0x41, 0x60, 0x1c, 0xa2, 0x15, 0xf8, 0x70, 0xf1, 0xcd, 0x37, 0xe8, 0x43, 0xd8, 0xd8, 0x37, 0x7c,
+	0xcb, 0x0d, 0xe3, 0xfd, 0x28, 0xc4, 0xc6, 0x32, 0x58, 0x7d, 0x40, 0x6e, 0x58, 0xcb, 0x0d, 0x0f,
+	0x62, 0x01, 0xdc, 0xcc, 0xae, 0x71, 0xcc, 0xad, 0x50, 0x69, 0xff, 0xeb, 0x25, 0xc8, 0xf7, 0x3d,
+	0xd3, 0x52, 0xdf, 0x86, 0x0a, 0x45, 0xbd, 0xad, 0x7a, 0xff, 0x11, 0x4d, 0x7f, 0x48, 0x17, 0x2a,
+	0xbb, 0x22, 0x75, 0x7e, 0x9c, 0xdc, 0x2b, 0xa4, 0xd5, 0xd1, 0xf1, 0x21, 0x72, 0xb8, 0xaa, 0xb0,
+	0x33, 0x11, 0xc4, 0x38, 0x06, 0xc7, 0x96, 0x5c, 0x62, 0xbe, 0xe5, 0x92, 0xee, 0x50, 0x60, 0x71,
+	0x9e, 0x74, 0x69, 0xdf, 0x43, 0x6e, 0xac, 0x53, 0xa8, 0x48, 0x61, 0x8d, 0x2e, 0xcd, 0xf1, 0x14,
+	0x38, 0xf8, 0x36, 0x54, 0xbe, 0xf2, 0x6c, 0x97, 0x37, 0xbc, 0xb8, 0xd2, 0xf0, 0xcf, 0x3c, 0x9b,
+	0x1f, 0x5b, 0x94, 0xbf, 0x12, 0x29, 0xf5, 0x55, 0x28, 0x79, 0x2e, 0xaf, 0xbb, 0xb4, 0x52, 0x77,
+	0xd1, 0x73, 0x7b, 0x3c, 0x04, 0xa5, 0x3e, 0x5e, 0xa0, 0xd3, 0x0e, 0x49, 0xad, 0x69, 0x28, 0xbc,
+	0xf4, 0x55, 0x02, 0x0e, 0xdc, 0x9e, 0x35, 0xc5, 0xe0, 0x82, 0xea, 0xd4, 0x76, 0x90, 0xe9, 0x53,
+	0x65, 0x95, 0x95, 0xca, 0x80, 0xa3, 0xa9, 0xc2, 0x9f, 0x40, 0xf9, 0xd0, 0xf7, 0x16, 0x73, 0xd4,
+	0xf9, 0x61, 0x85, 0xb2, 0x44, 0xb8, 0x9d, 0x33, 0xec, 0x3d, 0x25, 0x6d, 0xf7, 0x50, 0x47, 0xa7,
+	0x51, 0x75, 0xb5, 0xf7, 0x11, 0x7e, 0x68, 0x51, 0xad, 0xc6, 0xe1, 0xa1, 0x2e, 0x62, 0x6a, 0x56,
+	0x6a, 0x35, 0x0e, 0x0f, 0xe9, 0xe3, 0x77, 0xa1, 0x7e, 0x82, 0x07, 0xea, 0x73, 0x6b, 0xc2, 0x69,
+	0xeb, 0xab, 0xd5, 0x9e, 0xd8, 0x2e, 0xda, 0x07, 0x44, 0x2f, 0x1b, 0x28, 0x8d, 0x17, 0x1a, 0x28,
+	0x5b, 0x50, 0x70, 0xec, 0x99, 0x1d, 0x52, 0xd0, 0xc2, 0x92, 0x06, 0x43, 0x08, 0x55, 0x83, 0xa2,
+	0x70, 0x82, 0x29, 0x2b, 0x24, 0x02, 0x93, 0x16, 0x8e, 0x9b, 0x2f, 0x10, 0x8e, 0xb7, 0x01, 0xa3,
+	0x03, 0x75, 0x14, 0xe3, 0xea, 0x7a, 0x31, 0x5e, 0xf4, 0xc6, 0x5f, 0x61, 0x10, 0xe4, 0x7b, 0x74,
+	0x52, 0x60, 0xb9, 0xa1, 0x1e, 0x15, 0xb8, 0xb8, 0xbe, 0x40, 0x8d, 0x93, 0x0d, 0x78, 0xb1, 0x77,
+	0xa0, 0xea, 0x93, 0xe5, 0xac, 0x93, 0x99, 0x7d, 0x49, 0x36, 0x3d, 0x12, 0x93, 0x9a, 0x81, 0x1f,
+	0xa7, 0x51, 0x68, 0xf0, 0xe8, 0x03, 0x7e, 0xdc, 0x1c, 0x90, 0xb3, 0xb5, 0xc2, 0x6a, 0x04, 0xe4,
+	0x47, 0xd1, 0x01, 0x9e, 0xd1, 0x45, 0x52, 0x3d, 0x3c, 0x6d, 0x5e, 0x95, 0x9b, 0xc2, 0x4f, 0x5b,
+	0xdb, 0xe1, 0x29, 0xab, 0x98, 0x51, 0x12, 0x59, 0xd7, 0xd8, 0x76, 0x4d, 0x5c, 0x0e, 0xa1, 0x71,
+	0x18, 0x34, 0x9b, 0xb4, 0x5b, 0xaa, 0x02, 0x36, 0x32, 0x0e, 0x03, 0xf5, 0x5d, 0xa8, 0x19, 0x5c,
+	0x76, 0xf2, 0xa8, 0xc7, 0x6b, 0xb2, 0x99, 0x28, 0x49, 0x55, 0x56, 0x35, 0x92, 0x8c, 0xfa, 0x01,
+	0xa8, 0x91, 0x87, 0x9d, 0x54, 0x6e, 0xbe, 0x2e, 0xae, 0xaf, 0xac, 0x8b, 0x0d, 0xe1, 0x62, 0x8f,
+	0x23, 0x75, 0x3f, 0x80, 0x7a, 0x5a, 0xd7, 0xb9, 0xb1, 0xc6, 0xa7, 0x4c, 0x53, 0xc6, 0x6a, 0x13,
+	0x29, 0x87, 0xe3, 0x83, 0x91, 0x3e, 0x13, 0x63, 0x72, 0x64, 0x51, 0x41, 0xee, 0x37, 0xad, 0xb9,
+	0x5e, 0xd8, 0x8e, 0x60, 0x38, 0x3e, 0x91, 0x05, 0x13, 0x9e, 0x36, 0x6f, 0xca, 0xe3, 0x13, 0xab,
+	0xbf, 0x28, 0xca, 0x45, 0x92, 0xe6, 0x89, 0x6b, 0x76, 0x54, 0xe0, 0x56, 0x6a, 0x9e, 0x62, 0x95,
+	0x8f, 0x81, 0x1f, 0xa7, 0x29, 0x14, 0xd5, 0x5b, 0xf8, 0x13, 0x4b, 0x0f, 0x42, 0x6b, 0xde, 0xdc,
+	0xa2, 0x11, 0x05, 0x0e, 0x1a, 0x86, 0xd6, 0x5c, 0x7d, 0x00, 0x8d, 0xb9, 0x6f, 0xe9, 0xd2, 0x3c,
+	0xbd, 0x22, 0x77, 0x71, 0xdf, 0xb7, 0x92, 0xa9, 0xaa, 0xcd, 0xa5, 0x5c, 0x54, 0x52, 0xea, 0x81,
+	0xb6, 0x54, 0x32, 0xe9, 0x44, 0x6d, 0x2e, 0xe5, 0xd4, 0x4f, 0x60, 0x53, 0x2a, 0xb9, 0x38, 0xa6,
+	0xc2, 0xaf, 0xa6, 0x5c, 0xfc, 0x11, 0xf9, 0xc1, 0x31, 0x16, 0x6f, 0xcc, 0x53, 0x79, 0xb5, 0x05,
+	0xca, 0x8a, 0xde, 0xf5, 0x1a, 0x95, 0xbf, 0x7a, 0x8e, 0x15, 0x95, 0xb2, 0xc4, 0x9e, 0x70, 0x0f,
+	0x6f, 0x37, 0xe8, 0xb8, 0x66, 0xf3, 0x27, 0x3c, 0x9c, 0x9e, 0x32, 0xea, 0x7d, 0xa8, 0x91, 0x1b,
+	0x2f, 0xa4, 0x50, 0xbe, 0xa0, 0xf9, 0xba, 0xec, 0x71, 0x22, 0x9f, 0x38, 0x21, 0x58, 0xd5, 0x89,
+	0xd3, 0x81, 0xfa, 0x3e, 0x6c, 0x72, 0xe7, 0x9f, 0xcc, 0x20, 0xdf, 0x58, 0x5d, 0x5c, 0x44, 0xf4,
+	0x30, 0xe1, 0x92, 0x0c, 0xae, 0xf9, 0x0b, 0x97, 0xe4, 0xbc, 0x28, 0x39, 0xf7, 0xbd, 0xb1, 0xc5,
+	0xcb, 0xdf, 0xde, 0xca, 0x25, 0xdd, 0x61, 0x9c, 0x8c, 0x97, 0x25, 0x7e, 0x74, 0xc5, 0x97, 0x41,
+	0xfb, 0x58, 0xee, 0x9c, 0x3a, 0x39, 0x67, 0xa7, 0x3a, 0xdf, 0xfc, 0x21, 0x75, 0xee, 0x60, 0x39,
+	0xaa, 0x53, 0x85, 0xfc, 0x62, 0x61, 0x9b, 0xcd, 0x3b, 0x3c, 0xc8, 0x0f, 0xd3, 0x78, 0x26, 0xe9,
+	0x5b, 0x93, 0x85, 0x1f, 0xd8, 0xcf, 0x2d, 0x3d, 0xb0, 0xdd, 0xe3, 0xe6, 0x4f, 0x69, 0x1c, 0xeb,
+	0x31, 0x74, 0x68, 0xbb, 0xc7, 0xb8, 0x62, 0xad, 0xd3, 0xd0, 0xf2, 0x5d, 0x1d, 0xb5, 0xa6, 0xe6,
+	0x5b, 0xf2, 0x8a, 0xed, 0x10, 0x62, 0x38, 0x31, 0x5c, 0x06, 0x56, 0x9c, 0x56, 0x3f, 0x86, 0x8d,
+	0x44, 0x0b, 0x9f, 0xa3, 0x0a, 0xd2, 0xfc, 0xd9, 0xda, 0xd3, 0x1f, 0x52, 0x4f, 0x58, 0x63, 0x9e,
+	0xca, 0x2f, 0xad, 0xad, 0x80, 0xaf, 0xad, 0xbb, 0xdf, 0x6b, 0x6d, 0x0d, 0x31, 0xaf, 0xbe, 0x0e,
+	0x65, 0xdb, 0x0d, 0x2d, 0x1f, 0x3d, 0x1c, 0xf7, 0x56, 0x18, 0x78, 0x8c, 0xc3, 0xa3, 0xdf, 0xc0,
+	0xb1, 0x91, 0x31, 0x35, 0xdf, 0x5e, 0x21, 0x8b, 0x50, 0x28, 0xb1, 0xa7, 0xb6, 0xe3, 0x70, 0x89,
+	0xfd, 0xce, 0x8a, 0xc4, 0x7e, 0x68, 0x3b, 0x0e, 0x97, 0xd8, 0x53, 0x91, 0x42, 0x29, 0x47, 0x25,
+	0xf0, 0xfb, 0xdb, 0xab, 0x52, 0x0e, 0x71, 0x4f, 0xe9, 0x7e, 0x4c, 0x35, 0x20, 0x5f, 0x17, 0x77,
+	0xd9, 0xdd, 0x97, 0x7b, 0x98, 0x76, 0x82, 0x31, 0x08, 0xe2, 0x3c, 0x1a, 0x0b, 0xc2, 0xd3, 0x87,
+	0x06, 0xce, 0xbb, 0x3c, 0x6c, 0x9b, 0x43, 0xd0, 0xba, 0x79, 0x1b, 0xea, 0x51, 0x34, 0x0b, 0x7e,
+	0x2e, 0x68, 0xbe, 0xb7, 0xd2, 0x82, 0x34, 0x81, 0xba, 0x0b, 0xb5, 0x29, 0x6a, 0x70, 0x33, 0xae,
+	0xd0, 0x35, 0xdf, 0xa7, 0x86, 0x6c, 0x45, 0x12, 0xf4, 0x3c, 0x85, 0x8f, 0xa5, 0x4a, 0xa9, 0x77,
+	0x41, 0xb5, 0xa7, 0x7c, 0x16, 0xd0, 0x62, 0xe2, 0x4a, 0x5b, 0xf3, 0x03, 0x5a, 0x52, 0x6b, 0x30,
+	0xea, 0x7d, 0xa8, 0x07, 0x96, 0x6b, 0x62, 0xac, 0x00, 0x5f, 0xda, 0x0f, 0xb6, 0x72, 0x09, 0xf3,
+	0x8c, 0x6f, 0x87, 0xa1, 0x0b, 0xdc, 0x35, 0xf7, 0x02, 0xae, 0x18, 0xdc, 0x07, 0x5c, 0x9d, 0xcf,
+	0x93, 0x42, 0x1f, 0x9e, 0x53, 0x08, 0xa9, 0xa4, 0x42, 0xb8, 0x74, 0xf5, 0xc0, 0x35, 0xe6, 0xc1,
+	0x91, 0x17, 0x36, 0x3f, 0x92, 0xa5, 0xf5, 0x50, 0x40, 0x59, 0x0d, 0x89, 0xa2, 0x9c, 0xf6, 0xcb,
+	0x02, 0x94, 0x23, 0x2d, 0x12, 0x43, 0x7f, 0x0e, 0xfa, 0x4f, 0xfa, 0x83, 0x67, 0x7d, 0xe5, 0x02,
+	0x3a, 0x65, 0x29, 0x94, 0x5b, 0x1f, 0xb6, 0x5b, 0x7d, 0x7e, 0xf5, 0x81, 0x02, 0xc8, 0x79, 0x3e,
+	0xab, 0x6e, 0x42, 0xfd, 0xe1, 0x41, 0x9f, 0x42, 0x7f, 0x38, 0x28, 0x87, 0xa0, 0xce, 0xe7, 0xdc,
+	0xf3, 0xcb, 0x41, 0x18, 0xf4, 0x5d, 0xdf, 0x6b, 0x8d, 0x3a, 0xac, 0x1b, 0x81, 0x0a, 0x14, 0x45,
+	0x34, 0x38, 0x60, 0x6d, 0x51, 0x53, 0x11, 0x3f, 0xbb, 0xcf, 0x06, 0x9f, 0x75, 0xda, 0x23, 0x05,
+	0xd4, 0xcb, 0xb0, 0x19, 0xd7, 0x11, 0xd5, 0xaf, 0x54, 0xd1, 0xa9, 0x1c, 0xd5, 0xa3, 0x5c, 0xc2,
+	0x5a, 0x59, 0xa7, 0x7d, 0xc0, 0x86, 0xdd, 0xa7, 0x1d, 0xbd, 0x3d, 0xea, 0x28, 0x97, 0xd1, 0xb7,
+	0x38, 0xec, 0xf6, 0x9f, 0x28, 0x57, 0xd0, 0x73, 0x87, 0x29, 0x5e, 0xfb, 0x55, 0x55, 0x85, 0x46,
+	0x42, 0x4b, 0xb0, 0x26, 0x39, 0xa5, 0x1f, 0x3d, 0x52, 0x6e, 0x62, 0xb5, 0xbb, 0xdd, 0xe1, 0xa8,
+	0xdb, 0x6f, 0x8f, 0x94, 0x5b, 0xe8, 0x77, 0x7e, 0xd8, 0xed, 0x8d, 0x3a, 0x4c, 0xd9, 0xc2, 0xfa,
+	0x3e, 0x1b, 0x74, 0xfb, 0xca, 0x2b, 0x08, 0x1d, 0xb6, 0xf6, 0xf6, 0x7b, 0x1d, 0x45, 0xa3, 0xaf,
+	0x0c, 0xd8, 0x48, 0x79, 0x15, 0x3d, 0x98, 0x07, 0x7d, 0x6c, 0xdb, 0x6b, 0xf8, 0x41, 0x4a, 0xea,
+	0x78, 0xdb, 0xe3, 0x27, 0x92, 0xf7, 0xfa, 0x75, 0x4c, 0x3f, 0xeb, 0xf6, 0x77, 0x07, 0xcf, 0x94,
+	0x37, 0x90, 0x6c, 0x87, 0x0d, 0x5a, 0xbb, 0x6d, 0x74, 0x72, 0xdf, 0xc6, 0x0a, 0x86, 0xfb, 0xbd,
+	0xee, 0x48, 0x79, 0x13, 0xa9, 0x1e, 0xb5, 0x46, 0x8f, 0x3b, 0x4c, 0xb9, 0x83, 0xe9, 0xd6, 0x70,
+	0xd8, 0x61, 0x23, 0x65, 0x1b, 0xd3, 0xdd, 0x3e, 0xa5, 0xef, 0x63, 0x7a, 0xb7, 0xd3, 0xeb, 0x8c,
+	0x3a, 0xca, 0xbb, 0x38, 0x60, 0xac, 0xb3, 0xdf, 0x6b, 0xb5, 0x3b, 0xca, 0x7b, 0x98, 0xe9, 0x0d,
+	0xda, 0x4f, 0xf4, 0xc1, 0xbe, 0xf2, 0x3e, 0x7e, 0x83, 0x7c, 0xef, 0x43, 0x1c, 0xcc, 0x0f, 0x70,
+	0x9c, 0xe2, 0x2c, 0xb5, 0xee, 0x01, 0x7e, 0x76, 0xaf, 0xdb, 0x3f, 0x18, 0x2a, 0x1f, 0x22, 0x31,
+	0x25, 0x09, 0xf3, 0x91, 0x7a, 0x09, 0x94, 0x41, 0x5f, 0xdf, 0x3d, 0xd8, 0xef, 0x75, 0xdb, 0xad,
+	0x51, 0x47, 0x7f, 0xd2, 0xf9, 0x42, 0xf9, 0x1d, 0x9c, 0xf6, 0x7d, 0xd6, 0xd1, 0x45, 0x3b, 0x7e,
+	0x1e, 0xe5, 0x45, 0x5b, 0x3e, 0xc6, 0x4f, 0x24, 0x78, 0xfd, 0xe0, 0x89, 0xf2, 0xbb, 0x4b, 0xa0,
+	0xe1, 0x13, 0xe5, 0x13, 0x9c, 0xf3, 0x51, 0x77, 0xaf, 0xa3, 0x8b, 0xc1, 0xc0, 0x6b, 0x03, 0xf9,
+	0x87, 0xdd, 0x5e, 0x4f, 0x69, 0x91, 0xa3, 0xb5, 0xc5, 0x46, 0x5d, 0x9a, 0xe8, 0x1d, 0xbc, 0x82,
+	0xf0, 0xf0, 0xe0, 0xcb, 0x2f, 0xbf, 0xd0, 0xc5, 0x4c, 0xb4, 0xb5, 0xdf, 0x87, 0x72, 0x64, 0x2e,
+	0x60, 0xeb, 0xbb, 0xfd, 0x7e, 0x07, 0xaf, 0xe5, 0x94, 0x21, 0xdf, 0xeb, 0x3c, 0x1c, 0x29, 0x19,
+	0x04, 0xb2, 0xee, 0xa3, 0xc7, 0x23, 0x25, 0x8b, 0xc9, 0xc1, 0x01, 0x16, 0xcb, 0xd1, 0x54, 0x75,
+	0xf6, 0xba, 0x4a, 0x1e, 0x53, 0xad, 0xfe, 0xa8, 0xab, 0x14, 0x68, 0x2a, 0xbb, 0xfd, 0x47, 0xbd,
+	0x8e, 0x52, 0x44, 0xe8, 0x5e, 0x8b, 0x3d, 0x51, 0x4a, 0x58, 0xa8, 0xb5, 0xbf, 0xdf, 0xfb, 0x42,
+	0x29, 0xf3, 0xfa, 0x77, 0x3b, 0x9f, 0x2b, 0x15, 0xbc, 0xda, 0xd3, 0xdb, 0x56, 0x40, 0xbb, 0x0d,
+	0xa5, 0xd6, 0xe1, 0xe1, 0x1e, 0x5a, 0x63, 0xd8, 0x68, 0x8c, 0x84, 0xa3, 0x3b, 0x41, 0x3b, 0x83,
+	0xd1, 0x68, 0xb0, 0xa7, 0x64, 0x70, 0x31, 0x8d, 0x06, 0xfb, 0x4a, 0x56, 0xeb, 0x42, 0x39, 0xe2,
+	0x92, 0xd2, 0x3d, 0x8c, 0x32, 0xe4, 0xf7, 0x59, 0xe7, 0x29, 0x3f, 0x01, 0xe9, 0x77, 0x3e, 0xc7,
+	0x66, 0x62, 0x0a, 0x2b, 0xca, 0xe1, 0x07, 0xf9, 0x85, 0x09, 0xba, 0x88, 0xd1, 0xeb, 0xf6, 0x3b,
+	0x2d, 0xa6, 0x14, 0xb4, 0xbf, 0x0a, 0xe5, 0x68, 0x8b, 0xaa, 0xaf, 0x41, 0x76, 0x34, 0x14, 0x6e,
+	0xb1, 0x4b, 0x77, 0x93, 0xcb, 0xb1, 0xa3, 0x28, 0xc5, 0xb2, 0xa3, 0xa1, 0xfa, 0x16, 0x14, 0xf9,
+	0xd5, 0x98, 0x66, 0x36, 0xc5, 0x60, 0x45, 0x2d, 0x23, 0xc2, 0x31, 0x41, 0xa3, 0xf5, 0xa0, 0x91,
+	0xc6, 0xa0, 0x8b, 0x80, 0xe3, 0x24, 0x8b, 0x56, 0x82, 0xa0, 0x6d, 0xc8, 0x73, 0xdd, 0x5d, 0x11,
+	0xab, 0x13, 0xe7, 0xb5, 0xff, 0x91, 0x01, 0x48, 0x64, 0x24, 0x4a, 0xe1, 0xd8, 0x5e, 0x2d, 0x08,
+	0x37, 0xbd, 0x1c, 0x7e, 0x5f, 0xe1, 0xc7, 0x60, 0xe8, 0x5a, 0x99, 0x7a, 0xfe, 0xcc, 0x08, 0xa3,
+	0x8b, 0x37, 0x3c, 0x87, 0x1a, 0x29, 0xf7, 0x0e, 0xa3, 0x32, 0xe0, 0x5a, 0x3c, 0x8a, 0x2c, 0xcf,
+	0x6a, 0x02, 0xd8, 0x43, 0x18, 0xaa, 0x8b, 0x96, 0x3b, 0x71, 0xbc, 0xc0, 0x32, 0xd1, 0x1c, 0x2a,
+	0x90, 0xc4, 0x87, 0x08, 0xb4, 0x73, 0xc6, 0x3b, 0xe4, 0xcf, 0x6c, 0xd7, 0x08, 0x2d, 0x53, 0x84,
+	0xb2, 0x48, 0x10, 0x74, 0xe0, 0xe0, 0x75, 0x48, 0x2e, 0xef, 0x78, 0x00, 0x4f, 0x19, 0x01, 0x34,
+	0x7d, 0x2f, 0x03, 0x58, 0xc1, 0xc4, 0x98, 0xf3, 0xca, 0xcb, 0x54, 0x79, 0x45, 0x40, 0x76, 0xce,
+	0xb4, 0x7f, 0x9a, 0x03, 0x48, 0x74, 0xac, 0x94, 0x57, 0x3a, 0x93, 0xf6, 0x4a, 0x6f, 0xc3, 0x15,
+	0x11, 0x5c, 0x2e, 0x22, 0x96, 0x4f, 0x75, 0xdb, 0xd5, 0xc7, 0x46, 0x74, 0x00, 0xa0, 0x0a, 0x2c,
+	0x3f, 0xe8, 0xee, 0xba, 0x3b, 0x46, 0xa8, 0x3e, 0x80, 0x0d, 0xb9, 0x0c, 0xc6, 0xea, 0xe7, 0xce,
+	0x89, 0xd5, 0xaf, 0x27, 0xc5, 0x47, 0x67, 0x73, 0xf5, 0x6d, 0xb8, 0xec, 0x5b, 0x53, 0xdf, 0x0a,
+	0x8e, 0xf4, 0x30, 0x90, 0x3f, 0xc6, 0x4f, 0xd5, 0x37, 0x05, 0x72, 0x14, 0xc4, 0xdf, 0x7a, 0x1b,
+	0x2e, 0x0b, 0xed, 0x6b, 0xa9, 0x79, 0xfc, 0x62, 0xdc, 0x26, 0x47, 0xca, 0xad, 0x7b, 0x19, 0x40,
+	0x28, 0x9e, 0xd1, 0x75, 0xe8, 0x32, 0xab, 0x70, 0x25, 0x13, 0x2d, 0x85, 0xb7, 0x40, 0xb5, 0x03,
+	0x7d, 0xc9, 0xa3, 0x29, 0xdc, 0xfc, 0x8a, 0x1d, 0xec, 0xa7, 0xbc, 0x99, 0xe7, 0x39, 0x4b, 0xcb,
+	0xe7, 0x39, 0x4b, 0x2f, 0x41, 0x81, 0x74, 0x53, 0xe1, 0xbb, 0xe4, 0x19, 0x55, 0x83, 0x3c, 0xee,
+	0x4f, 0x72, 0xb1, 0x35, 0xb6, 0x1b, 0x77, 0x11, 0x48, 0x3a, 0x30, 0x42, 0x19, 0xe1, 0xb4, 0x3f,
+	0xca, 0x40, 0x23, 0xad, 0x4f, 0xf1, 0xc8, 0xb1, 0x24, 0x24, 0xae, 0x90, 0x84, 0xc1, 0xbd, 0x04,
+	0x95, 0xf9, 0xb1, 0x88, 0x7f, 0x8b, 0xce, 0x5b, 0xe7, 0xc7, 0x3c, 0xee, 0x4d, 0x7d, 0x13, 0x4a,
+	0xf3, 0x63, 0xbe, 0x6c, 0xce, 0x9b, 0x96, 0xe2, 0x9c, 0x87, 0xa4, 0xbc, 0x09, 0xa5, 0x85, 0x20,
+	0xcd, 0x9f, 0x47, 0xba, 0x20, 0x52, 0x6d, 0x0b, 0x6a, 0xb2, 0x05, 0x83, 0x27, 0x12, 0xa8, 0xf7,
+	0xf0, 0x86, 0x61, 0x12, 0x7b, 0x50, 0x93, 0x4d, 0x95, 0xef, 0xe3, 0x30, 0x4f, 0x59, 0xef, 0xd9,
+	0x17, 0x58, 0xef, 0x5b, 0x74, 0xb0, 0xae, 0x53, 0x84, 0x0c, 0x86, 0xd5, 0x72, 0x6f, 0x39, 0x1c,
+	0x19, 0x41, 0x6b, 0x11, 0x7a, 0x6d, 0xcf, 0x11, 0x47, 0x37, 0x22, 0xe4, 0x38, 0x1f, 0x79, 0xdf,
+	0x44, 0x4c, 0xf1, 0x3f, 0xcc, 0xc0, 0xe6, 0x8a, 0xaa, 0x8e, 0xfd, 0x48, 0x6e, 0xbc, 0x63, 0x12,
+	0x6d, 0xe7, 0x99, 0x11, 0x4e, 0x8e, 0xf4, 0xb9, 0x6f, 0x4d, 0xed, 0xd3, 0xe8, 0xda, 0x3e, 0xc1,
+	0xf6, 0x09, 0x44, 0xe7, 0x58, 0xf3, 0x39, 0x19, 0x28, 0xe8, 0xc0, 0xe0, 0xd7, 0x53, 0x81, 0x40,
+	0x3d, 0x84, 0xc4, 0x67, 0xdc, 0xf9, 0x73, 0xce, 0xb8, 0xf1, 0xca, 0x86, 0xe1, 0x9a, 0x8e, 0xf0,
+	0x11, 0x97, 0x59, 0x94, 0xd5, 0x6e, 0x40, 0xb1, 0x1b, 0x1b, 0x0b, 0xf1, 0xdd, 0xd6, 0x9c, 0xb8,
+	0xcf, 0xea, 0x41, 0xa5, 0x4d, 0x77, 0x63, 0xf7, 0x8c, 0xb9, 0x7a, 0x07, 0xef, 0x3b, 0xcd, 0xc5,
+	0xb9, 0x7c, 0x33, 0x76, 0xd9, 0x71, 0xec, 0xdd, 0x3d, 0x63, 0xce, 0x0f, 0xb8, 0x90, 0xe8, 0xfa,
+	0xfb, 0x50, 0x8e, 0x00, 0x3f, 0x28, 0x48, 0xe7, 0xbf, 0xe6, 0xa0, 0xb2, 0x2b, 0xbb, 0x15, 0x50,
+	0x83, 0x0b, 0xfd, 0x85, 0x8b, 0xd6, 0x9f, 0x70, 0x70, 0x56, 0xd1, 0x8d, 0x2b, 0x40, 0xd1, 0xa4,
+	0x67, 0xbf, 0x63, 0xd2, 0x6f, 0x00, 0xfa, 0x3f, 0x74, 0xdb, 0x24, 0xcd, 0x39, 0x17, 0x87, 0x0b,
+	0x74, 0x4d, 0x54, 0x9c, 0xd7, 0x9e, 0xa1, 0xe4, 0xbf, 0xff, 0x19, 0x4a, 0x61, 0xed, 0x19, 0xca,
+	0xff, 0x33, 0xa7, 0x1e, 0xaf, 0x27, 0xcc, 0x13, 0xc3, 0xc3, 0x91, 0xac, 0x42, 0x64, 0x11, 0xab,
+	0x7c, 0x62, 0x9d, 0x21, 0xdd, 0x47, 0xd0, 0x88, 0x86, 0x59, 0x74, 0x0c, 0x52, 0x01, 0x8d, 0x02,
+	0x47, 0x9f, 0x67, 0xf5, 0x50, 0xce, 0xa6, 0x77, 0x55, 0xf5, 0xbb, 0x77, 0x95, 0xf6, 0xa7, 0x59,
+	0x28, 0xfc, 0x02, 0x6f, 0xee, 0xa9, 0xef, 0x43, 0x25, 0x08, 0x67, 0xa1, 0xec, 0xcc, 0xbd, 0xc6,
+	0x8b, 0x11, 0x9e, 0x7c, 0xb1, 0x16, 0x46, 0xae, 0x72, 0x3b, 0x0b, 0x69, 0x31, 0x85, 0xab, 0x07,
+	0x5d, 0x22, 0xdc, 0x79, 0x5c, 0x60, 0x3c, 0x83, 0xee, 0x3d, 0xf4, 0xec, 0x06, 0xe9, 0xa3, 0x61,
+	0xd4, 0xe5, 0x19, 0x47, 0xa0, 0x7b, 0x4f, 0xdc, 0x7d, 0xc8, 0xaf, 0x3a, 0x54, 0x39, 0x86, 0xa2,
+	0xb6, 0x2c, 0x03, 0x0d, 0xc0, 0xe8, 0x8a, 0x4b, 0x9c, 0xc7, 0x4d, 0xe4, 0x78, 0x86, 0x39, 0x32,
+	0x0e, 0xa3, 0x2b, 0x60, 0x22, 0x8b, 0xb2, 0xd5, 0xb4, 0x42, 0x6b, 0x12, 0x0e, 0xbf, 0x76, 0xa2,
+	0x29, 0x93, 0x20, 0x9a, 0x09, 0xf5, 0x54, 0x67, 0xd2, 0x96, 0x05, 0x6a, 0x61, 0x9d, 0x1e, 0x6a,
+	0xa8, 0x19, 0x49, 0xc5, 0xcd, 0xca, 0x6a, 0x6d, 0x4e, 0xd2, 0x77, 0x49, 0x33, 0x3a, 0xd8, 0xdf,
+	0x6d, 0x8d, 0x3a, 0x4a, 0x81, 0xf4, 0xd7, 0x0e, 0x7b, 0xd4, 0x51, 0x8a, 0xda, 0x1f, 0x67, 0x61,
+	0x73, 0xe4, 0x1b, 0x6e, 0x60, 0xf0, 0xa8, 0x64, 0x37, 0xf4, 0x3d, 0x47, 0xfd, 0x08, 0xca, 0xe1,
+	0xc4, 0x91, 0x07, 0xf9, 0x56, 0x34, 0xa5, 0x4b, 0xa4, 0x77, 0x47, 0x13, 0x6e, 0xd2, 0x96, 0x42,
+	0x9e, 0x50, 0x7f, 0x06, 0x85, 0xb1, 0x75, 0x68, 0xbb, 0x62, 0x7b, 0x5d, 0x5e, 0x2e, 0xb8, 0x83,
+	0x48, 0x7c, 0xfb, 0x82, 0xa8, 0xd4, 0xb7, 0xf1, 0xc6, 0xde, 0x2c, 0xe2, 0x50, 0x49, 0x00, 0xa5,
+	0xf4, 0x21, 0xc4, 0xe2, 0xfb, 0x16, 0x9c, 0x4e, 0x7d, 0x1f, 0xaf, 0x9e, 0x3b, 0xce, 0xd8, 0x98,
+	0x1c, 0x0b, 0xde, 0xd5, 0x5c, 0x2e, 0xc3, 0x04, 0xfe, 0xf1, 0x05, 0x16, 0xd3, 0x6a, 0x77, 0xa1,
+	0x24, 0x1a, 0x8b, 0x03, 0xb0, 0xd3, 0x79, 0xd4, 0x15, 0x03, 0xd9, 0x1e, 0xec, 0xed, 0x75, 0x47,
+	0xfc, 0xa6, 0x06, 0x1b, 0xf4, 0x7a, 0x3b, 0xad, 0xf6, 0x13, 0x25, 0xbb, 0x53, 0x86, 0xa2, 0x41,
+	0x41, 0x7f, 0xda, 0xdf, 0xcc, 0xc0, 0xc6, 0x52, 0x07, 0xd4, 0x07, 0x90, 0x9f, 0x79, 0x66, 0x34,
+	0x3c, 0xaf, 0xad, 0xed, 0xa5, 0x94, 0xe7, 0x52, 0x14, 0x4b, 0x68, 0x1f, 0x42, 0x23, 0x0d, 0x97,
+	0x14, 0xdd, 0x3a, 0x54, 0x58, 0xa7, 0xb5, 0xab, 0x0f, 0xfa, 0xbd, 0x2f, 0xb8, 0xbd, 0x48, 0xd9,
+	0x67, 0xac, 0x3b, 0xea, 0x28, 0x59, 0xed, 0xf7, 0x40, 0x59, 0x1e, 0x18, 0xf5, 0x11, 0x6c, 0xe0,
+	0x35, 0x0d, 0xc7, 0xe2, 0x6c, 0x20, 0x99, 0xb2, 0x9b, 0x6b, 0x46, 0x52, 0x90, 0xd1, 0x8c, 0x35,
+	0x26, 0xa9, 0xbc, 0xf6, 0x57, 0x40, 0x5d, 0x1d, 0xc1, 0xdf, 0x5e, 0xf5, 0xff, 0x33, 0x03, 0xf9,
+	0x7d, 0xc7, 0xc0, 0xf0, 0xff, 0x02, 0xdd, 0xc2, 0x6d, 0x66, 0xe4, 0x43, 0x14, 0xda, 0xbe, 0xb8,
+	0x2c, 0x08, 0xa7, 0xfe, 0x14, 0x72, 0xe1, 0x24, 0xba, 0x95, 0x72, 0xf5, 0x9c, 0xc5, 0x87, 0x57,
+	0x61, 0xc3, 0x89, 0x83, 0x2f, 0x20, 0x98, 0x66, 0x14, 0xa1, 0x22, 0x94, 0x76, 0xf4, 0x5b, 0xef,
+	0x5a, 0x53, 0xdb, 0xb5, 0xc5, 0xad, 0x61, 0x24, 0xc1, 0x5b, 0xc1, 0xe6, 0xc4, 0x49, 0x87, 0x1b,
+	0x21, 0xa5, 0x54, 0xa1, 0x39, 0xc1, 0x27, 0x4b, 0xea, 0xa1, 0x7f, 0xa6, 0xfb, 0x0b, 0x97, 0x4e,
+	0x38, 0x03, 0xa1, 0xc9, 0x55, 0x51, 0x54, 0x2d, 0xe8, 0x38, 0x30, 0x10, 0xd1, 0xad, 0x73, 0xdf,
+	0x9a, 0x1b, 0x7e, 0xac, 0xc3, 0xe1, 0x31, 0x1a, 0x01, 0xf0, 0x4e, 0x2d, 0xd6, 0xae, 0xbd, 0x45,
+	0x37, 0x52, 0x51, 0xe7, 0xd1, 0xa2, 0xd4, 0x9a, 0xcb, 0x03, 0x02, 0xa3, 0xfd, 0x59, 0x0e, 0xaa,
+	0x52, 0x7b, 0xd4, 0x77, 0xa1, 0x6c, 0x4e, 0x9c, 0x35, 0xdc, 0x4e, 0x22, 0xba, 0xbb, 0x1b, 0x6d,
+	0x41, 0x93, 0x27, 0x28, 0x2c, 0xd2, 0x0a, 0xf5, 0xe7, 0x86, 0x6f, 0xf3, 0xeb, 0xf3, 0x59, 0xd9,
+	0x55, 0x3b, 0xb4, 0xc2, 0xa7, 0x11, 0x06, 0x5f, 0x3c, 0x09, 0xa4, 0x3c, 0x29, 0x66, 0xa2, 0x4b,
+	0xb9, 0xd4, 0x13, 0x03, 0x1c, 0x88, 0x4f, 0x94, 0x08, 0x3c, 0x92, 0x5a, 0xa7, 0xd6, 0x64, 0x11,
+	0x46, 0x8a, 0x59, 0x3d, 0xea, 0x10, 0x01, 0x91, 0x54, 0xe0, 0xd5, 0x6d, 0xe4, 0x75, 0x86, 0xe3,
+	0x78, 0x24, 0x91, 0x0b, 0xb2, 0x5f, 0x70, 0x37, 0x86, 0xf3, 0xd7, 0x53, 0xa2, 0x1c, 0x46, 0x50,
+	0x79, 0xe1, 0x91, 0xe5, 0x37, 0x8b, 0xb2, 0x70, 0x18, 0x20, 0x68, 0xb7, 0xdd, 0xc3, 0x95, 0x42,
+	0x68, 0xed, 0x97, 0x19, 0x28, 0x89, 0x11, 0x40, 0xab, 0x19, 0x2f, 0x57, 0x3d, 0x6d, 0xb1, 0x2e,
+	0xba, 0x59, 0x44, 0x94, 0xd4, 0x23, 0xd6, 0xea, 0x0b, 0x3e, 0xc9, 0x3a, 0x4f, 0x07, 0x4f, 0x3a,
+	0xdc, 0x7a, 0xdc, 0xed, 0xf4, 0xbf, 0x50, 0x72, 0xdc, 0x73, 0xd2, 0xd9, 0x6f, 0x31, 0xe4, 0x92,
+	0x55, 0x28, 0x75, 0x3e, 0xef, 0xb4, 0x0f, 0x88, 0x4d, 0x36, 0x00, 0x76, 0x3b, 0xad, 0x5e, 0x6f,
+	0x80, 0xa6, 0xbc, 0x52, 0x44, 0x2f, 0x48, 0x9b, 0x75, 0xd0, 0xac, 0x6f, 0xb5, 0xdb, 0x83, 0x83,
+	0xfe, 0x48, 0x29, 0xe1, 0x17, 0x5b, 0x68, 0x63, 0xc7, 0x20, 0x7a, 0x00, 0x60, 0x97, 0x0d, 0xf6,
+	0x63, 0x48, 0x65, 0xa7, 0x82, 0x4a, 0x32, 0xcd, 0x95, 0xf6, 0x47, 0x0d, 0x68, 0xa4, 0x97, 0xa6,
+	0xfa, 0x01, 0x94, 0x4d, 0x33, 0x35, 0xc7, 0x37, 0xd6, 0x2d, 0xe1, 0xbb, 0xbb, 0x66, 0x34, 0xcd,
+	0x3c, 0x81, 0xa7, 0x91, 0x7c, 0x23, 0x65, 0x57, 0x36, 0x52, 0xb4, 0x8d, 0x3e, 0x81, 0x0d, 0x71,
+	0x7b, 0x14, 0xad, 0xc5, 0xb1, 0x11, 0x58, 0xe9, 0x5d, 0xd2, 0x26, 0xe4, 0xae, 0xc0, 0x3d, 0xbe,
+	0xc0, 0x1a, 0x93, 0x14, 0x44, 0xfd, 0x39, 0x34, 0x0c, 0x32, 0x6d, 0xe2, 0xf2, 0x79, 0x59, 0xc4,
+	0xb7, 0x10, 0x27, 0x15, 0xaf, 0x1b, 0x32, 0x00, 0x17, 0xa2, 0xe9, 0x7b, 0xf3, 0xa4, 0x70, 0x41,
+	0x5e, 0x88, 0xbb, 0xbe, 0x37, 0x97, 0xca, 0xd6, 0x4c, 0x29, 0x8f, 0x11, 0xaa, 0xa2, 0xe5, 0x89,
+	0x91, 0x14, 0x6f, 0x59, 0xde, 0x6c, 0x52, 0x14, 0xf0, 0x25, 0xa1, 0x49, 0x92, 0xc5, 0x30, 0x67,
+	0xde, 0xe0, 0xc4, 0x68, 0x8a, 0xd7, 0x1a, 0xb5, 0x36, 0x2a, 0x05, 0x46, 0x9c, 0x53, 0xdf, 0x06,
+	0xa0, 0x76, 0xf2, 0x32, 0xe5, 0xd4, 0xd1, 0x95, 0xef, 0xcd, 0xa3, 0x22, 0x15, 0x33, 0xca, 0x48,
+	0xcd, 0xe3, 0x71, 0xfc, 0x95, 0xd5, 0xe6, 0x51, 0xc8, 0x79, 0xd2, 0x3c, 0xca, 0x26, 0xcd, 0xe3,
+	0xc5, 0x60, 0xa5, 0x79, 0x51, 0x29, 0x30, 0xe2, 0x5c, 0xdc, 0x3c, 0x5e, 0xa6, 0xba, 0xdc, 0xbc,
+	0xa8, 0x48, 0xc5, 0x8c, 0x32, 0x38, 0x6d, 0x4b, 0x9a, 0x59, 0xed, 0x5c, 0xcd, 0x0c, 0xa7, 0x2d,
+	0xad, 0x9b, 0xfd, 0x1c, 0x1a, 0xc1, 0x91, 0x77, 0x22, 0x31, 0x90, 0xba, 0x5c, 0x7a, 0x78, 0xe4,
+	0x9d, 0xc8, 0x1c, 0xa4, 0x1e, 0xc8, 0x00, 0x6c, 0x2d, 0xef, 0x22, 0xdd, 0xd4, 0x69, 0xc8, 0xad,
+	0xa5, 0x1e, 0xe2, 0x0d, 0x0a, 0x6c, 0xad, 0x11, 0x65, 0x70, 0x50, 0x12, 0x73, 0x38, 0x68, 0x6e,
+	0xc8, 0x83, 0xd2, 0x8b, 0xac, 0x62, 0xfc, 0x12, 0xc4, 0x36, 0x72, 0x80, 0x6b, 0x6b, 0xe1, 0xca,
+	0xc5, 0x14, 0x79, 0x6d, 0x1d, 0xb8, 0xa9, 0x82, 0x35, 0x4e, 0x2a, 0x8a, 0x26, 0xbb, 0x22, 0xb0,
+	0xbe, 0x5e, 0x58, 0xee, 0xc4, 0x6a, 0x6e, 0xae, 0xee, 0x8a, 0xa1, 0xc0, 0x25, 0xbb, 0x22, 0x82,
+	0xc4, 0xeb, 0x3a, 0x2e, 0xae, 0x2e, 0xaf, 0x6b, 0xa9, 0x70, 0xcd, 0x94, 0xf2, 0xc9, 0x86, 0x8a,
+	0xcb, 0x5e, 0x5c, 0xd9, 0x50, 0x52, 0xe1, 0xba, 0x21, 0x03, 0x70, 0xa4, 0x44, 0xcb, 0x69, 0x70,
+	0x53, 0x67, 0xb7, 0xbc, 0xd5, 0x62, 0x74, 0x61, 0x12, 0xe7, 0xb4, 0xbf, 0x57, 0x80, 0x92, 0x60,
+	0x1e, 0xf8, 0x16, 0x89, 0xe0, 0x61, 0xbb, 0xad, 0x51, 0x6b, 0xa7, 0x35, 0x44, 0xad, 0x43, 0x85,
+	0x06, 0x67, 0x62, 0x31, 0x2c, 0x83, 0x8c, 0x8d, 0xb8, 0x58, 0x0c, 0xca, 0x22, 0x63, 0x13, 0x65,
+	0xf9, 0x2b, 0x28, 0x39, 0x74, 0x49, 0xf2, 0x82, 0x1c, 0x40, 0x51, 0xc8, 0x54, 0x8a, 0xe7, 0x0b,
+	0x52, 0x11, 0xee, 0x12, 0x2c, 0x26, 0x45, 0x38, 0xa0, 0x14, 0x17, 0xe1, 0xf9, 0x32, 0x36, 0x66,
+	0xc4, 0x0e, 0xfa, 0xed, 0xe4, 0x3b, 0x15, 0x2c, 0x24, 0xaa, 0x79, 0xda, 0xed, 0x3c, 0x53, 0x00,
+	0x0b, 0xf1, 0x5a, 0x28, 0x5f, 0x45, 0xbd, 0x89, 0x2a, 0xa1, 0x6c, 0x4d, 0xbd, 0x0a, 0x17, 0x87,
+	0x8f, 0x07, 0xcf, 0x74, 0x5e, 0x28, 0xee, 0x42, 0x1d, 0xfd, 0xb3, 0x12, 0x82, 0x57, 0xdf, 0xc0,
+	0x4f, 0x12, 0x34, 0x22, 0x1c, 0x2a, 0x1b, 0xe4, 0x61, 0x47, 0xd8, 0x88, 0x0b, 0x12, 0x05, 0xbb,
+	0xc2, 0x8b, 0x0e, 0x7a, 0x07, 0x7b, 0xfd, 0xa1, 0xb2, 0x89, 0x8d, 0x20, 0x08, 0x6f, 0xb9, 0x1a,
+	0x57, 0x93, 0x88, 0x9f, 0x8b, 0x24, 0x91, 0x10, 0xf6, 0xac, 0xc5, 0xfa, 0xdd, 0xfe, 0xa3, 0xa1,
+	0x72, 0x29, 0xae, 0xb9, 0xc3, 0xd8, 0x80, 0x0d, 0x95, 0xcb, 0x31, 0x60, 0x38, 0x6a, 0x8d, 0x0e,
+	0x86, 0xca, 0x95, 0xb8, 0x95, 0xfb, 0x6c, 0xd0, 0xee, 0x0c, 0x87, 0xbd, 0xee, 0x70, 0xa4, 0x5c,
+	0x45, 0xaf, 0x7e, 0xd2, 0xa2, 0x88, 0xb8, 0x29, 0x35, 0x94, 0x3d, 0xea, 0x8c, 0x94, 0x6b, 0x71,
+	0x33, 0xda, 0x83, 0x1e, 0x3e, 0x50, 0x33, 0xe8, 0x2b, 0xd7, 0x91, 0x88, 0x1c, 0xdc, 0xa2, 0x37,
+	0x2f, 0x61, 0xbb, 0x0e, 0xfa, 0x32, 0xe8, 0x86, 0xb4, 0x34, 0x86, 0x9d, 0x5f, 0x1c, 0x74, 0xfa,
+	0xed, 0x8e, 0xf2, 0x72, 0xb2, 0x34, 0x62, 0xd8, 0xcd, 0x78, 0x69, 0xc4, 0xa0, 0x5b, 0xf1, 0x37,
+	0x23, 0xd0, 0x50, 0xd9, 0xc2, 0xfa, 0x44, 0x3b, 0xfa, 0xfd, 0x4e, 0x7b, 0x84, 0x7d, 0x7d, 0x25,
+	0x1e, 0xc5, 0x83, 0xfd, 0x47, 0x0c, 0xaf, 0x47, 0x6b, 0x3b, 0x35, 0x7a, 0x47, 0x4d, 0x08, 0x39,
+	0xed, 0x33, 0x50, 0xe5, 0x07, 0x89, 0xc4, 0x23, 0x08, 0x2a, 0xe4, 0x31, 0xfc, 0x2e, 0xba, 0x75,
+	0x83, 0x69, 0xbc, 0x04, 0x31, 0x5f, 0x8c, 0xe9, 0x88, 0x37, 0x09, 0xc2, 0x97, 0x41, 0xda, 0x3f,
+	0xc9, 0x40, 0x23, 0x2d, 0xe0, 0x50, 0xb1, 0xb3, 0xa7, 0x3a, 0x9e, 0xd5, 0xd3, 0x45, 0xfd, 0x20,
+	0xf2, 0x0e, 0xd8, 0xd3, 0xbe, 0x17, 0xd2, 0x4d, 0x7d, 0x32, 0xe7, 0x62, 0x79, 0xc5, 0x6b, 0x8d,
+	0xf3, 0x6a, 0x17, 0x2e, 0xa6, 0xde, 0x6b, 0x4a, 0x3d, 0x93, 0xd0, 0x8c, 0x5f, 0x99, 0x59, 0x6a,
+	0x3f, 0x53, 0x83, 0xd5, 0x3e, 0x29, 0x90, 0xc3, 0xcb, 0x65, 0xfc, 0xbe, 0x25, 0x26, 0xb5, 0xc7,
+	0x50, 0x4f, 0xc9, 0x53, 0x72, 0x15, 0x4d, 0xd3, 0x2d, 0x2d, 0xdb, 0xd3, 0x17, 0x37, 0x53, 0xfb,
+	0x93, 0x0c, 0xd4, 0x64, 0xe9, 0xfa, 0xa3, 0x6b, 0xa2, 0x50, 0x4d, 0x91, 0x46, 0xcf, 0xac, 0xb8,
+	0xa0, 0x1f, 0x81, 0xba, 0xf4, 0x7e, 0x24, 0xf7, 0x65, 0x3d, 0x3c, 0x1e, 0xc6, 0xdd, 0x91, 0x41,
+	0x68, 0xe8, 0x52, 0x10, 0xf6, 0xc3, 0x27, 0x48, 0x20, 0x82, 0x3d, 0x13, 0x08, 0xde, 0x57, 0xe6,
+	0xf1, 0x08, 0xfb, 0x8b, 0xf1, 0x30, 0xbe, 0x31, 0x99, 0x82, 0x69, 0xb7, 0xa0, 0xf2, 0xf0, 0x38,
+	0x7a, 0x4f, 0x42, 0x7e, 0xd2, 0xa2, 0xc2, 0x6f, 0x77, 0xe0, 0xfb, 0x96, 0x8d, 0xe4, 0x9a, 0x22,
+	0x85, 0x81, 0xf0, 0xb7, 0xc0, 0xf8, 0x92, 0xc1, 0xb7, 0xc0, 0xe2, 0xe7, 0x27, 0xb3, 0xf2, 0xf3,
+	0x93, 0xaf, 0x8a, 0xca, 0x72, 0xb2, 0x9c, 0x8a, 0xbf, 0xc5, 0x6b, 0xc7, 0x40, 0x01, 0xfc, 0xcf,
+	0xac, 0xa9, 0xe5, 0xfb, 0x56, 0xf4, 0x2c, 0xda, 0x0a, 0x71, 0x8a, 0x88, 0x6c, 0x0d, 0x6b, 0xda,
+	0x2c, 0xc8, 0xec, 0x3d, 0x7d, 0x93, 0x12, 0xf1, 0xda, 0xdf, 0xc9, 0x43, 0x55, 0xd2, 0x67, 0xbe,
+	0xd7, 0x12, 0xbd, 0x81, 0x8f, 0x7a, 0x45, 0x77, 0xf4, 0x44, 0xc0, 0x7e, 0x0c, 0x48, 0xcd, 0x67,
+	0x6e, 0x69, 0x3e, 0xf1, 0xc6, 0x11, 0x8f, 0x17, 0x11, 0xfe, 0xaa, 0x28, 0x9b, 0x76, 0xc8, 0x14,
+	0x5e, 0xe0, 0xe6, 0x7c, 0x07, 0x6a, 0xfc, 0x75, 0x08, 0xe9, 0x7d, 0xae, 0x55, 0xfa, 0x6a, 0xf2,
+	0x4a, 0x46, 0x80, 0x37, 0x73, 0xa7, 0xc7, 0xba, 0x39, 0x8e, 0x7c, 0x1d, 0x85, 0xe9, 0xf1, 0xee,
+	0x98, 0xdc, 0xc4, 0xd3, 0x58, 0x84, 0x97, 0x09, 0x53, 0x9e, 0x46, 0x82, 0xfa, 0x36, 0x94, 0xa6,
+	0xc7, 0x3c, 0x0e, 0xbf, 0xb2, 0x95, 0x5b, 0x37, 0xe4, 0xc5, 0xe9, 0x31, 0x05, 0xe5, 0x7f, 0x08,
+	0xca, 0x92, 0x2f, 0x2c, 0x68, 0xc2, 0xda, 0x46, 0x6d, 0xa4, 0xdd, 0x62, 0x81, 0x7a, 0x0f, 0x2e,
+	0x09, 0x99, 0x6a, 0x04, 0x3a, 0x8f, 0x65, 0xa4, 0x6b, 0x9f, 0xfc, 0x6d, 0x8c, 0x4d, 0x8e, 0x6b,
+	0x05, 0x43, 0xc2, 0x88, 0x05, 0x2b, 0xad, 0x6f, 0x7e, 0xa7, 0xb6, 0xc2, 0x52, 0x30, 0xf5, 0x01,
+	0xd4, 0xa6, 0xc7, 0x7c, 0x2d, 0x8c, 0xbc, 0x3d, 0x4b, 0x44, 0xa5, 0x5d, 0x5a, 0x5e, 0x05, 0x14,
+	0xbc, 0x94, 0xa2, 0xd4, 0xfe, 0x75, 0x06, 0x1a, 0x89, 0xa2, 0x8a, 0xbb, 0x18, 0x9d, 0xa8, 0xc9,
+	0x0b, 0x7f, 0xcd, 0x65, 0x5d, 0x16, 0x49, 0xd0, 0x1b, 0xce, 0x1f, 0x1d, 0x5a, 0x77, 0xd3, 0x79,
+	0xdd, 0x3b, 0x26, 0xb9, 0x75, 0xef, 0x98, 0x68, 0x8f, 0x20, 0x87, 0x67, 0x20, 0xe4, 0x14, 0x41,
+	0x31, 0xc7, 0x0d, 0x28, 0x2e, 0xe0, 0xe8, 0x94, 0x0e, 0x0f, 0x34, 0xe9, 0xf6, 0xd1, 0x3e, 0xeb,
+	0xee, 0xb5, 0xd8, 0x17, 0x74, 0xc2, 0x49, 0x8a, 0xc0, 0xc3, 0x01, 0xeb, 0x74, 0x1f, 0xf5, 0x09,
+	0x90, 0x27, 0x97, 0x49, 0xd2, 0xc4, 0x96, 0x69, 0x3e, 0x3c, 0x96, 0x2f, 0x7c, 0x66, 0x52, 0xaf,
+	0xc4, 0xa5, 0x2f, 0x2c, 0x64, 0x97, 0x2f, 0x2c, 0xa8, 0xf1, 0x16, 0x8d, 0xf7, 0x3b, 0xde, 0x7d,
+	0xc6, 0x6b, 0xc8, 0x69, 0x6b, 0x24, 0xbd, 0xbb, 0x88, 0x40, 0xfb, 0x75, 0x06, 0xd4, 0x54, 0x43,
+	0xb8, 0x82, 0xfc, 0x63, 0xdb, 0xf2, 0x01, 0x34, 0xc5, 0x13, 0x3e, 0x9c, 0x4a, 0xf2, 0x93, 0x8a,
+	0x21, 0xbd, 0xec, 0x25, 0x61, 0x10, 0xc9, 0x65, 0x6c, 0xf5, 0x1e, 0xf0, 0xf7, 0x58, 0x70, 0xc6,
+	0xd3, 0xfe, 0x07, 0x69, 0xf3, 0xb3, 0x84, 0x26, 0x79, 0x80, 0x45, 0x7e, 0x58, 0x86, 0x3b, 0x8e,
+	0x37, 0x92, 0x59, 0x23, 0x86, 0xa0, 0xfd, 0x61, 0x06, 0x2e, 0xa6, 0x17, 0xc4, 0x6f, 0xd6, 0xcb,
+	0xf4, 0x2b, 0x3a, 0xb9, 0xe5, 0x57, 0x74, 0xd6, 0xad, 0xa7, 0xfc, 0xda, 0xf5, 0xf4, 0x07, 0x19,
+	0xb8, 0x24, 0x8d, 0x7e, 0x62, 0xd2, 0xfc, 0x25, 0xb5, 0x4c, 0x7a, 0x4c, 0x27, 0x9f, 0x7a, 0x4c,
+	0x47, 0xfb, 0xe3, 0x0c, 0x5c, 0x59, 0x6a, 0x09, 0xb3, 0xfe, 0x52, 0xdb, 0x92, 0x7e, 0x74, 0x87,
+	0x7c, 0xc5, 0x3c, 0x10, 0x85, 0x07, 0xe5, 0xab, 0xe9, 0x57, 0x74, 0xf0, 0x38, 0x45, 0xfb, 0x37,
+	0xe9, 0x46, 0x9a, 0x49, 0x48, 0x35, 0x46, 0x00, 0x25, 0x6a, 0x52, 0x74, 0xd1, 0x71, 0x6d, 0x3c,
+	0xb6, 0x4c, 0xb7, 0x96, 0x2f, 0x66, 0xbf, 0x1f, 0x5f, 0x7c, 0x00, 0xb5, 0xb8, 0xe2, 0x5d, 0x6b,
+	0x9a, 0x76, 0x1c, 0x2c, 0xdd, 0xca, 0x4f, 0x51, 0x6a, 0xef, 0xc2, 0x66, 0xd2, 0x8b, 0xb6, 0x78,
+	0x49, 0xe2, 0x16, 0x54, 0x5d, 0x0b, 0xef, 0x5f, 0x52, 0x36, 0x3a, 0x1d, 0x77, 0xad, 0x13, 0x41,
+	0xa0, 0x3d, 0x94, 0xf9, 0x5e, 0xfc, 0x70, 0xa6, 0x63, 0xca, 0x33, 0x53, 0xf2, 0x1c, 0x33, 0x42,
+	0x61, 0x6d, 0xd2, 0xc4, 0x94, 0x5c, 0xeb, 0x84, 0xd6, 0xdc, 0x89, 0xa8, 0xa7, 0x65, 0x9a, 0xe2,
+	0xb4, 0x71, 0xdd, 0xa5, 0xed, 0x6b, 0x50, 0xc6, 0xc8, 0x31, 0xb9, 0x82, 0xb9, 0xcf, 0x3f, 0xfb,
+	0x9a, 0x38, 0x7b, 0x3f, 0xef, 0x64, 0x92, 0xb0, 0xd1, 0x1d, 0xd7, 0x7c, 0xf2, 0xb0, 0xee, 0x7b,
+	0x82, 0xe5, 0xe1, 0xfe, 0x13, 0x5f, 0x8e, 0x4f, 0x20, 0xf1, 0xb0, 0x1f, 0x93, 0x08, 0x09, 0xac,
+	0xaf, 0xc5, 0xf1, 0x3f, 0x26, 0xb5, 0x3f, 0x02, 0x80, 0xa4, 0xe3, 0x29, 0xe9, 0x9d, 0x59, 0x92,
+	0xde, 0x3f, 0xe8, 0x28, 0xf2, 0x5d, 0x7c, 0xe3, 0x67, 0x7e, 0xa6, 0x27, 0x25, 0x72, 0x6b, 0x4b,
+	0xd4, 0x90, 0x6a, 0x94, 0x84, 0x1f, 0xaf, 0x1e, 0x57, 0xe5, 0xd7, 0x1e, 0x57, 0xbd, 0x03, 0x25,
+	0xee, 0x1f, 0x0f, 0x44, 0x20, 0xfb, 0xd5, 0x65, 0xc9, 0x74, 0x57, 0xbc, 0x99, 0x14, 0xd1, 0xa9,
+	0x1d, 0x68, 0xc4, 0x0f, 0xc6, 0xc8, 0x61, 0xed, 0x37, 0x57, 0x4b, 0x46, 0x64, 0xfc, 0x95, 0x02,
+	0x43, 0xce, 0x4a, 0x12, 0x3b, 0x9c, 0x09, 0xa7, 0x0d, 0x49, 0xec, 0x92, 0x2c, 0xb1, 0x47, 0x33,
+	0xee, 0xaa, 0x41, 0x89, 0xfd, 0x33, 0xb8, 0x28, 0x42, 0x04, 0xb1, 0x00, 0x0e, 0x27, 0xd1, 0xf3,
+	0xab, 0x71, 0xe2, 0x5e, 0xe1, 0x68, 0x46, 0xea, 0x32, 0x92, 0x7f, 0x0e, 0x97, 0x26, 0x47, 0x78,
+	0xe9, 0x1b, 0xdf, 0xb5, 0xd0, 0xe9, 0x09, 0x41, 0x1d, 0x4f, 0x31, 0xb9, 0x0e, 0xf2, 0xc6, 0x4a,
+	0x63, 0xdb, 0x44, 0x3c, 0x1a, 0x3b, 0x74, 0xc6, 0x1f, 0x1f, 0x6a, 0x6e, 0x4e, 0x96, 0xe1, 0x4b,
+	0x87, 0x3e, 0xb0, 0x7c, 0xe8, 0xb3, 0xa2, 0x5a, 0x54, 0x57, 0x55, 0x8b, 0xeb, 0x7f, 0x9a, 0x87,
+	0x22, 0x1f, 0x58, 0x7a, 0x7b, 0xc2, 0xf7, 0xe6, 0x71, 0x60, 0xcb, 0x1a, 0xcd, 0x80, 0x1e, 0x00,
+	0x47, 0x25, 0xe2, 0x2e, 0x14, 0xf1, 0xcc, 0x72, 0x7a, 0x9c, 0x3e, 0x98, 0x59, 0x12, 0xd2, 0xe8,
+	0x57, 0x35, 0x30, 0xa1, 0x7e, 0x00, 0x15, 0xa4, 0xe7, 0x3e, 0xa7, 0x94, 0x81, 0xb3, 0x2a, 0x4e,
+	0xf1, 0x9c, 0xc5, 0x10, 0x69, 0xf5, 0xe3, 0xb4, 0x8b, 0x8b, 0xcb, 0xba, 0xeb, 0x2b, 0x45, 0xcf,
+	0x73, 0x76, 0xfd, 0x2e, 0x70, 0x9f, 0x47, 0xcc, 0x29, 0x0a, 0xf2, 0x19, 0xc0, 0x0a, 0x5f, 0x41,
+	0x07, 0x8b, 0xc1, 0xe3, 0x2b, 0x28, 0x8f, 0x4f, 0x46, 0xf0, 0xf2, 0xf1, 0x53, 0xbd, 0x6b, 0x46,
+	0x06, 0xf7, 0x79, 0xec, 0x83, 0xc2, 0x0c, 0x15, 0x33, 0xcd, 0x28, 0x5e, 0xa1, 0xb4, 0x52, 0x2c,
+	0xe6, 0x26, 0x54, 0x2c, 0xca, 0xa8, 0x0f, 0xa0, 0x4a, 0x9e, 0x20, 0x51, 0xae, 0xbc, 0x32, 0xb4,
+	0x09, 0x33, 0x20, 0xff, 0x76, 0x9c, 0x53, 0xdb, 0x51, 0x3f, 0x7d, 0x4b, 0x76, 0x21, 0xde, 0x58,
+	0x3b, 0x50, 0x2c, 0xf6, 0x26, 0xf2, 0xce, 0x32, 0x5e, 0x46, 0xdd, 0x81, 0x9a, 0x21, 0x49, 0x89,
+	0x26, 0x9c, 0x53, 0x87, 0x44, 0x43, 0x75, 0x48, 0xf9, 0xe4, 0x9c, 0xeb, 0x3a, 0x83, 0x2b, 0xeb,
+	0x97, 0xb2, 0x7c, 0x1c, 0x9f, 0xe7, 0xc7, 0xf1, 0x5a, 0xfa, 0x6e, 0x67, 0xfa, 0x36, 0x8e, 0x74,
+	0x38, 0xff, 0x29, 0x1a, 0xb5, 0xf2, 0xe6, 0xad, 0x42, 0x29, 0x7a, 0xfc, 0x8c, 0x02, 0xbb, 0xda,
+	0x83, 0x7d, 0x3c, 0xea, 0xaa, 0x42, 0xa9, 0xdb, 0x1f, 0x8e, 0x5a, 0x7d, 0x71, 0x8a, 0xd9, 0xed,
+	0x8b, 0x53, 0x4c, 0xed, 0xdf, 0xe3, 0xf1, 0x7e, 0xec, 0x78, 0xfd, 0xd1, 0x96, 0x6c, 0x6c, 0xfe,
+	0xe5, 0x64, 0xf3, 0x6f, 0x49, 0xcb, 0xe2, 0xe7, 0xe7, 0xfc, 0xce, 0xef, 0x46, 0x5a, 0x97, 0x09,
+	0x56, 0xaf, 0x07, 0x14, 0xbe, 0xe7, 0xf5, 0x00, 0x39, 0xb6, 0xa9, 0x98, 0x8e, 0x6d, 0x5a, 0x7a,
+	0x00, 0xaf, 0x44, 0x67, 0xfd, 0xf2, 0x03, 0x78, 0xe7, 0x1e, 0xf2, 0x97, 0xcf, 0x3f, 0xe4, 0xa7,
+	0x5f, 0x39, 0x40, 0xd7, 0x9f, 0x08, 0xf1, 0x11, 0xb9, 0xb4, 0xf8, 0x80, 0x17, 0x88, 0x8f, 0xef,
+	0xc1, 0x8a, 0xd4, 0x6d, 0xb8, 0x34, 0x3d, 0x8e, 0x1f, 0xfb, 0x49, 0xac, 0x9d, 0x1a, 0x75, 0x63,
+	0x2d, 0x4e, 0xfb, 0xbb, 0x19, 0x80, 0xc4, 0x55, 0xf9, 0x1b, 0x7b, 0x64, 0x24, 0x83, 0x36, 0xf7,
+	0x1d, 0x06, 0xed, 0x0b, 0xae, 0xa4, 0x6a, 0x5f, 0x43, 0x25, 0x76, 0x4e, 0xff, 0xf8, 0x35, 0xf6,
+	0x83, 0x3e, 0xf9, 0xfb, 0x91, 0x77, 0x2a, 0xf6, 0xee, 0xfe, 0xa6, 0x63, 0x91, 0xfa, 0x7c, 0xee,
+	0x05, 0x9f, 0x3f, 0xe5, 0x2e, 0xa2, 0xf8, 0xe3, 0xbf, 0xe5, 0x8d, 0x25, 0xaf, 0xf9, 0x7c, 0x6a,
+	0xcd, 0x6b, 0x0b, 0xe1, 0xe7, 0xfa, 0xcd, 0x3f, 0xfd, 0x83, 0x3a, 0xfc, 0xe7, 0x99, 0xc8, 0xd1,
+	0x12, 0x3f, 0xa1, 0x74, 0xae, 0xa2, 0xb5, 0xde, 0x57, 0xf4, 0x43, 0x3e, 0xf7, 0x9d, 0x96, 0x62,
+	0xfe, 0xbb, 0x2c, 0xc5, 0x37, 0xa0, 0xc0, 0x05, 0x42, 0xe1, 0x3c, 0x2b, 0x91, 0xe3, 0x5f, 0xf8,
+	0xe8, 0xa8, 0xa6, 0x09, 0xc5, 0x92, 0xf7, 0xf7, 0x52, 0x54, 0x6f, 0xf4, 0x60, 0x2a, 0x66, 0xd0,
+	0x50, 0xaf, 0x24, 0x06, 0xe3, 0x0f, 0x1f, 0x93, 0xdf, 0x9a, 0xa9, 0xf8, 0xcf, 0xb2, 0x50, 0x4f,
+	0x9d, 0x4b, 0xfd, 0x88, 0xc6, 0xac, 0xe5, 0xe6, 0xb9, 0xf5, 0xdc, 0xfc, 0x5c, 0xc6, 0x9a, 0x3f,
+	0x9f, 0xb1, 0xfe, 0x5f, 0x91, 0x00, 0x3c, 0x60, 0x50, 0xbc, 0x6f, 0x5a, 0x8e, 0x02, 0x06, 0x79,
+	0xc0, 0x1b, 0x72, 0xd3, 0x9a, 0xfc, 0xdd, 0xb5, 0xfa, 0x7b, 0x66, 0xad, 0xfe, 0x7e, 0x33, 0x7e,
+	0xd3, 0xbf, 0xbb, 0xcb, 0x8d, 0xc2, 0x3a, 0x93, 0x20, 0x78, 0x2b, 0x99, 0x6b, 0x35, 0x5c, 0x91,
+	0xd3, 0xbd, 0xa9, 0x1e, 0x61, 0x4d, 0x11, 0x11, 0x77, 0x85, 0x13, 0xf0, 0x17, 0x69, 0xa7, 0xad,
+	0x08, 0xab, 0x75, 0xa1, 0x9e, 0x3a, 0x24, 0x94, 0x7e, 0x3d, 0x24, 0x23, 0xff, 0x7a, 0x08, 0x06,
+	0x60, 0x9d, 0x1c, 0x59, 0xbe, 0xb5, 0xe6, 0x59, 0x19, 0x8e, 0xc0, 0xa7, 0xc1, 0xe5, 0x80, 0x05,
+	0xf5, 0x2d, 0x28, 0xd8, 0xa1, 0x35, 0x8b, 0x2c, 0xe0, 0x2b, 0xab, 0x31, 0x0d, 0x64, 0x04, 0x73,
+	0x22, 0x0c, 0x0e, 0x50, 0x96, 0x71, 0xd2, 0x4f, 0x9c, 0x64, 0xce, 0xf9, 0x89, 0x93, 0x6c, 0xaa,
+	0x91, 0xeb, 0x7e, 0xa5, 0x24, 0x7e, 0xda, 0x22, 0x7f, 0xce, 0xd3, 0x16, 0x78, 0xb3, 0xc8, 0xb7,
+	0xe8, 0xf7, 0x23, 0xcc, 0x66, 0x61, 0x85, 0x28, 0xc6, 0x69, 0x7f, 0x2b, 0x03, 0x25, 0x11, 0x5d,
+	0xb1, 0xd6, 0x50, 0x7d, 0x13, 0x4a, 0xfc, 0xb7, 0x24, 0x22, 0xc3, 0x7d, 0x25, 0x60, 0x31, 0xc2,
+	0x63, 0x44, 0x27, 0xa2, 0xd2, 0x86, 0x2b, 0xc6, 0xdc, 0x30, 0x82, 0xe3, 0x52, 0xe3, 0x6e, 0x08,
+	0x34, 0xbd, 0x02, 0x71, 0x3d, 0x19, 0x08, 0x84, 0xaa, 0x59, 0xa0, 0x7d, 0x0c, 0x25, 0x11, 0xbd,
+	0xb1, 0xb6, 0x29, 0x2f, 0xfa, 0x15, 0x85, 0x2d, 0x80, 0x24, 0x9c, 0x63, 0x5d, 0x0d, 0xf8, 0xbb,
+	0x28, 0x51, 0x04, 0x07, 0xae, 0xbf, 0xe4, 0xd3, 0x22, 0x48, 0x57, 0x6e, 0x8c, 0x23, 0xde, 0x5e,
+	0xc3, 0x83, 0x5c, 0xf2, 0x88, 0xdd, 0xc3, 0x47, 0xcc, 0xc5, 0x93, 0x76, 0x99, 0xf3, 0x9f, 0xb4,
+	0x8b, 0x89, 0xd4, 0x3b, 0x10, 0xb3, 0xe3, 0x17, 0x59, 0xcb, 0x5a, 0x2b, 0x8a, 0x46, 0xa7, 0x55,
+	0x76, 0x5f, 0x78, 0x7e, 0x7a, 0x74, 0xa9, 0x3e, 0xe5, 0x6c, 0x49, 0xb5, 0x89, 0x49, 0x64, 0x5a,
+	0x03, 0x6a, 0xf2, 0xb1, 0xb3, 0xf6, 0xcb, 0x3c, 0x28, 0xf8, 0x8b, 0x1a, 0xc8, 0xb4, 0x30, 0xa8,
+	0x9f, 0x3a, 0x71, 0x0d, 0xca, 0xf1, 0x5b, 0xd9, 0x99, 0xe8, 0xad, 0x4d, 0x27, 0x7a, 0x44, 0xda,
+	0xa3, 0x49, 0x95, 0xbd, 0x12, 0xc0, 0x41, 0x44, 0xc0, 0x39, 0x41, 0xea, 0xd1, 0xca, 0xb2, 0x1d,
+	0x3c, 0xa6, 0x3c, 0x7a, 0xb1, 0xf0, 0x1a, 0xb0, 0xe3, 0x4d, 0x68, 0x4d, 0xd6, 0xe8, 0x9a, 0x70,
+	0xcf, 0x9b, 0x60, 0xa9, 0xc8, 0x5a, 0x0e, 0x44, 0x8c, 0x7f, 0x99, 0x03, 0x46, 0xe4, 0x7e, 0x17,
+	0x97, 0x41, 0xc3, 0x80, 0x38, 0x53, 0x8d, 0x95, 0x39, 0x60, 0x14, 0x44, 0xef, 0x7b, 0x4d, 0xc4,
+	0xa3, 0xd5, 0x39, 0x7a, 0xdf, 0x0b, 0x1f, 0x20, 0x43, 0xef, 0x0b, 0xbe, 0x8b, 0x3e, 0x11, 0xcf,
+	0xd2, 0x8b, 0xd7, 0xd3, 0x10, 0xf5, 0x2a, 0x7f, 0xd6, 0xdb, 0xb7, 0x82, 0x80, 0x3f, 0x1e, 0xc1,
+	0xdf, 0x75, 0xa8, 0x45, 0xc0, 0xf8, 0x95, 0x0a, 0xf1, 0x10, 0x3a, 0x92, 0x80, 0x78, 0xa5, 0x82,
+	0x40, 0x44, 0x70, 0x0d, 0xca, 0xdf, 0x78, 0xae, 0x45, 0x56, 0x77, 0x95, 0x5a, 0x55, 0xc2, 0xfc,
+	0x9e, 0x31, 0xd7, 0xfe, 0x5d, 0x06, 0x2e, 0x2d, 0x8f, 0x2a, 0xcd, 0x76, 0x0d, 0xca, 0xed, 0x41,
+	0x4f, 0xef, 0xb7, 0xf6, 0xf0, 0x4c, 0x7b, 0x03, 0xaa, 0x83, 0x1d, 0xbc, 0x0f, 0xc5, 0x01, 0x19,
+	0xba, 0xd6, 0x33, 0xd4, 0x1f, 0x77, 0x77, 0x77, 0x3b, 0x7d, 0x6e, 0x62, 0x0c, 0x76, 0x3e, 0xd3,
+	0x7b, 0x83, 0x36, 0x7f, 0x83, 0x39, 0x3a, 0xd9, 0x1e, 0x2a, 0x79, 0xcc, 0xf2, 0xb8, 0x49, 0xcc,
+	0x16, 0x78, 0x58, 0xe0, 0xb3, 0xa1, 0xde, 0xee, 0x8f, 0x94, 0x22, 0xe6, 0xf0, 0xde, 0x89, 0xde,
+	0x8e, 0xe2, 0x7f, 0xda, 0x83, 0xbd, 0x7d, 0xd6, 0x19, 0x0e, 0xf5, 0x61, 0xf7, 0xcb, 0x8e, 0x52,
+	0xa6, 0x2f, 0xb3, 0xee, 0xa3, 0x6e, 0x9f, 0x03, 0x2a, 0xe8, 0x36, 0xdf, 0xeb, 0xf6, 0x15, 0xa0,
+	0x44, 0xeb, 0x73, 0xa5, 0x8a, 0x89, 0xe1, 0xc1, 0x9e, 0x52, 0xbb, 0xf3, 0x0a, 0xd4, 0xe4, 0xdf,
+	0x16, 0xa0, 0x48, 0x40, 0xcf, 0xb5, 0xf8, 0x9b, 0x5f, 0xbd, 0x6f, 0xde, 0x55, 0x32, 0x77, 0x7e,
+	0x5f, 0x7a, 0x20, 0x96, 0x68, 0x84, 0x17, 0x9e, 0x6e, 0x97, 0xf1, 0xcb, 0x2e, 0xe4, 0x73, 0xa7,
+	0xbb, 0x31, 0x8f, 0x5b, 0xc3, 0xc7, 0xdc, 0x3f, 0x2f, 0x30, 0x04, 0xc8, 0x25, 0x6f, 0x45, 0xd1,
+	0x6d, 0x32, 0x4a, 0xc6, 0x07, 0xd9, 0x05, 0x2c, 0x48, 0x67, 0xcc, 0x45, 0x3c, 0x9e, 0xc5, 0x54,
+	0x8c, 0x2b, 0xdd, 0xd1, 0xa0, 0x2a, 0x3d, 0xef, 0x47, 0xdf, 0x30, 0x82, 0x23, 0xf1, 0xfc, 0x14,
+	0xda, 0x8a, 0x4a, 0xe6, 0xce, 0x7b, 0x50, 0x17, 0x34, 0xe2, 0x71, 0x3d, 0xfc, 0x29, 0x1f, 0xbc,
+	0x87, 0xe2, 0x08, 0x3a, 0x6b, 0x11, 0x58, 0x7c, 0x0a, 0x98, 0x25, 0x9e, 0xe1, 0x53, 0xb2, 0x77,
+	0xee, 0xc1, 0xe5, 0xb5, 0x2f, 0x07, 0x62, 0xf1, 0xa1, 0x8d, 0xc1, 0x83, 0x3c, 0x3e, 0xf3, 0xf1,
+	0xd9, 0xd8, 0xb7, 0x4d, 0x25, 0x73, 0xe7, 0x53, 0x68, 0x9e, 0x17, 0x6e, 0x88, 0x9f, 0x69, 0x3f,
+	0x6e, 0x51, 0x48, 0x27, 0xce, 0xd0, 0x40, 0xe7, 0xb9, 0x0c, 0x8f, 0x88, 0xed, 0x75, 0x28, 0x84,
+	0xe1, 0xce, 0xb7, 0x19, 0x89, 0xa9, 0x44, 0x21, 0x63, 0x31, 0x40, 0x0c, 0xbd, 0x0c, 0x62, 0x96,
+	0x61, 0x2a, 0x19, 0xf5, 0x0a, 0xa8, 0x29, 0x50, 0xcf, 0x9b, 0x18, 0x8e, 0x92, 0xa5, 0x60, 0x85,
+	0x08, 0xfe, 0xcc, 0xb7, 0x43, 0x4b, 0xc9, 0xa9, 0x2f, 0xc3, 0xb5, 0x18, 0xd6, 0xf3, 0x4e, 0xf6,
+	0x7d, 0x1b, 0xad, 0xdf, 0x33, 0x8e, 0xce, 0xef, 0x7c, 0xf2, 0xab, 0x5f, 0xdf, 0xcc, 0xfc, 0x87,
+	0x5f, 0xdf, 0xcc, 0xfc, 0xb7, 0x5f, 0xdf, 0xbc, 0xf0, 0xcb, 0xff, 0x7e, 0x33, 0xf3, 0xa5, 0xfc,
+	0x3b, 0x7f, 0x33, 0x23, 0xf4, 0xed, 0x53, 0xbe, 0x13, 0xa2, 0x8c, 0x6b, 0xdd, 0x9b, 0x1f, 0x1f,
+	0xde, 0x9b, 0x8f, 0xef, 0x21, 0x03, 0x1a, 0x17, 0xe9, 0x17, 0xfd, 0xee, 0xff, 0x9f, 0x01, 0x00,
+	0xd1, 0x92, 0x59, 0xc7, 0x31, 0x70, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -13274,6 +13286,15 @@ func (m *SubscriptionMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tables[iNdEx])
+			copy(dAtA[i:], m.Tables[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Tables[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SubName) > 0 {
 		i -= len(m.SubName)
 		copy(dAtA[i:], m.SubName)
@@ -19441,6 +19462,13 @@ func (m *DropDatabase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DeletePubSql) > 0 {
+		i -= len(m.DeletePubSql)
+		copy(dAtA[i:], m.DeletePubSql)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.DeletePubSql)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.CheckFKSql) > 0 {
 		i -= len(m.CheckFKSql)
 		copy(dAtA[i:], m.CheckFKSql)
@@ -22657,6 +22685,12 @@ func (m *SubscriptionMeta) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if len(m.Tables) > 0 {
+		for _, s := range m.Tables {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25396,6 +25430,10 @@ func (m *DropDatabase) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.DeletePubSql)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -29058,6 +29096,38 @@ func (m *SubscriptionMeta) Unmarshal(dAtA []byte) error {
 			}
 			m.SubName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -44530,6 +44600,38 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 			}
 			m.CheckFKSql = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePubSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletePubSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
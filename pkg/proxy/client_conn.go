@@ -44,7 +44,8 @@ var clientBaseConnID uint32 = 1000
 
 // parse parses the account information from whole username.
 // The whole username parameter is like: tenant1:user1:role1?key1:value1,key2:value2
-func (c *clientInfo) parse(full string) error {
+// The delimiters between the tenant, the user and the role are configured.
+func (c *clientInfo) parse(full string, delimiters string) error {
 	var labelPart string
 	labelDelPos := strings.IndexByte(full, '?')
 	userPart := full[:]
@@ -54,7 +55,7 @@ func (c *clientInfo) parse(full string) error {
 			labelPart = full[labelDelPos+1:]
 		}
 	}
-	tenant, err := frontend.GetTenantInfo(context.Background(), userPart)
+	tenant, err := frontend.GetTenantInfoWithDelimiters(context.Background(), userPart, delimiters)
 	if err != nil {
 		return err
 	}
@@ -141,6 +142,9 @@ type clientConn struct {
 	tlsConnectTimeout time.Duration
 	// ipNetList is the list of ip net, which is parsed from CIDRs.
 	ipNetList []*net.IPNet
+	// userInputDelimiters is the delimiters between the tenant, the user
+	// and the role in the user name.
+	userInputDelimiters string
 	// queryClient is used to send query request to CN servers.
 	queryClient qclient.QueryClient
 	// testHelper is used for testing.
@@ -195,7 +199,8 @@ func newClientConn(
 			originIP:   originIP,
 			originPort: uint16(port),
 		},
-		ipNetList: ipNetList,
+		ipNetList:           ipNetList,
+		userInputDelimiters: cfg.UserInputDelimiters,
 		// set the connection timeout value.
 		tlsConnectTimeout: cfg.TLSConnectTimeout.Duration,
 		queryClient:       qc,
@@ -206,7 +211,8 @@ func newClientConn(
 	}
 	c.log = logger.With(zap.Uint32("ConnID", c.connID))
 	fp := config.FrontendParameters{
-		EnableTls: cfg.TLSEnabled,
+		EnableTls:           cfg.TLSEnabled,
+		UserInputDelimiters: cfg.UserInputDelimiters,
 	}
 	fp.SetDefaultValues()
 	c.mysqlProto = frontend.NewMysqlClientProtocol(c.connID, c.conn, 0, &fp)
@@ -211,10 +211,11 @@ func testStartNClients(t *testing.T, tp *testProxyHandler, ci clientInfo, cn *CN
 
 func TestAccountParser(t *testing.T) {
 	cases := []struct {
-		str      string
-		tenant   string
-		username string
-		hasErr   bool
+		str        string
+		delimiters string
+		tenant     string
+		username   string
+		hasErr     bool
 	}{
 		{
 			str:      "t1:u1",
@@ -252,10 +253,17 @@ func TestAccountParser(t *testing.T) {
 			username: "u1",
 			hasErr:   false,
 		},
+		{
+			str:        "t1|u:1?a=1",
+			delimiters: "|",
+			tenant:     "t1",
+			username:   "u:1",
+			hasErr:     false,
+		},
 	}
 	for _, item := range cases {
 		a := clientInfo{}
-		err := a.parse(item.str)
+		err := a.parse(item.str, item.delimiters)
 		if item.hasErr {
 			require.Error(t, err)
 		} else {
@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/util"
@@ -38,6 +39,8 @@ var (
 	defaultRebalanceTolerance = 0.3
 	// The default value of rebalance policy.
 	defaultRebalancePolicy = "active"
+	// The default value of the delimiters of the user input.
+	defaultUserInputDelimiters = ":#"
 	// The default value of heartbeat interval.
 	defaultHeartbeatInterval = time.Second * 3
 	// The default value of heartbeat timeout.
@@ -106,6 +109,11 @@ type Config struct {
 	// internal network. The addresses outside the range are external
 	// addresses.
 	InternalCIDRs []string `toml:"internal-cidrs"`
+	// UserInputDelimiters lists the delimiters between the account, the user
+	// and the role in the user name of the login. This value should be the same
+	// with all CN servers, and the name of this parameter is userInputDelimiters.
+	// Default value is ":#".
+	UserInputDelimiters string `toml:"user-input-delimiters" user_setting:"advanced"`
 
 	// HAKeeper is the configuration of HAKeeper.
 	HAKeeper struct {
@@ -207,6 +215,9 @@ func (c *Config) FillDefault() {
 	if c.RebalancePolicy == "" {
 		c.RebalancePolicy = defaultRebalancePolicy
 	}
+	if c.UserInputDelimiters == "" {
+		c.UserInputDelimiters = defaultUserInputDelimiters
+	}
 	if c.Plugin != nil {
 		if c.Plugin.Timeout == 0 {
 			c.Plugin.Timeout = time.Second
@@ -234,6 +245,9 @@ func (c *Config) Validate() error {
 	if _, ok := RebalancePolicyMapping[c.RebalancePolicy]; !ok {
 		c.RebalancePolicy = defaultRebalancePolicy
 	}
+	if err := frontend.ValidateUserInputDelimiters(c.UserInputDelimiters); err != nil {
+		return err
+	}
 	return nil
 }
 
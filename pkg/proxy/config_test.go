@@ -30,6 +30,7 @@ func TestFillDefault(t *testing.T) {
 	require.NotEqual(t, 0, c.RebalanceTolerance)
 	require.Less(t, c.RebalanceTolerance, float64(1))
 	require.NotEqual(t, 0, c.Cluster.RefreshInterval.Duration)
+	require.Equal(t, defaultUserInputDelimiters, c.UserInputDelimiters)
 }
 
 func TestValidate(t *testing.T) {
@@ -62,6 +63,17 @@ func TestValidate(t *testing.T) {
 				Timeout: time.Second,
 			},
 		},
+	}, {
+		name: "user input delimiters valid",
+		cfg: Config{
+			UserInputDelimiters: "|:",
+		},
+	}, {
+		name: "user input delimiters invalid",
+		cfg: Config{
+			UserInputDelimiters: "?",
+		},
+		wantErr: true,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
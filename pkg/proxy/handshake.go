@@ -57,7 +57,7 @@ func (c *clientConn) handleHandshakeResp() error {
 	}
 
 	// parse tenant information from client login request.
-	if err := c.clientInfo.parse(c.mysqlProto.GetUserName()); err != nil {
+	if err := c.clientInfo.parse(c.mysqlProto.GetUserName(), c.userInputDelimiters); err != nil {
 		return err
 	}
 
@@ -121,6 +121,14 @@ func (s *Scope) DropDatabase(c *Compile) error {
 	if err != nil {
 		return err
 	}
+
+	//4. delete the publications of the database for the force option
+	if sql = s.Plan.GetDdl().GetDropDatabase().GetDeletePubSql(); len(sql) != 0 {
+		err = c.runSql(sql)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
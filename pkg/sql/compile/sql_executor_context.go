@@ -80,7 +80,7 @@ func (c *compilerContext) ResolveSubscriptionTableById(tableId uint64, pubmeta *
 	panic("not supported in internal sql executor")
 }
 
-func (c *compilerContext) IsPublishing(dbName string) (bool, error) {
+func (c *compilerContext) GetPublicationsOfDatabase(dbName string) ([]string, error) {
 	panic("not supported in internal sql executor")
 }
 
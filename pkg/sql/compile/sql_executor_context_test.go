@@ -42,7 +42,7 @@ func Test_panic(t *testing.T) {
 
 	func() {
 		defer r()
-		_, _ = c.IsPublishing("")
+		_, _ = c.GetPublicationsOfDatabase("")
 	}()
 
 	func() {
@@ -89,7 +89,7 @@ func init() {
 		"condition":                  UNUSED,
 		"constraint":                 CONSTRAINT,
 		"consistent":                 CONSISTENT,
-		"continue":                   UNUSED,
+		"continue":                   CONTINUE,
 		"connection":                 CONNECTION,
 		"connect":                    CONNECT,
 		"convert":                    CONVERT,
@@ -177,8 +177,10 @@ func init() {
 		"expansion":                  EXPANSION,
 		"extended":                   EXTENDED,
 		"expire":                     EXPIRE,
+		"expiry":                     EXPIRY,
 		"except":                     EXCEPT,
 		"execute":                    EXECUTE,
+		"error":                      ERROR,
 		"errors":                     ERRORS,
 		"event":                      EVENT,
 		"events":                     EVENTS,
@@ -336,6 +338,8 @@ func init() {
 		"over":                       OVER,
 		"outfile":                    OUTFILE,
 		"ownership":                  OWNERSHIP,
+		"owner":                      OWNER,
+		"hierarchy":                  HIERARCHY,
 		"header":                     HEADER,
 		"headers":                    HEADERS,
 		"parallel":                   PARALLEL,
@@ -447,6 +451,8 @@ func init() {
 		"table":                      TABLE,
 		"tables":                     TABLES,
 		"tablespace":                 TABLESPACE,
+		"tag":                        TAG,
+		"tags":                       TAGS,
 		"terminated":                 TERMINATED,
 		"task":                       TASK,
 		"text":                       TEXT,
@@ -568,6 +574,12 @@ func init() {
 		"random":                     RANDOM,
 		"suspend":                    SUSPEND,
 		"restricted":                 RESTRICTED,
+		"quota":                      QUOTA,
+		"parent":                     PARENT,
+		"reason":                     REASON,
+		"dry":                        DRY,
+		"run":                        RUN,
+		"template":                   TEMPLATE,
 		"attribute":                  ATTRIBUTE,
 		"history":                    HISTORY,
 		"reuse":                      REUSE,
@@ -607,6 +619,7 @@ func init() {
 		"until":                      UNTIL,
 		"publication":                PUBLICATION,
 		"subscriptions":              SUBSCRIPTIONS,
+		"subscribers":                SUBSCRIBERS,
 		"publications":               PUBLICATIONS,
 		"roles":                      ROLES,
 		"backend":                    BACKEND,
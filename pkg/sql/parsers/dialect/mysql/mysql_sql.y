@@ -207,6 +207,10 @@ import (
     alterAccountAuthOption tree.AlterAccountAuthOption
     accountIdentified tree.AccountIdentified
     accountStatus tree.AccountStatus
+    accountQuota tree.AccountQuota
+    accountQuotas tree.AccountQuotas
+    accountSetting tree.AccountSetting
+    accountSettings tree.AccountSettings
     accountComment tree.AccountComment
     stageComment tree.StageComment
     stageStatus tree.StageStatus
@@ -267,7 +271,7 @@ import (
 %nonassoc LOWER_THAN_ORDER
 %nonassoc ORDER
 %nonassoc LOWER_THAN_COMMA
-%token <str> SELECT INSERT UPDATE DELETE FROM WHERE GROUP HAVING BY LIMIT OFFSET FOR CONNECT MANAGE GRANTS OWNERSHIP REFERENCE
+%token <str> SELECT INSERT UPDATE DELETE FROM WHERE GROUP HAVING BY LIMIT OFFSET FOR CONNECT MANAGE GRANTS OWNERSHIP OWNER HIERARCHY EXPIRY REFERENCE CONTINUE ERROR TAG TAGS
 %nonassoc LOWER_THAN_SET
 %nonassoc <str> SET
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST AFTER
@@ -346,7 +350,7 @@ import (
 // Sequence
 %token <str> INCREMENT CYCLE MINVALUE
 // publication
-%token <str> PUBLICATION SUBSCRIPTIONS PUBLICATIONS
+%token <str> PUBLICATION SUBSCRIPTIONS PUBLICATIONS SUBSCRIBERS
 
 
 // MO table option
@@ -373,7 +377,7 @@ import (
 %token <str> NULLX AUTO_INCREMENT APPROXNUM SIGNED UNSIGNED ZEROFILL ENGINES LOW_CARDINALITY AUTOEXTEND_SIZE
 
 // Account
-%token <str> ADMIN_NAME RANDOM SUSPEND ATTRIBUTE HISTORY REUSE CURRENT OPTIONAL FAILED_LOGIN_ATTEMPTS PASSWORD_LOCK_TIME UNBOUNDED SECONDARY RESTRICTED
+%token <str> ADMIN_NAME RANDOM SUSPEND ATTRIBUTE HISTORY REUSE CURRENT OPTIONAL FAILED_LOGIN_ATTEMPTS PASSWORD_LOCK_TIME UNBOUNDED SECONDARY RESTRICTED QUOTA REASON DRY RUN TEMPLATE PARENT
 
 // User
 %token <str> USER IDENTIFIED CIPHER ISSUER X509 SUBJECT SAN REQUIRE SSL NONE PASSWORD SHARED EXCLUSIVE
@@ -477,14 +481,15 @@ import (
 
 %type <statement> stmt block_stmt block_type_stmt normal_stmt
 %type <statements> stmt_list stmt_list_return
-%type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt truncate_table_stmt alter_sequence_stmt upgrade_stmt
+%type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt truncate_table_stmt alter_sequence_stmt alter_routine_owner_stmt alter_role_stmt upgrade_stmt
 %type <statement> delete_without_using_stmt delete_with_using_stmt
 %type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_prepare_stmt drop_view_stmt drop_connector_stmt drop_function_stmt drop_procedure_stmt drop_sequence_stmt
 %type <statement> drop_account_stmt drop_role_stmt drop_user_stmt
+%type <statement> merge_role_stmt
 %type <statement> create_account_stmt create_user_stmt create_role_stmt
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt create_function_stmt create_extension_stmt create_procedure_stmt create_sequence_stmt
 %type <statement> create_source_stmt create_connector_stmt pause_daemon_task_stmt cancel_daemon_task_stmt resume_daemon_task_stmt
-%type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt show_target_filter_stmt show_table_status_stmt show_grants_stmt show_collation_stmt show_accounts_stmt show_roles_stmt show_stages_stmt show_snapshots_stmt show_upgrade_stmt
+%type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt show_target_filter_stmt show_table_status_stmt show_grants_stmt show_collation_stmt show_accounts_stmt show_roles_stmt show_role_hierarchy_stmt show_stages_stmt show_snapshots_stmt show_upgrade_stmt
 %type <statement> show_tables_stmt show_sequences_stmt show_process_stmt show_errors_stmt show_warnings_stmt show_target
 %type <statement> show_procedure_status_stmt show_function_status_stmt show_node_list_stmt show_locks_stmt
 %type <statement> show_table_num_stmt show_column_num_stmt show_table_values_stmt show_table_size_stmt
@@ -528,7 +533,7 @@ import (
 
 // iteration
 %type <statement> loop_stmt iterate_stmt leave_stmt repeat_stmt while_stmt
-%type <statement> create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
+%type <statement> create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt show_subscribers_stmt
 %type <statement> create_stage_stmt drop_stage_stmt alter_stage_stmt
 %type <statement> create_snapshot_stmt drop_snapshot_stmt
 %type <str> urlparams
@@ -550,7 +555,7 @@ import (
 %type <orderBy> order_list order_by_clause order_by_opt
 %type <limit> limit_opt limit_clause
 %type <str> insert_column
-%type <identifierList> column_list column_list_opt partition_clause_opt partition_id_list insert_column_list accounts_list
+%type <identifierList> column_list column_list_opt partition_clause_opt partition_id_list insert_column_list accounts_list pub_table_list
 %type <joinCond> join_condition join_condition_opt on_expression_opt
 %type <selectLockInfo> select_lock_opt
 %type <upgrade_target> target
@@ -669,12 +674,12 @@ import (
 %type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
 %type <str> password_opt
-%type <boolVal> grant_option_opt enforce enforce_opt
+%type <boolVal> grant_option_opt continue_on_error_opt enforce enforce_opt
 
 %type <varAssignmentExpr> var_assignment
 %type <varAssignmentExprs> var_assignment_list
 %type <str> var_name equal_or_assignment
-%type <strs> var_name_list
+%type <strs> var_name_list role_tags_opt role_tag_list
 %type <expr> set_expr
 //%type <setRole> set_role_opt
 %type <setDefaultRole> set_default_role_opt
@@ -759,13 +764,18 @@ import (
 %type <str> explain_foramt_value trim_direction
 %type <str> priority_opt priority quick_opt ignore_opt wild_opt
 
-%type <str> account_name account_role_name
+%type <str> account_name account_role_name in_account_opt
 %type <expr> account_name_or_param account_admin_name
 %type <accountAuthOption> account_auth_option
 %type <alterAccountAuthOption> alter_account_auth_option
 %type <accountIdentified> account_identified
 %type <accountStatus> account_status_option
 %type <accountComment> account_comment_opt
+%type <accountQuota> account_quota
+%type <accountQuotas> account_quota_list
+%type <accountSetting> account_setting
+%type <accountSettings> account_settings_opt account_setting_list
+%type <str> account_template_opt
 %type <accountCommentOrAttribute> user_comment_or_attribute_opt
 %type <stageComment> stage_comment_opt
 %type <stageStatus> stage_status_opt
@@ -905,6 +915,7 @@ normal_stmt:
 |   lock_stmt
 |   revoke_stmt
 |   grant_stmt
+|   merge_role_stmt
 |   load_data_stmt
 |   load_extension_stmt
 |   do_stmt
@@ -1702,20 +1713,38 @@ local_opt:
     }
 
 grant_stmt:
-    GRANT priv_list ON object_type priv_level TO role_spec_list grant_option_opt
+    GRANT priv_list ON object_type priv_level TO role_spec_list grant_option_opt continue_on_error_opt
     {
         $$ = &tree.Grant{
             Typ: tree.GrantTypePrivilege,
             GrantPrivilege: tree.GrantPrivilege{
                 Privileges: $2,
                 ObjType: $4,
-                Level: $5,
+                Level: tree.ResolvePrivilegeLevel($4, $5),
                 Roles: $7,
                 GrantOption: $8,
+                ContinueOnError: $9,
             },
         }
     }
-|   GRANT role_spec_list TO drop_user_spec_list grant_option_opt
+|   GRANT priv_list ON ALL TABLES IN DATABASE ident TO role_spec_list grant_option_opt continue_on_error_opt
+    {
+        $$ = &tree.Grant{
+            Typ: tree.GrantTypePrivilege,
+            GrantPrivilege: tree.GrantPrivilege{
+                Privileges: $2,
+                ObjType: tree.OBJECT_TYPE_TABLE,
+                Level: &tree.PrivilegeLevel{
+                    Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE_ALL_TABLES,
+                    DbName: $8.Compare(),
+                },
+                Roles: $10,
+                GrantOption: $11,
+                ContinueOnError: $12,
+            },
+        }
+    }
+|   GRANT role_spec_list TO drop_user_spec_list grant_option_opt continue_on_error_opt
     {
         $$ = &tree.Grant{
             Typ: tree.GrantTypeRole,
@@ -1723,6 +1752,32 @@ grant_stmt:
                 Roles: $2,
                 Users: $4,
                 GrantOption: $5,
+                ContinueOnError: $6,
+            },
+        }
+    }
+|   GRANT role_spec_list TO drop_user_spec_list WITH EXPIRY STRING continue_on_error_opt
+    {
+        $$ = &tree.Grant{
+            Typ: tree.GrantTypeRole,
+            GrantRole:tree.GrantRole{
+                Roles: $2,
+                Users: $4,
+                Expiry: $7,
+                ContinueOnError: $8,
+            },
+        }
+    }
+|   GRANT role_spec_list TO drop_user_spec_list WITH GRANT OPTION WITH EXPIRY STRING continue_on_error_opt
+    {
+        $$ = &tree.Grant{
+            Typ: tree.GrantTypeRole,
+            GrantRole:tree.GrantRole{
+                Roles: $2,
+                Users: $4,
+                GrantOption: true,
+                Expiry: $10,
+                ContinueOnError: $11,
             },
         }
     }
@@ -1747,11 +1802,29 @@ grant_option_opt:
     {
         $$ = true
     }
+
+continue_on_error_opt:
+    {
+        $$ = false
+    }
+|   CONTINUE ON ERROR
+    {
+        $$ = true
+    }
 // |    WITH MAX_QUERIES_PER_HOUR INTEGRAL
 // |    WITH MAX_UPDATES_PER_HOUR INTEGRAL
 // |    WITH MAX_CONNECTIONS_PER_HOUR INTEGRAL
 // |    WITH MAX_USER_CONNECTIONS INTEGRAL
 
+merge_role_stmt:
+    MERGE ROLE role_spec INTO role_spec
+    {
+        $$ = &tree.MergeRole{
+            Source: $3,
+            Target: $5,
+        }
+    }
+
 revoke_stmt:
     REVOKE exists_opt  priv_list ON object_type priv_level FROM role_spec_list
     {
@@ -1761,11 +1834,59 @@ revoke_stmt:
                 IfExists: $2,
                 Privileges: $3,
                 ObjType: $5,
-                Level: $6,
+                Level: tree.ResolvePrivilegeLevel($5, $6),
                 Roles: $8,
             },
         }
     }
+|   REVOKE exists_opt GRANT OPTION FOR priv_list ON object_type priv_level FROM role_spec_list
+    {
+        $$ = &tree.Revoke{
+            Typ: tree.RevokeTypePrivilege,
+            RevokePrivilege: tree.RevokePrivilege{
+                IfExists: $2,
+                GrantOptionOnly: true,
+                Privileges: $6,
+                ObjType: $8,
+                Level: tree.ResolvePrivilegeLevel($8, $9),
+                Roles: $11,
+            },
+        }
+    }
+|   REVOKE exists_opt priv_list ON ALL TABLES IN DATABASE ident FROM role_spec_list
+    {
+        $$ = &tree.Revoke{
+            Typ: tree.RevokeTypePrivilege,
+            RevokePrivilege: tree.RevokePrivilege{
+                IfExists: $2,
+                Privileges: $3,
+                ObjType: tree.OBJECT_TYPE_TABLE,
+                Level: &tree.PrivilegeLevel{
+                    Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE_ALL_TABLES,
+                    DbName: $9.Compare(),
+                },
+                Roles: $11,
+            },
+        }
+    }
+|   REVOKE exists_opt priv_list FROM role_spec_list
+    {
+        // only REVOKE ALL PRIVILEGES, GRANT OPTION FROM roles
+        if len($3) != 2 || $3[0].Type != tree.PRIVILEGE_TYPE_STATIC_ALL || $3[0].ColumnList != nil ||
+            $3[1].Type != tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION || $3[1].ColumnList != nil {
+            yylex.Error("only ALL PRIVILEGES, GRANT OPTION can be revoked without the object")
+            goto ret1
+        }
+        $$ = &tree.Revoke{
+            Typ: tree.RevokeTypePrivilege,
+            RevokePrivilege: tree.RevokePrivilege{
+                IfExists: $2,
+                Privileges: $3,
+                ObjType: tree.OBJECT_TYPE_NONE,
+                Roles: $5,
+            },
+        }
+    }
 |   REVOKE exists_opt role_spec_list FROM user_spec_list
     {
         $$ = &tree.Revoke{
@@ -2289,7 +2410,7 @@ var_assignment:
     {
         $$ = &tree.VarAssignmentExpr{
             System: true,
-            Global: true,
+            Persist: true,
             Name: $2,
             Value: $4,
         }
@@ -2733,6 +2854,16 @@ reset_stmt:
     {
         $$ = tree.NewReset(tree.Identifier($3))
     }
+|   reset_sym MYSQL_COMPATIBILITY_MODE
+    {
+        $$ = &tree.ResetMysqlCompatibilityMode{}
+    }
+|   reset_sym MYSQL_COMPATIBILITY_MODE FOR DATABASE db_name
+    {
+        $$ = &tree.ResetMysqlCompatibilityMode{
+            DbName: $5,
+        }
+    }
 
 explainable_stmt:
     delete_stmt
@@ -2945,8 +3076,54 @@ alter_stmt:
 |   alter_publication_stmt
 |   alter_stage_stmt
 |   alter_sequence_stmt
+|   alter_routine_owner_stmt
+|   alter_role_stmt
 // |    alter_ddl_stmt
 
+alter_routine_owner_stmt:
+    ALTER FUNCTION func_name OWNER TO role_name
+    {
+        $$ = &tree.AlterRoutineOwner{
+            IsFunction: true,
+            DbName: $3.Name.SchemaName,
+            Name: $3.Name.ObjectName,
+            Owner: $6.Compare(),
+        }
+    }
+|   ALTER PROCEDURE proc_name OWNER TO role_name
+    {
+        $$ = &tree.AlterRoutineOwner{
+            IsFunction: false,
+            DbName: $3.Name.SchemaName,
+            Name: $3.Name.ObjectName,
+            Owner: $6.Compare(),
+        }
+    }
+
+alter_role_stmt:
+    ALTER ROLE role_spec COMMENT_KEYWORD STRING
+    {
+        $$ = &tree.AlterRole{
+            Role: $3,
+            Comment: $5,
+        }
+    }
+|   ALTER ROLE role_spec TAGS '(' ')'
+    {
+        $$ = &tree.AlterRole{
+            Role: $3,
+            SetTags: true,
+        }
+    }
+|   ALTER ROLE role_spec TAGS '(' role_tag_list ')'
+    {
+        $$ = &tree.AlterRole{
+            Role: $3,
+            Tags: $6,
+            SetTags: true,
+        }
+    }
+
 alter_sequence_stmt:
     ALTER SEQUENCE exists_opt table_name alter_as_datatype_opt increment_by_opt min_value_opt max_value_opt start_with_opt alter_cycle_opt
     {
@@ -3420,6 +3597,83 @@ alter_account_stmt:
             comment,
         )
     }
+|   ALTER ACCOUNT exists_opt account_name_or_param RENAME TO account_name
+    {
+        var ifExists = $3
+        var name = $4
+        var newName = $7
+
+        alter := tree.NewAlterAccount(
+            ifExists,
+            name,
+            tree.AlterAccountAuthOption{Exist: false},
+            tree.AccountStatus{},
+            tree.AccountComment{},
+        )
+        alter.RenameTo = newName
+        $$ = alter
+    }
+|   ALTER ACCOUNT exists_opt account_name_or_param SET QUOTA account_quota_list
+    {
+        var ifExists = $3
+        var name = $4
+        var quotas = $7
+
+        alter := tree.NewAlterAccount(
+            ifExists,
+            name,
+            tree.AlterAccountAuthOption{Exist: false},
+            tree.AccountStatus{},
+            tree.AccountComment{},
+        )
+        alter.Quotas = quotas
+        $$ = alter
+    }
+|   ALTER ACCOUNT exists_opt account_name_or_param SET PARENT account_name
+    {
+        var ifExists = $3
+        var name = $4
+        var parent = $7
+
+        alter := tree.NewAlterAccount(
+            ifExists,
+            name,
+            tree.AlterAccountAuthOption{Exist: false},
+            tree.AccountStatus{},
+            tree.AccountComment{},
+        )
+        alter.Parent = parent
+        $$ = alter
+    }
+
+account_quota_list:
+    account_quota
+    {
+        $$ = tree.AccountQuotas{$1}
+    }
+|   account_quota_list ',' account_quota
+    {
+        $$ = append($1, $3)
+    }
+
+account_quota:
+    ident equal_opt INTEGRAL
+    {
+        var Name = $1.Compare()
+        var Value = $3.(int64)
+        $$ = tree.AccountQuota{
+            Name: Name,
+            Value: Value,
+        }
+    }
+|   ident equal_opt NULL
+    {
+        var Name = $1.Compare()
+        $$ = tree.AccountQuota{
+            Name: Name,
+            Unlimited: true,
+        }
+    }
 
 alter_database_config_stmt:
     ALTER DATABASE db_name SET MYSQL_COMPATIBILITY_MODE '=' STRING
@@ -3661,6 +3915,7 @@ show_stmt:
 |   show_table_status_stmt
 |   show_grants_stmt
 |   show_roles_stmt
+|   show_role_hierarchy_stmt
 |   show_collation_stmt
 |   show_function_status_stmt
 |   show_procedure_status_stmt
@@ -3674,6 +3929,7 @@ show_stmt:
 |   show_upgrade_stmt
 |   show_publications_stmt
 |   show_subscriptions_stmt
+|   show_subscribers_stmt
 |   show_servers_stmt
 |   show_stages_stmt
 |   show_connectors_stmt
@@ -3756,6 +4012,15 @@ show_function_status_stmt:
             IsFunction: true,
         }
     }
+|   SHOW FUNCTION OWNER like_opt where_expression_opt
+    {
+       $$ = &tree.ShowFunctionOrProcedureStatus{
+            Like: $4,
+            Where: $5,
+            IsFunction: true,
+            Owner: true,
+        }
+    }
 
 show_procedure_status_stmt:
     SHOW PROCEDURE STATUS like_opt where_expression_opt
@@ -3766,6 +4031,15 @@ show_procedure_status_stmt:
             IsFunction: false,
         }
     }
+|   SHOW PROCEDURE OWNER like_opt where_expression_opt
+    {
+        $$ = &tree.ShowFunctionOrProcedureStatus{
+            Like: $4,
+            Where: $5,
+            IsFunction: false,
+            Owner: true,
+        }
+    }
 
 show_roles_stmt:
     SHOW ROLES like_opt
@@ -3774,6 +4048,22 @@ show_roles_stmt:
             Like: $3,
         }
     }
+|   SHOW ROLES WITH TAG STRING
+    {
+        $$ = &tree.ShowRolesStmt{
+            Tag: $5,
+        }
+    }
+
+show_role_hierarchy_stmt:
+    SHOW ROLE HIERARCHY
+    {
+        $$ = &tree.ShowRoleHierarchy{}
+    }
+|   SHOW ROLE HIERARCHY FOR user_name
+    {
+        $$ = &tree.ShowRoleHierarchy{Username: $5.Username, Hostname: $5.Hostname}
+    }
 
 show_node_list_stmt:
     SHOW NODE LIST
@@ -3998,9 +4288,9 @@ show_columns_stmt:
     }
 
 show_accounts_stmt:
-    SHOW ACCOUNTS like_opt
+    SHOW ACCOUNTS like_opt where_expression_opt
     {
-        $$ = &tree.ShowAccounts{Like: $3}
+        $$ = &tree.ShowAccounts{Like: $3, Where: $4}
     }
 
 show_publications_stmt:
@@ -4026,6 +4316,12 @@ show_subscriptions_stmt:
 	$$ = &tree.ShowSubscriptions{All: true, Like: $4}
     }
 
+show_subscribers_stmt:
+    SHOW SUBSCRIBERS FROM ident
+    {
+	$$ = &tree.ShowSubscribers{Publication: tree.Identifier($4.Compare())}
+    }
+
 like_opt:
     {
         $$ = nil
@@ -4106,6 +4402,14 @@ show_create_stmt:
     {
 	    $$ = &tree.ShowCreatePublications{Name: $4}
     }
+|   SHOW CREATE FUNCTION func_name
+    {
+	    $$ = &tree.ShowCreateFunction{Name: $4}
+    }
+|   SHOW CREATE PROCEDURE proc_name
+    {
+	    $$ = &tree.ShowCreateProcedure{Name: $4}
+    }
 
 show_servers_stmt:
     SHOW BACKEND SERVERS
@@ -4188,6 +4492,22 @@ drop_account_stmt:
         var name = $4
         $$ = tree.NewDropAccount(ifExists, name)
     }
+|   DROP ACCOUNT exists_opt account_name_or_param FORCE
+    {
+        var ifExists = $3
+        var name = $4
+        drop := tree.NewDropAccount(ifExists, name)
+        drop.Force = true
+        $$ = drop
+    }
+|   DROP ACCOUNT exists_opt account_name_or_param DRY RUN
+    {
+        var ifExists = $3
+        var name = $4
+        drop := tree.NewDropAccount(ifExists, name)
+        drop.DryRun = true
+        $$ = drop
+    }
 
 drop_user_stmt:
     DROP USER exists_opt drop_user_spec_list
@@ -4274,12 +4594,28 @@ drop_database_stmt:
         var ifExists = $3
         $$ = tree.NewDropDatabase(name, ifExists)
     }
+|   DROP DATABASE exists_opt ident FORCE
+    {
+        var name = tree.Identifier($4.Compare())
+        var ifExists = $3
+        drop := tree.NewDropDatabase(name, ifExists)
+        drop.Force = true
+        $$ = drop
+    }
 |   DROP SCHEMA exists_opt ident
     {
         var name = tree.Identifier($4.Compare())
         var ifExists = $3
         $$ = tree.NewDropDatabase(name, ifExists)
     }
+|   DROP SCHEMA exists_opt ident FORCE
+    {
+        var name = tree.Identifier($4.Compare())
+        var ifExists = $3
+        drop := tree.NewDropDatabase(name, ifExists)
+        drop.Force = true
+        $$ = drop
+    }
 
 drop_prepare_stmt:
     DROP PREPARE stmt_name
@@ -4294,6 +4630,14 @@ drop_function_stmt:
         var args = $5
         $$ = tree.NewDropFunction(name, args)
     }
+|   DROP FUNCTION IF EXISTS func_name '(' func_args_list_opt ')'
+    {
+        var name = $5
+        var args = $7
+        dropFunction := tree.NewDropFunction(name, args)
+        dropFunction.IfExists = true
+        $$ = dropFunction
+    }
 
 drop_procedure_stmt:
     DROP PROCEDURE proc_name
@@ -4308,6 +4652,40 @@ drop_procedure_stmt:
         var ifExists = true
         $$ = tree.NewDropProcedure(name, ifExists)
     }
+|    DROP PROCEDURE proc_name '(' proc_args_list_opt ')'
+    {
+        var name = $3
+        var ifExists = false
+        dropProcedure := tree.NewDropProcedure(name, ifExists)
+        dropProcedure.Args = $5
+        dropProcedure.WithArgs = true
+        $$ = dropProcedure
+    }
+|    DROP PROCEDURE IF EXISTS proc_name '(' proc_args_list_opt ')'
+    {
+        var name = $5
+        var ifExists = true
+        dropProcedure := tree.NewDropProcedure(name, ifExists)
+        dropProcedure.Args = $7
+        dropProcedure.WithArgs = true
+        $$ = dropProcedure
+    }
+|    DROP PROCEDURE proc_name ALL
+    {
+        var name = $3
+        var ifExists = false
+        dropProcedure := tree.NewDropProcedure(name, ifExists)
+        dropProcedure.All = true
+        $$ = dropProcedure
+    }
+|    DROP PROCEDURE IF EXISTS proc_name ALL
+    {
+        var name = $5
+        var ifExists = true
+        dropProcedure := tree.NewDropProcedure(name, ifExists)
+        dropProcedure.All = true
+        $$ = dropProcedure
+    }
 
 delete_stmt:
     delete_without_using_stmt
@@ -6045,20 +6423,62 @@ create_view_stmt:
     }
 
 create_account_stmt:
-    CREATE ACCOUNT not_exists_opt account_name_or_param account_auth_option account_status_option account_comment_opt
+    CREATE ACCOUNT not_exists_opt account_name_or_param account_template_opt account_auth_option account_status_option account_comment_opt account_settings_opt
     {
         var IfNotExists = $3
         var Name = $4
-        var AuthOption = $5
-        var StatusOption = $6
-        var Comment = $7
-   		$$ = tree.NewCreateAccount(
+        var AuthOption = $6
+        var StatusOption = $7
+        var Comment = $8
+   		ca := tree.NewCreateAccount(
         	IfNotExists,
             Name,
             AuthOption,
             StatusOption,
             Comment,
     	)
+        ca.Template = $5
+        ca.Settings = $9
+        $$ = ca
+    }
+
+account_template_opt:
+    {
+        $$ = ""
+    }
+|   FROM TEMPLATE ident
+    {
+        $$ = $3.Compare()
+    }
+
+account_settings_opt:
+    {
+        $$ = nil
+    }
+|   WITH account_setting_list
+    {
+        $$ = $2
+    }
+
+account_setting_list:
+    account_setting
+    {
+        $$ = tree.AccountSettings{$1}
+    }
+|   account_setting_list ',' account_setting
+    {
+        $$ = append($1, $3)
+    }
+
+account_setting:
+    ident equal_opt STRING
+    {
+        var Name = $1.Compare()
+        var Value = $3
+        $$ = tree.AccountSetting{
+            Name: Name,
+            Value: Value,
+        }
     }
 
 view_list_opt:
@@ -6213,6 +6633,14 @@ account_status_option:
         as.Option = tree.AccountStatusSuspend
         $$ = *as
     }
+|   SUSPEND REASON STRING
+    {
+        as := tree.NewAccountStatus()
+        as.Exist = true
+        as.Option = tree.AccountStatusSuspend
+        as.Reason = $3
+        $$ = *as
+    }
 |   RESTRICTED
     {
         as := tree.NewAccountStatus()
@@ -6236,20 +6664,32 @@ account_comment_opt:
     }
 
 create_user_stmt:
-    CREATE USER not_exists_opt user_spec_list_of_create_user default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
+    CREATE USER not_exists_opt user_spec_list_of_create_user default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt in_account_opt
     {
         var IfNotExists = $3
         var Users = $4
         var Role = $5
         var MiscOpt = $6
         var CommentOrAttribute = $7
-        $$ = tree.NewCreateUser(
+        cu := tree.NewCreateUser(
             IfNotExists,
             Users,
             Role,
             MiscOpt,
             CommentOrAttribute,
         )
+        cu.Account = $8
+        $$ = cu
+    }
+
+in_account_opt:
+    /* EMPTY */
+    {
+        $$ = ""
+    }
+|   IN ACCOUNT account_name
+    {
+        $$ = $3
     }
 
 create_publication_stmt:
@@ -6266,6 +6706,26 @@ create_publication_stmt:
             Name,
             Database,
             Table,
+            nil,
+            AccountsSet,
+            Comment,
+        )
+    }
+|   CREATE PUBLICATION not_exists_opt ident DATABASE ident TABLE pub_table_list alter_publication_accounts_opt comment_opt
+    {
+        var IfNotExists = $3
+        var Name = tree.Identifier($4.Compare())
+        var Database = tree.Identifier($6.Compare())
+        var Table = tree.Identifier("")
+        var Tables = $8
+        var AccountsSet = $9
+        var Comment = $10
+        $$ = tree.NewCreatePublication(
+            IfNotExists,
+            Name,
+            Database,
+            Table,
+            Tables,
             AccountsSet,
             Comment,
         )
@@ -6283,11 +6743,22 @@ create_publication_stmt:
             Name,
             Database,
             Table,
+            nil,
             AccountsSet,
             Comment,
         )
     }
 
+pub_table_list:
+    ident
+    {
+        $$ = tree.IdentifierList{tree.Identifier($1.Compare())}
+    }
+|   pub_table_list ',' ident
+    {
+        $$ = append($1, tree.Identifier($3.Compare()))
+    }
+
 create_stage_stmt:
     CREATE STAGE not_exists_opt ident urlparams stage_credentials_opt stage_status_opt stage_comment_opt
     {
@@ -6445,6 +6916,19 @@ alter_publication_accounts_opt:
 	        SetAccounts: $2,
 	    }
     }
+    | ACCOUNT LIKE STRING
+    {
+    	$$ = &tree.AccountsSetOption{
+	        LikePattern: $3,
+	    }
+    }
+    | ACCOUNT accounts_list LIKE STRING
+    {
+    	$$ = &tree.AccountsSetOption{
+	        SetAccounts: $2,
+	        LikePattern: $4,
+	    }
+    }
     | ACCOUNT ADD accounts_list
     {
     	$$ = &tree.AccountsSetOption{
@@ -6727,14 +7211,37 @@ name_string:
 |   STRING
 
 create_role_stmt:
-    CREATE ROLE not_exists_opt role_spec_list
+    CREATE ROLE not_exists_opt role_spec_list role_tags_opt in_account_opt
     {
         var IfNotExists = $3
         var Roles = $4
-        $$ = tree.NewCreateRole(
+        cr := tree.NewCreateRole(
             IfNotExists,
             Roles,
         )
+        cr.Tags = $5
+        cr.Account = $6
+        $$ = cr
+    }
+
+role_tags_opt:
+    /* EMPTY */
+    {
+        $$ = nil
+    }
+|   TAGS '(' role_tag_list ')'
+    {
+        $$ = $3
+    }
+
+role_tag_list:
+    STRING
+    {
+        $$ = []string{$1}
+    }
+|   role_tag_list ',' STRING
+    {
+        $$ = append($1, $3)
     }
 
 role_spec_list:
@@ -11911,6 +12418,7 @@ non_reserved_keyword:
 |	PROCESSLIST
 |   PUBLICATION
 |   SUBSCRIPTIONS
+|   SUBSCRIBERS
 |   PUBLICATIONS
 |   PROPERTIES
 |	WEEK
@@ -12018,6 +12526,12 @@ non_reserved_keyword:
 |	RANDOM
 |	SUSPEND
 |	RESTRICTED
+|	QUOTA
+|	PARENT
+|	REASON
+|	DRY
+|	RUN
+|	TEMPLATE
 |	REUSE
 |	CURRENT
 |	OPTIONAL
@@ -12045,6 +12559,12 @@ non_reserved_keyword:
 |	SAMPLE
 |	PERCENT
 |	OWNERSHIP
+|	OWNER
+|	HIERARCHY
+|	EXPIRY
+|	ERROR
+|	TAG
+|	TAGS
 |   MO_TS
 
 func_not_keyword:
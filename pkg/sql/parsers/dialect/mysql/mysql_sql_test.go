@@ -237,6 +237,11 @@ var (
 	}, {
 		input:  "alter database test set mysql_compatibility_mode = '{transaction_isolation: REPEATABLE-READ, lower_case_table_names: 0}'",
 		output: "alter database configuration for test as {transaction_isolation: REPEATABLE-READ, lower_case_table_names: 0} ",
+	}, {
+		input: "reset mysql_compatibility_mode",
+	}, {
+		input:  "RESET MYSQL_COMPATIBILITY_MODE FOR DATABASE db1",
+		output: "reset mysql_compatibility_mode for database db1",
 	}, {
 		input: "show profiles",
 	}, {
@@ -255,6 +260,14 @@ var (
 		input: "show plugins",
 	}, {
 		input: "show procedure status",
+	}, {
+		input: "show procedure owner",
+	}, {
+		input:  "show function owner like 'f%'",
+		output: "show function owner like f%",
+	}, {
+		input:  "show procedure owner where db = 'db1'",
+		output: "show procedure owner where db = db1",
 	}, {
 		input: "show triggers from db1 where 1",
 	}, {
@@ -266,6 +279,15 @@ var (
 	}, {
 		input:  "show grants for 'test'@'localhost'",
 		output: "show grants for test@localhost",
+	}, {
+		input:  "show role hierarchy",
+		output: "show role hierarchy",
+	}, {
+		input:  "show role hierarchy for 'u1'@'localhost'",
+		output: "show role hierarchy for u1@localhost",
+	}, {
+		input:  "show role hierarchy for u1",
+		output: "show role hierarchy for u1@%",
 	}, {
 		input: "show table status from t1",
 	}, {
@@ -1314,8 +1336,10 @@ var (
 		}, {
 			input: "set global a = 1",
 		}, {
-			input:  "set persist a = 1",
-			output: "set global a = 1",
+			input: "set persist a = 1",
+		}, {
+			input:  "set persist sql_mode = 'ANSI', b = 2",
+			output: "set persist sql_mode = ANSI, b = 2",
 		}, {
 			input:  "alter account config set MYSQL_COMPATIBILITY_MODE a = 1",
 			output: "set global a = 1",
@@ -1446,6 +1470,11 @@ var (
 			input: "drop database if exists t",
 		}, {
 			input: "drop database t",
+		}, {
+			input: "drop database if exists t force",
+		}, {
+			input:  "drop schema t force",
+			output: "drop database t force",
 		}, {
 			input:  "create role if not exists 'a', 'b'",
 			output: "create role if not exists a, b",
@@ -1668,10 +1697,23 @@ var (
 			output: "create account ? admin_name 'admin' identified with '******'",
 		}, {
 			input: "create account nihao admin_name ? identified by ?",
+		}, {
+			input:  "create account nihao admin_name 'admin' identified by '123' with time_zone = '+08:00', character_set = 'utf8mb4'",
+			output: "create account nihao admin_name 'admin' identified by '******' with time_zone = '+08:00', character_set = 'utf8mb4'",
+		}, {
+			input:  "create account nihao admin_name 'admin' identified by '123' comment 'new account' with TIME_ZONE 'Asia/Shanghai'",
+			output: "create account nihao admin_name 'admin' identified by '******' comment 'new account' with time_zone = 'Asia/Shanghai'",
+		}, {
+			input:  "create account nihao from template golden admin_name 'admin' identified by '123'",
+			output: "create account nihao from template golden admin_name 'admin' identified by '******'",
 		}, {
 			input: "drop account if exists abc",
 		}, {
 			input: "drop account ?",
+		}, {
+			input: "drop account if exists abc force",
+		}, {
+			input: "drop account if exists abc dry run",
 		}, {
 			input:  "alter account if exists nihao admin_name 'admin' identified by '123' open comment 'new account'",
 			output: "alter account if exists nihao admin_name 'admin' identified by '******' open comment 'new account'",
@@ -1690,6 +1732,18 @@ var (
 			output: "alter account nihao admin_name 'admin' identified with '******'",
 		}, {
 			input: "alter account ? admin_name ? identified with ?",
+		}, {
+			input: "alter account if exists nihao rename to nihao2",
+		}, {
+			input: "alter account ? rename to nihao2",
+		}, {
+			input:  "alter account nihao set quota max_databases = 10, max_tables 100, max_connections = null",
+			output: "alter account nihao set quota max_databases = 10, max_tables = 100, max_connections = null",
+		}, {
+			input: "alter account child1 set parent parent1",
+		}, {
+			input:  "create user u1 identified by '123' in account child1",
+			output: "create user u1 identified by '******' in account child1",
 		}, {
 			input: "create user if not exists abc1 identified by '123', abc2 identified by '234', abc3 identified by '111' default role def_role " +
 				"password expire " +
@@ -1831,12 +1885,42 @@ var (
 			input: "grant all, all(a, b) on table db1.tb1 to u1, u2 with grant option",
 		}, {
 			input: "grant all, all(a, b) on table tb1 to u1, u2 with grant option",
+		}, {
+			input:  "grant select, insert on all tables in database DB1 to r1, r2 with grant option",
+			output: "grant select, insert on all tables in database db1 to r1, r2 with grant option",
+		}, {
+			input: "revoke if exists select on all tables in database db1 from r1",
+		}, {
+			input:  "revoke all privileges, grant option from r1, r2",
+			output: "revoke all, grant option from r1, r2",
+		}, {
+			input: "revoke if exists all, grant option from r1",
+		}, {
+			input: "revoke grant option for select, insert on table db1.t1 from r1, r2",
+		}, {
+			input:  "revoke if exists grant option for create user on account * from R1",
+			output: "revoke if exists grant option for create user on account * from r1",
 		}, {
 			input: "grant r1, r2 to u1, u2, r3 with grant option",
+		}, {
+			input: "grant r1, r2 to u1, u2, r3 with grant option continue on error",
+		}, {
+			input: "grant r1 to u1 with expiry '2025-01-01' continue on error",
+		}, {
+			input:  "grant select, insert on table db1.T1 to r1, r2 continue on error",
+			output: "grant select, insert on table db1.t1 to r1, r2 continue on error",
+		}, {
+			input: "grant select on all tables in database db1 to r1 with grant option continue on error",
+		}, {
+			input: "select error from t1",
 		}, {
 			input: "grant r1, r2 to u1, u2, r3",
 		}, {
 			input: "grant r1, r2 to u1@h1, u2@h2, r3",
+		}, {
+			input: "grant r1 to u1 with expiry '2025-01-01'",
+		}, {
+			input: "grant r1 to u1, r2 with grant option with expiry '2025-01-01 10:00:00'",
 		}, {
 			input:  "revoke if exists all, all(a, b), create(a, b), select(a, b), super(a, b, c) on table db.A from u1, u2",
 			output: "revoke if exists all, all(a, b), create(a, b), select(a, b), super(a, b, c) on table db.a from u1, u2",
@@ -2011,6 +2095,12 @@ var (
 			input:  "alter account if exists abc restricted",
 			output: "alter account if exists abc restricted",
 		},
+		{
+			input: "alter account if exists abc suspend reason 'overdue'",
+		},
+		{
+			input: "alter account if exists abc suspend reason 'overdue' comment 'str'",
+		},
 		{
 			input:  "alter account if exists abc admin_name 'root' identified by '111' open",
 			output: "alter account if exists abc admin_name 'root' identified by '******' open",
@@ -2086,6 +2176,14 @@ var (
 			input:  `drop function t.increment (float)`,
 			output: `drop function t.increment (float)`,
 		},
+		{
+			input:  `drop function if exists helloworld ()`,
+			output: `drop function if exists helloworld ()`,
+		},
+		{
+			input:  `drop function if exists t.twosum (int, int)`,
+			output: `drop function if exists t.twosum (int, int)`,
+		},
 		{
 			input:  `create extension python as strutil file 'stringutils.whl'`,
 			output: `create extension python as strutil file stringutils.whl`,
@@ -2135,6 +2233,10 @@ var (
 			input:  "show accounts like '%dafgda_'",
 			output: "show accounts like %dafgda_",
 		},
+		{
+			input:  "show accounts where status = 'suspend'",
+			output: "show accounts where status = suspend",
+		},
 		{
 			input:  "create table test (`col` varchar(255) DEFAULT b'0')",
 			output: "create table test (col varchar(255) default 0b0)",
@@ -2412,6 +2514,19 @@ var (
 		{
 			input: "create publication pub1 database db1 comment 'test'",
 		},
+		{
+			input: "create publication pub1 database db1 account like 'team_%'",
+		},
+		{
+			input:  "CREATE PUBLICATION pub1 DATABASE db1 ACCOUNT acc0, acc1 LIKE \"team_%\" COMMENT 'test'",
+			output: "create publication pub1 database db1 account acc0, acc1 like 'team_%' comment 'test'",
+		},
+		{
+			input: "create publication pub1 database db1 table t1",
+		},
+		{
+			input: "create publication pub1 database db1 table t1, t2 account acc0, acc1 comment 'test'",
+		},
 		{
 			input: "create publication pub1 table t1",
 		},
@@ -2492,6 +2607,18 @@ var (
 		{
 			input: "alter publication pub1 account add acc0",
 		},
+		{
+			input: "alter publication pub1 database db2",
+		},
+		{
+			input: "alter publication pub1 account like 'team_%'",
+		},
+		{
+			input: "alter publication pub1 account acc0, acc1 like 'team_%' comment 'test'",
+		},
+		{
+			input: "alter publication pub1 account acc0 database db2 comment 'test'",
+		},
 		{
 			input: "restore cluster from snapshot snapshot_01",
 		},
@@ -2526,6 +2653,16 @@ var (
 		{
 			input: "show create publication pub1",
 		},
+		{
+			input: "show create function f1",
+		},
+		{
+			input: "show create function db1.f1",
+		},
+		{
+			input:  "SHOW CREATE PROCEDURE db1.p1",
+			output: "show create procedure db1.p1",
+		},
 		{
 			input: "show publications",
 		},
@@ -2539,6 +2676,9 @@ var (
 			input:  "show subscriptions all like '%pub'",
 			output: "show subscriptions all like %pub",
 		},
+		{
+			input: "show subscribers from pub1",
+		},
 		{
 			input:  "insert into tbl values ($$this is a dollar-quoted string$$)",
 			output: "insert into tbl values (this is a dollar-quoted string)",
@@ -2567,6 +2707,10 @@ var (
 			input:  "show roles like '%dafgda_'",
 			output: "show roles like %dafgda_",
 		},
+		{
+			input:  "show roles with tag 'pii'",
+			output: "show roles with tag 'pii'",
+		},
 		{
 			input:  "create procedure test1 (in param1 int) 'test test'",
 			output: "create procedure test1 (in param1 int) 'test test'",
@@ -2579,6 +2723,54 @@ var (
 			input:  "drop procedure test1",
 			output: "drop procedure test1",
 		},
+		{
+			input:  "drop procedure test1 ()",
+			output: "drop procedure test1 ()",
+		},
+		{
+			input:  "drop procedure if exists test2 (param1 int, inout param2 char(5))",
+			output: "drop procedure if exists test2 (in param1 int, inout param2 char(5))",
+		},
+		{
+			input:  "drop procedure test1 all",
+			output: "drop procedure test1 all",
+		},
+		{
+			input:  "drop procedure if exists db1.test1 all",
+			output: "drop procedure if exists db1.test1 all",
+		},
+		{
+			input:  "alter procedure test1 owner to r1",
+			output: "alter procedure test1 owner to r1",
+		},
+		{
+			input:  "alter function db1.f1 owner to r1",
+			output: "alter function db1.f1 owner to r1",
+		},
+		{
+			input:  "alter role R1 comment 'the role of the reports'",
+			output: "alter role r1 comment 'the role of the reports'",
+		},
+		{
+			input:  "alter role r1 tags ('pii', 'prod-write')",
+			output: "alter role r1 tags ('pii', 'prod-write')",
+		},
+		{
+			input:  "alter role r1 tags ()",
+			output: "alter role r1 tags ()",
+		},
+		{
+			input:  "merge role R1 into r2",
+			output: "merge role r1 into r2",
+		},
+		{
+			input:  "create role if not exists r1, r2 tags ('pii')",
+			output: "create role if not exists r1, r2 tags ('pii')",
+		},
+		{
+			input:  "create role r1 tags ('pii') in account child1",
+			output: "create role r1 tags ('pii') in account child1",
+		},
 		{
 			input:  "call test1()",
 			output: "call test1()",
@@ -3065,6 +3257,9 @@ var (
 		{
 			input: "alter table t1 add constraint index (col3, col4)",
 		},
+		{
+			input: "revoke select from r1",
+		},
 		{
 			input: "alter table t1 add constraint uk_6dotkott2kjsp8vw4d0m25fb7 index (col3)",
 		},
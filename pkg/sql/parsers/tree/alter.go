@@ -328,6 +328,12 @@ type AlterAccount struct {
 	StatusOption AccountStatus
 	// comment or not
 	Comment AccountComment
+	// the new name of the account or not
+	RenameTo string
+	// the quotas of the account or not
+	Quotas AccountQuotas
+	// the new parent of the account or not
+	Parent string
 }
 
 func NewAlterAccount(exist bool, name Expr, aopt AlterAccountAuthOption, sopt AccountStatus, c AccountComment) *AlterAccount {
@@ -353,6 +359,48 @@ func (node *AlterAccount) Format(ctx *FmtCtx) {
 	node.AuthOption.Format(ctx)
 	node.StatusOption.Format(ctx)
 	node.Comment.Format(ctx)
+	if len(node.RenameTo) != 0 {
+		ctx.WriteString(" rename to ")
+		ctx.WriteString(node.RenameTo)
+	}
+	node.Quotas.Format(ctx)
+	if len(node.Parent) != 0 {
+		ctx.WriteString(" set parent ")
+		ctx.WriteString(node.Parent)
+	}
+}
+
+// AccountQuota is the limit of a kind of the resource of the account.
+// The unlimited quota is NULL.
+type AccountQuota struct {
+	Name      string
+	Unlimited bool
+	Value     int64
+}
+
+func (node *AccountQuota) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Name)
+	ctx.WriteString(" = ")
+	if node.Unlimited {
+		ctx.WriteString("null")
+	} else {
+		ctx.WriteString(fmt.Sprintf("%d", node.Value))
+	}
+}
+
+type AccountQuotas []AccountQuota
+
+func (node AccountQuotas) Format(ctx *FmtCtx) {
+	if len(node) == 0 {
+		return
+	}
+	ctx.WriteString(" set quota ")
+	for i := range node {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		node[i].Format(ctx)
+	}
 }
 
 func (node *AlterAccount) GetStatementType() string { return "Alter Account" }
@@ -473,6 +521,98 @@ func (node *AlterDataBaseConfig) reset() {
 	*node = AlterDataBaseConfig{}
 }
 
+// AlterRoutineOwner transfers the ownership of the function or the procedure to the role
+type AlterRoutineOwner struct {
+	statementImpl
+	IsFunction bool
+	//the database of the routine. It is empty if it is not specified.
+	DbName Identifier
+	Name   Identifier
+	Owner  string
+}
+
+func (node *AlterRoutineOwner) Format(ctx *FmtCtx) {
+	if node.IsFunction {
+		ctx.WriteString("alter function ")
+	} else {
+		ctx.WriteString("alter procedure ")
+	}
+	if len(node.DbName) != 0 {
+		ctx.WriteString(string(node.DbName))
+		ctx.WriteByte('.')
+	}
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" owner to ")
+	ctx.WriteString(node.Owner)
+}
+
+func (node *AlterRoutineOwner) GetStatementType() string { return "Alter Routine Owner" }
+func (node *AlterRoutineOwner) GetQueryType() string     { return QueryTypeDCL }
+
+// ResetMysqlCompatibilityMode resets the variables of the account in the mo_mysql_compatibility_mode
+// to the ones at the creation of the account. The variables of the database are reset too if DbName is given.
+type ResetMysqlCompatibilityMode struct {
+	statementImpl
+	DbName string
+}
+
+func (node *ResetMysqlCompatibilityMode) Format(ctx *FmtCtx) {
+	ctx.WriteString("reset mysql_compatibility_mode")
+	if len(node.DbName) != 0 {
+		ctx.WriteString(" for database ")
+		ctx.WriteString(node.DbName)
+	}
+}
+
+func (node *ResetMysqlCompatibilityMode) GetStatementType() string {
+	return "Reset Mysql Compatibility Mode"
+}
+func (node *ResetMysqlCompatibilityMode) GetQueryType() string { return QueryTypeDDL }
+
+// AlterRole sets the comment of the role
+type AlterRole struct {
+	statementImpl
+	Role    *Role
+	Comment string
+	// SetTags replaces the tags of the role with the Tags instead of the comment.
+	// The empty Tags clear the tags of the role.
+	SetTags bool
+	Tags    []string
+}
+
+func (node *AlterRole) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter role ")
+	node.Role.Format(ctx)
+	if node.SetTags {
+		ctx.WriteString(" tags ")
+		formatRoleTags(ctx, node.Tags)
+		return
+	}
+	ctx.WriteString(" comment ")
+	ctx.WriteString(fmt.Sprintf("'%s'", node.Comment))
+}
+
+func (node *AlterRole) GetStatementType() string { return "Alter Role" }
+func (node *AlterRole) GetQueryType() string     { return QueryTypeDCL }
+
+// MergeRole merges the privileges of the role Source into the role Target.
+// It lists the privileges that both roles have with the different grant options.
+type MergeRole struct {
+	statementImpl
+	Source *Role
+	Target *Role
+}
+
+func (node *MergeRole) Format(ctx *FmtCtx) {
+	ctx.WriteString("merge role ")
+	node.Source.Format(ctx)
+	ctx.WriteString(" into ")
+	node.Target.Format(ctx)
+}
+
+func (node *MergeRole) GetStatementType() string { return "Merge Role" }
+func (node *MergeRole) GetQueryType() string     { return QueryTypeDCL }
+
 // AlterTable
 // see https://dev.mysql.com/doc/refman/8.0/en/alter-table.html
 type AlterTable struct {
@@ -922,6 +1062,8 @@ type AccountsSetOption struct {
 	SetAccounts  IdentifierList
 	AddAccounts  IdentifierList
 	DropAccounts IdentifierList
+	// LikePattern is the pattern of the account names set along with the SetAccounts.
+	LikePattern string
 }
 
 func NewAccountsSetOption(al bool, se, ad, dr IdentifierList) *AccountsSetOption {
@@ -968,14 +1110,20 @@ func (node *AlterPublication) Format(ctx *FmtCtx) {
 		ctx.WriteString("if exists ")
 	}
 	node.Name.Format(ctx)
-	ctx.WriteString(" account ")
 	if node.AccountsSet != nil {
+		ctx.WriteString(" account ")
 		if node.AccountsSet.All {
 			ctx.WriteString("all")
 		} else {
 			if len(node.AccountsSet.SetAccounts) > 0 {
 				node.AccountsSet.SetAccounts.Format(ctx)
 			}
+			if len(node.AccountsSet.LikePattern) > 0 {
+				if len(node.AccountsSet.SetAccounts) > 0 {
+					ctx.WriteByte(' ')
+				}
+				ctx.WriteString(fmt.Sprintf("like '%s'", node.AccountsSet.LikePattern))
+			}
 			if len(node.AccountsSet.AddAccounts) > 0 {
 				ctx.WriteString("add ")
 				node.AccountsSet.AddAccounts.Format(ctx)
@@ -986,6 +1134,10 @@ func (node *AlterPublication) Format(ctx *FmtCtx) {
 			}
 		}
 	}
+	if node.DbName != "" {
+		ctx.WriteString(" database ")
+		ctx.WriteString(node.DbName)
+	}
 	if node.Comment != "" {
 		ctx.WriteString(" comment ")
 		ctx.WriteString(fmt.Sprintf("'%s'", node.Comment))
@@ -4181,6 +4181,10 @@ type CreateRole struct {
 	statementImpl
 	IfNotExists bool
 	Roles       []*Role
+	// the tags of the roles
+	Tags []string
+	// the child account the roles are created in or not
+	Account string
 }
 
 func (node *CreateRole) Format(ctx *FmtCtx) {
@@ -4194,6 +4198,31 @@ func (node *CreateRole) Format(ctx *FmtCtx) {
 		r.Format(ctx)
 		prefix = ", "
 	}
+	if len(node.Tags) != 0 {
+		ctx.WriteString(" tags ")
+		formatRoleTags(ctx, node.Tags)
+	}
+	formatInAccount(ctx, node.Account)
+}
+
+// formatInAccount formats the child account the users or roles are created in
+func formatInAccount(ctx *FmtCtx, account string) {
+	if len(account) != 0 {
+		ctx.WriteString(" in account ")
+		ctx.WriteString(account)
+	}
+}
+
+// formatRoleTags formats the tags of the role as ('tag1', 'tag2')
+func formatRoleTags(ctx *FmtCtx, tags []string) {
+	ctx.WriteString("(")
+	for i, tag := range tags {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString(fmt.Sprintf("'%s'", tag))
+	}
+	ctx.WriteString(")")
 }
 
 func (node *CreateRole) GetStatementType() string { return "Create Role" }
@@ -4955,6 +4984,8 @@ type CreateUser struct {
 	MiscOpt     UserMiscOption
 	// comment or attribute
 	CommentOrAttribute AccountCommentOrAttribute
+	// the child account the users are created in or not
+	Account string
 }
 
 func (node *CreateUser) Format(ctx *FmtCtx) {
@@ -4983,6 +5014,7 @@ func (node *CreateUser) Format(ctx *FmtCtx) {
 	}
 
 	node.CommentOrAttribute.Format(ctx)
+	formatInAccount(ctx, node.Account)
 }
 
 func (node *CreateUser) GetStatementType() string { return "Create User" }
@@ -5069,6 +5101,10 @@ type CreateAccount struct {
 	StatusOption AccountStatus
 	// comment or not
 	Comment AccountComment
+	// the initial settings of the account or not
+	Settings AccountSettings
+	// the account whose roles and settings are copied or not
+	Template string
 }
 
 func NewCreateAccount(ife bool, n Expr, ao AccountAuthOption, so AccountStatus, c AccountComment) *CreateAccount {
@@ -5087,9 +5123,14 @@ func (node *CreateAccount) Format(ctx *FmtCtx) {
 		ctx.WriteString("if not exists ")
 	}
 	node.Name.Format(ctx)
+	if len(node.Template) != 0 {
+		ctx.WriteString(" from template ")
+		ctx.WriteString(node.Template)
+	}
 	node.AuthOption.Format(ctx)
 	node.StatusOption.Format(ctx)
 	node.Comment.Format(ctx)
+	node.Settings.Format(ctx)
 }
 
 func (node *CreateAccount) GetStatementType() string { return "Create Account" }
@@ -5105,6 +5146,33 @@ func (node *CreateAccount) Free() {
 	reuse.Free[CreateAccount](node, nil)
 }
 
+// AccountSetting is the initial value of a system variable of the new account.
+type AccountSetting struct {
+	Name  string
+	Value string
+}
+
+func (node *AccountSetting) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Name)
+	ctx.WriteString(" = ")
+	ctx.WriteString(fmt.Sprintf("'%s'", node.Value))
+}
+
+type AccountSettings []AccountSetting
+
+func (node AccountSettings) Format(ctx *FmtCtx) {
+	if len(node) == 0 {
+		return
+	}
+	ctx.WriteString(" with ")
+	for i := range node {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		node[i].Format(ctx)
+	}
+}
+
 type AccountAuthOption struct {
 	Equal          string
 	AdminName      Expr
@@ -5219,6 +5287,8 @@ func (aso AccountStatusOption) String() string {
 type AccountStatus struct {
 	Exist  bool
 	Option AccountStatusOption
+	// the reason of the suspension
+	Reason string
 }
 
 func NewAccountStatus() *AccountStatus {
@@ -5233,6 +5303,9 @@ func (node *AccountStatus) Format(ctx *FmtCtx) {
 			ctx.WriteString(" open")
 		case AccountStatusSuspend:
 			ctx.WriteString(" suspend")
+			if len(node.Reason) != 0 {
+				ctx.WriteString(fmt.Sprintf(" reason '%s'", node.Reason))
+			}
 		case AccountStatusRestricted:
 			ctx.WriteString(" restricted")
 		}
@@ -5318,16 +5391,19 @@ type CreatePublication struct {
 	Name        Identifier
 	Database    Identifier
 	Table       Identifier
+	// Tables are the tables published in the Database. Empty means all tables.
+	Tables      IdentifierList
 	AccountsSet *AccountsSetOption
 	Comment     string
 }
 
-func NewCreatePublication(ife bool, n Identifier, db Identifier, table Identifier, as *AccountsSetOption, c string) *CreatePublication {
+func NewCreatePublication(ife bool, n Identifier, db Identifier, table Identifier, tables IdentifierList, as *AccountsSetOption, c string) *CreatePublication {
 	cp := reuse.Alloc[CreatePublication](nil)
 	cp.IfNotExists = ife
 	cp.Name = n
 	cp.Database = db
 	cp.Table = table
+	cp.Tables = tables
 	cp.AccountsSet = as
 	cp.Comment = c
 	return cp
@@ -5342,18 +5418,25 @@ func (node *CreatePublication) Format(ctx *FmtCtx) {
 	if node.Database != "" {
 		ctx.WriteString(" database ")
 		node.Database.Format(ctx)
+		if len(node.Tables) > 0 {
+			ctx.WriteString(" table ")
+			node.Tables.Format(ctx)
+		}
 	} else {
 		ctx.WriteString(" table ")
 		node.Table.Format(ctx)
 	}
-	if node.AccountsSet != nil && len(node.AccountsSet.SetAccounts) > 0 {
-		ctx.WriteString(" account ")
-		prefix := ""
+	if node.AccountsSet != nil && (len(node.AccountsSet.SetAccounts) > 0 || len(node.AccountsSet.LikePattern) > 0) {
+		ctx.WriteString(" account")
+		prefix := " "
 		for _, a := range node.AccountsSet.SetAccounts {
 			ctx.WriteString(prefix)
 			a.Format(ctx)
 			prefix = ", "
 		}
+		if len(node.AccountsSet.LikePattern) > 0 {
+			ctx.WriteString(fmt.Sprintf(" like '%s'", node.AccountsSet.LikePattern))
+		}
 	}
 	if len(node.Comment) > 0 {
 		ctx.WriteString(" comment ")
@@ -71,6 +71,8 @@ type DropDatabase struct {
 	statementImpl
 	Name     Identifier
 	IfExists bool
+	// Force drops the publications of the database too
+	Force bool
 }
 
 func (node *DropDatabase) Format(ctx *FmtCtx) {
@@ -83,6 +85,9 @@ func (node *DropDatabase) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		ctx.WriteString(string(node.Name))
 	}
+	if node.Force {
+		ctx.WriteString(" force")
+	}
 }
 
 func (node *DropDatabase) GetStatementType() string { return "Drop Database" }
@@ -318,6 +323,10 @@ type DropAccount struct {
 	statementImpl
 	IfExists bool
 	Name     Expr
+	// Force goes on dropping the account when some of the objects can not be dropped
+	Force bool
+	// DryRun reports the objects to be dropped without dropping them
+	DryRun bool
 }
 
 func (node *DropAccount) Format(ctx *FmtCtx) {
@@ -327,6 +336,12 @@ func (node *DropAccount) Format(ctx *FmtCtx) {
 	}
 	ctx.WriteString(" ")
 	node.Name.Format(ctx)
+	if node.Force {
+		ctx.WriteString(" force")
+	}
+	if node.DryRun {
+		ctx.WriteString(" dry run")
+	}
 }
 
 func (node *DropAccount) GetStatementType() string { return "Drop Account" }
@@ -167,6 +167,7 @@ type FunctionLanguage string
 const (
 	SQL    FunctionLanguage = "sql"
 	PYTHON FunctionLanguage = "python"
+	WASM   FunctionLanguage = "wasm"
 )
 
 type CreateFunction struct {
@@ -202,7 +203,7 @@ func (node *CreateFunction) Valid() error {
 			return moerr.NewInvalidInputNoCtx("import")
 		}
 		return nil
-	case string(PYTHON):
+	case string(PYTHON), string(WASM):
 		return nil
 	default:
 		return moerr.NewInvalidArgNoCtx("function language", node.Language)
@@ -274,8 +275,9 @@ func (node *CreateFunction) Free() {
 
 type DropFunction struct {
 	statementImpl
-	Name *FunctionName
-	Args FunctionArgs
+	IfExists bool
+	Name     *FunctionName
+	Args     FunctionArgs
 }
 
 func (node DropFunction) TypeName() string { return "tree.DropFunction" }
@@ -293,6 +295,9 @@ func (node *DropFunction) reset() {
 
 func (node *DropFunction) Format(ctx *FmtCtx) {
 	ctx.WriteString("drop function ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
 	node.Name.Format(ctx)
 	ctx.WriteString(" (")
 
@@ -44,6 +44,17 @@ func (node *Grant) Format(ctx *FmtCtx) {
 func (node *Grant) GetStatementType() string { return "Grant" }
 func (node *Grant) GetQueryType() string     { return QueryTypeDCL }
 
+// IsContinueOnError denotes the grant reports the status of every pair instead of failing as a whole
+func (node *Grant) IsContinueOnError() bool {
+	switch node.Typ {
+	case GrantTypePrivilege:
+		return node.GrantPrivilege.ContinueOnError
+	case GrantTypeRole:
+		return node.GrantRole.ContinueOnError
+	}
+	return false
+}
+
 func NewGrant() *Grant {
 	return &Grant{}
 }
@@ -57,6 +68,9 @@ type GrantPrivilege struct {
 	Level       *PrivilegeLevel
 	Roles       []*Role
 	GrantOption bool
+	// ContinueOnError grants every pair of the privilege and the role independently
+	// and reports the status of every pair
+	ContinueOnError bool
 }
 
 func (node *GrantPrivilege) Format(ctx *FmtCtx) {
@@ -70,7 +84,8 @@ func (node *GrantPrivilege) Format(ctx *FmtCtx) {
 		}
 	}
 	ctx.WriteString(" on")
-	if node.ObjType != OBJECT_TYPE_NONE {
+	//the object type is implied by the all tables
+	if node.ObjType != OBJECT_TYPE_NONE && !node.Level.IsAllTables() {
 		ctx.WriteByte(' ')
 		ctx.WriteString(node.ObjType.String())
 	}
@@ -91,6 +106,9 @@ func (node *GrantPrivilege) Format(ctx *FmtCtx) {
 	if node.GrantOption {
 		ctx.WriteString(" with grant option")
 	}
+	if node.ContinueOnError {
+		ctx.WriteString(" continue on error")
+	}
 }
 
 func (node *GrantPrivilege) GetStatementType() string { return "Grant Privilege" }
@@ -101,6 +119,11 @@ type GrantRole struct {
 	Roles       []*Role
 	Users       []*User
 	GrantOption bool
+	// the time that the grant expires. the grant is permanent if it is empty.
+	Expiry string
+	// ContinueOnError grants every pair of the role and the user (role) independently
+	// and reports the status of every pair
+	ContinueOnError bool
 }
 
 func (node *GrantRole) Format(ctx *FmtCtx) {
@@ -125,6 +148,14 @@ func (node *GrantRole) Format(ctx *FmtCtx) {
 	if node.GrantOption {
 		ctx.WriteString(" with grant option")
 	}
+	if len(node.Expiry) != 0 {
+		ctx.WriteString(" with expiry '")
+		ctx.WriteString(node.Expiry)
+		ctx.WriteString("'")
+	}
+	if node.ContinueOnError {
+		ctx.WriteString(" continue on error")
+	}
 }
 
 func (node *GrantRole) GetStatementType() string { return "Grant Role" }
@@ -227,6 +227,11 @@ type DropProcedure struct {
 	statementImpl
 	Name     *ProcedureName
 	IfExists bool
+	// Args is the signature of the overload to drop. It is valid only if WithArgs is true.
+	Args     ProcedureArgs
+	WithArgs bool
+	// All drops all the overloads of the procedure.
+	All bool
 }
 
 func (node *DropProcedure) Free() {
@@ -248,6 +253,19 @@ func (node *DropProcedure) Format(ctx *FmtCtx) {
 		ctx.WriteString("if exists ")
 	}
 	node.Name.Format(ctx)
+	if node.All {
+		ctx.WriteString(" all")
+	} else if node.WithArgs {
+		ctx.WriteString(" (")
+		for i, def := range node.Args {
+			if i != 0 {
+				ctx.WriteString(",")
+				ctx.WriteByte(' ')
+			}
+			def.Format(ctx)
+		}
+		ctx.WriteString(")")
+	}
 }
 
 func (node *DropProcedure) GetStatementType() string { return "Create Procedure" }
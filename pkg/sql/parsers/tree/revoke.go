@@ -47,11 +47,13 @@ func (node *Revoke) GetQueryType() string     { return QueryTypeDCL }
 
 type RevokePrivilege struct {
 	statementImpl
-	IfExists   bool
-	Privileges []*Privilege
-	ObjType    ObjectType
-	Level      *PrivilegeLevel
-	Roles      []*Role
+	IfExists bool
+	//only the grant option of the privileges is revoked. the privileges are kept.
+	GrantOptionOnly bool
+	Privileges      []*Privilege
+	ObjType         ObjectType
+	Level           *PrivilegeLevel
+	Roles           []*Role
 }
 
 func (node *RevokePrivilege) Format(ctx *FmtCtx) {
@@ -59,6 +61,9 @@ func (node *RevokePrivilege) Format(ctx *FmtCtx) {
 	if node.IfExists {
 		ctx.WriteString(" if exists")
 	}
+	if node.GrantOptionOnly {
+		ctx.WriteString(" grant option for")
+	}
 
 	if node.Privileges != nil {
 		prefix := " "
@@ -68,8 +73,14 @@ func (node *RevokePrivilege) Format(ctx *FmtCtx) {
 			prefix = ", "
 		}
 	}
+	//revoke all privileges, grant option from roles
+	if node.IsAllPrivileges() {
+		node.formatRoles(ctx)
+		return
+	}
 	ctx.WriteString(" on")
-	if node.ObjType != OBJECT_TYPE_NONE {
+	//the object type is implied by the all tables
+	if node.ObjType != OBJECT_TYPE_NONE && !node.Level.IsAllTables() {
 		ctx.WriteByte(' ')
 		ctx.WriteString(node.ObjType.String())
 	}
@@ -78,6 +89,10 @@ func (node *RevokePrivilege) Format(ctx *FmtCtx) {
 		node.Level.Format(ctx)
 	}
 
+	node.formatRoles(ctx)
+}
+
+func (node *RevokePrivilege) formatRoles(ctx *FmtCtx) {
 	if node.Roles != nil {
 		ctx.WriteString(" from")
 		prefix := " "
@@ -89,6 +104,12 @@ func (node *RevokePrivilege) Format(ctx *FmtCtx) {
 	}
 }
 
+// IsAllPrivileges denotes the statement revokes all privileges of the roles.
+// It has no object type and no privilege level.
+func (node *RevokePrivilege) IsAllPrivileges() bool {
+	return node.ObjType == OBJECT_TYPE_NONE && node.Level == nil
+}
+
 func (node *RevokePrivilege) GetStatementType() string { return "Revoke Privilege" }
 func (node *RevokePrivilege) GetQueryType() string     { return QueryTypeDCL }
 
@@ -144,21 +165,42 @@ func (node *PrivilegeLevel) Format(ctx *FmtCtx) {
 		ctx.WriteString("*")
 	case PRIVILEGE_LEVEL_TYPE_STAR_STAR:
 		ctx.WriteString("*.*")
+	case PRIVILEGE_LEVEL_TYPE_DATABASE:
+		ctx.WriteString(node.DbName)
 	case PRIVILEGE_LEVEL_TYPE_DATABASE_STAR:
 		ctx.WriteString(fmt.Sprintf("%s.*", node.DbName))
 	case PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE:
 		ctx.WriteString(fmt.Sprintf("%s.%s", node.DbName, node.TabName))
 	case PRIVILEGE_LEVEL_TYPE_TABLE:
 		ctx.WriteString(node.TabName)
+	case PRIVILEGE_LEVEL_TYPE_DATABASE_ALL_TABLES:
+		ctx.WriteString(fmt.Sprintf("all tables in database %s", node.DbName))
 	}
 }
 
+// IsAllTables returns true if the level is the all tables in the database.
+func (node *PrivilegeLevel) IsAllTables() bool {
+	return node != nil && node.Level == PRIVILEGE_LEVEL_TYPE_DATABASE_ALL_TABLES
+}
+
 func (node *PrivilegeLevel) String() string {
 	fmtCtx := NewFmtCtx(dialect.MYSQL)
 	node.Format(fmtCtx)
 	return fmtCtx.String()
 }
 
+// ResolvePrivilegeLevel resolves the bare name in the privilege level by the object type.
+// The name in the object type database is the database name.
+func ResolvePrivilegeLevel(ot ObjectType, pl *PrivilegeLevel) *PrivilegeLevel {
+	if ot == OBJECT_TYPE_DATABASE && pl != nil && pl.Level == PRIVILEGE_LEVEL_TYPE_TABLE {
+		return &PrivilegeLevel{
+			Level:  PRIVILEGE_LEVEL_TYPE_DATABASE,
+			DbName: pl.TabName,
+		}
+	}
+	return pl
+}
+
 func NewPrivilegeLevel(l PrivilegeLevelType, d, t, r string) *PrivilegeLevel {
 	return &PrivilegeLevel{
 		Level:       l,
@@ -181,6 +223,7 @@ const (
 	PRIVILEGE_LEVEL_TYPE_STORED_ROUTINE                           //procedure
 	PRIVILEGE_LEVEL_TYPE_PROXY
 	PRIVILEGE_LEVEL_TYPE_ROUTINE
+	PRIVILEGE_LEVEL_TYPE_DATABASE_ALL_TABLES //all tables in database db_name
 )
 
 type Privilege struct {
@@ -49,8 +49,10 @@ func NewSetVar(a []*VarAssignmentExpr) *SetVar {
 // for variable = expr
 type VarAssignmentExpr struct {
 	NodeFormatter
-	System   bool
-	Global   bool
+	System bool
+	Global bool
+	// Persist denotes the variable is kept for the current user by SET PERSIST.
+	Persist  bool
 	Name     string
 	Value    Expr
 	Reserved Expr
@@ -60,6 +62,9 @@ func (node *VarAssignmentExpr) Format(ctx *FmtCtx) {
 	if node.Global {
 		ctx.WriteString("global ")
 	}
+	if node.Persist {
+		ctx.WriteString("persist ")
+	}
 	ctx.WriteString(node.Name)
 	ctx.WriteString(" =")
 	if node.Value != nil {
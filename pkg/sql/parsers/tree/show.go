@@ -556,13 +556,20 @@ type ShowFunctionOrProcedureStatus struct {
 	Like       *ComparisonExpr
 	Where      *Where
 	IsFunction bool
+	//show the owners of the routines instead of the status
+	Owner bool
 }
 
 func (node *ShowFunctionOrProcedureStatus) Format(ctx *FmtCtx) {
 	if node.IsFunction {
-		ctx.WriteString("show function status")
+		ctx.WriteString("show function")
 	} else {
-		ctx.WriteString("show procedure status")
+		ctx.WriteString("show procedure")
+	}
+	if node.Owner {
+		ctx.WriteString(" owner")
+	} else {
+		ctx.WriteString(" status")
 	}
 	if node.Like != nil {
 		ctx.WriteString(" like ")
@@ -699,7 +706,8 @@ func NewShowTableValues(table *UnresolvedObjectName, dbname string) *ShowTableVa
 
 type ShowAccounts struct {
 	showImpl
-	Like *ComparisonExpr
+	Like  *ComparisonExpr
+	Where *Where
 }
 
 func (node *ShowAccounts) Format(ctx *FmtCtx) {
@@ -708,6 +716,10 @@ func (node *ShowAccounts) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Like.Format(ctx)
 	}
+	if node.Where != nil {
+		ctx.WriteByte(' ')
+		node.Where.Format(ctx)
+	}
 }
 
 func (node *ShowAccounts) GetStatementType() string { return "Show Accounts" }
@@ -759,6 +771,19 @@ func (node *ShowSubscriptions) Format(ctx *FmtCtx) {
 func (node *ShowSubscriptions) GetStatementType() string { return "Show Subscriptions" }
 func (node *ShowSubscriptions) GetQueryType() string     { return QueryTypeOth }
 
+// ShowSubscribers lists the accounts that subscribe the publication
+type ShowSubscribers struct {
+	showImpl
+	Publication Identifier
+}
+
+func (node *ShowSubscribers) Format(ctx *FmtCtx) {
+	ctx.WriteString("show subscribers from ")
+	node.Publication.Format(ctx)
+}
+func (node *ShowSubscribers) GetStatementType() string { return "Show Subscribers" }
+func (node *ShowSubscribers) GetQueryType() string     { return QueryTypeOth }
+
 type ShowCreatePublications struct {
 	showImpl
 	Name string
@@ -771,6 +796,32 @@ func (node *ShowCreatePublications) Format(ctx *FmtCtx) {
 func (node *ShowCreatePublications) GetStatementType() string { return "Show Create Publication" }
 func (node *ShowCreatePublications) GetQueryType() string     { return QueryTypeOth }
 
+// ShowCreateFunction shows the CREATE FUNCTION statements of the overloads of the function
+type ShowCreateFunction struct {
+	showImpl
+	Name *FunctionName
+}
+
+func (node *ShowCreateFunction) Format(ctx *FmtCtx) {
+	ctx.WriteString("show create function ")
+	node.Name.Format(ctx)
+}
+func (node *ShowCreateFunction) GetStatementType() string { return "Show Create Function" }
+func (node *ShowCreateFunction) GetQueryType() string     { return QueryTypeOth }
+
+// ShowCreateProcedure shows the CREATE PROCEDURE statements of the overloads of the procedure
+type ShowCreateProcedure struct {
+	showImpl
+	Name *ProcedureName
+}
+
+func (node *ShowCreateProcedure) Format(ctx *FmtCtx) {
+	ctx.WriteString("show create procedure ")
+	node.Name.Format(ctx)
+}
+func (node *ShowCreateProcedure) GetStatementType() string { return "Show Create Procedure" }
+func (node *ShowCreateProcedure) GetQueryType() string     { return QueryTypeOth }
+
 type ShowTableSize struct {
 	showImpl
 	Table  *UnresolvedObjectName
@@ -803,6 +854,8 @@ func NewShowTableSize(table *UnresolvedObjectName, dbname string) *ShowTableSize
 type ShowRolesStmt struct {
 	showImpl
 	Like *ComparisonExpr
+	// Tag lists the roles with the tag only
+	Tag string
 }
 
 func (node *ShowRolesStmt) Format(ctx *FmtCtx) {
@@ -811,11 +864,39 @@ func (node *ShowRolesStmt) Format(ctx *FmtCtx) {
 		ctx.WriteString(" ")
 		node.Like.Format(ctx)
 	}
+	if len(node.Tag) != 0 {
+		ctx.WriteString(" with tag '")
+		ctx.WriteString(node.Tag)
+		ctx.WriteString("'")
+	}
 }
 
 func (node *ShowRolesStmt) GetStatementType() string { return "Show Roles" }
 func (node *ShowRolesStmt) GetQueryType() string     { return QueryTypeOth }
 
+// ShowRoleHierarchy indicates SHOW ROLE HIERARCHY [FOR user] statement.
+// It lists the grants among the roles that the user has.
+type ShowRoleHierarchy struct {
+	showImpl
+	Username string
+	Hostname string
+}
+
+func (node *ShowRoleHierarchy) Format(ctx *FmtCtx) {
+	ctx.WriteString("show role hierarchy")
+	if node.Username != "" {
+		ctx.WriteString(" for ")
+		ctx.WriteString(node.Username)
+		if node.Hostname != "" {
+			ctx.WriteString("@")
+			ctx.WriteString(node.Hostname)
+		}
+	}
+}
+
+func (node *ShowRoleHierarchy) GetStatementType() string { return "Show Role Hierarchy" }
+func (node *ShowRoleHierarchy) GetQueryType() string     { return QueryTypeOth }
+
 // ShowBackendServers indicates SHOW BACKEND SERVERS statement.
 type ShowBackendServers struct {
 	showImpl
@@ -135,10 +135,15 @@ var (
 	//    ShowTableStatus
 	//    ShowErrors
 	//    ShowVariables
+	//    ShowStatus
 	//    ShowAccounts
 	//    ShowCollation
 	//    ShowSubscriptions
+	//    ShowSubscribers
+	//    ShowCreateFunction
+	//    ShowCreateProcedure
 	//    ShowBackendServers
+	//    DropAccount ... DRY RUN
 	compositeResRowType = MakeStmtKind(OUTPUT_RESULT_ROW, RESP_PREBUILD_RESULT_ROW, EXEC_IN_FRONTEND)
 )
 
@@ -181,6 +186,18 @@ func (node *ShowSubscriptions) StmtKind() StmtKind {
 	return compositeResRowType
 }
 
+func (node *ShowSubscribers) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
+func (node *ShowCreateFunction) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
+func (node *ShowCreateProcedure) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
 func (node *CreateStage) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -198,6 +215,9 @@ func (node *CreateAccount) StmtKind() StmtKind {
 }
 
 func (node *DropAccount) StmtKind() StmtKind {
+	if node.DryRun {
+		return compositeResRowType
+	}
 	return frontendStatusTyp
 }
 
@@ -209,6 +229,18 @@ func (node *AlterDataBaseConfig) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
 
+func (node *ResetMysqlCompatibilityMode) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterRoutineOwner) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *AlterRole) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
 func (node *CreateUser) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -250,6 +282,9 @@ func (node *CallStmt) StmtKind() StmtKind {
 }
 
 func (node *Grant) StmtKind() StmtKind {
+	if node.IsContinueOnError() {
+		return compositeResRowType
+	}
 	return frontendStatusTyp
 }
 
@@ -269,6 +304,14 @@ func (node *ShowBackendServers) StmtKind() StmtKind {
 	return compositeResRowType
 }
 
+func (node *ShowRoleHierarchy) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
+func (node *MergeRole) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
 func (node *SetTransaction) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -388,7 +431,7 @@ func (node *ShowColumns) StmtKind() StmtKind {
 }
 
 func (node *ShowStatus) StmtKind() StmtKind {
-	return defaultResRowTyp
+	return compositeResRowType
 }
 
 func (node *ShowWarnings) StmtKind() StmtKind {
@@ -41,19 +41,20 @@ func TestQueryType(t *testing.T) {
 					&ValuesStatement{}: QueryTypeDQL,
 					&With{}:            QueryTypeDQL,
 					// DDL
-					&CreateDatabase{}:      QueryTypeDDL,
-					&CreateTable{}:         QueryTypeDDL,
-					&CreateView{}:          QueryTypeDDL,
-					&CreateIndex{}:         QueryTypeDDL,
-					&CreateFunction{}:      QueryTypeDDL,
-					&AlterView{}:           QueryTypeDDL,
-					&AlterDataBaseConfig{}: QueryTypeDDL,
-					&DropDatabase{}:        QueryTypeDDL,
-					&DropTable{}:           QueryTypeDDL,
-					&DropView{}:            QueryTypeDDL,
-					&DropIndex{}:           QueryTypeDDL,
-					&DropFunction{}:        QueryTypeDDL,
-					&TruncateTable{}:       QueryTypeDDL,
+					&CreateDatabase{}:              QueryTypeDDL,
+					&CreateTable{}:                 QueryTypeDDL,
+					&CreateView{}:                  QueryTypeDDL,
+					&CreateIndex{}:                 QueryTypeDDL,
+					&CreateFunction{}:              QueryTypeDDL,
+					&AlterView{}:                   QueryTypeDDL,
+					&AlterDataBaseConfig{}:         QueryTypeDDL,
+					&ResetMysqlCompatibilityMode{}: QueryTypeDDL,
+					&DropDatabase{}:                QueryTypeDDL,
+					&DropTable{}:                   QueryTypeDDL,
+					&DropView{}:                    QueryTypeDDL,
+					&DropIndex{}:                   QueryTypeDDL,
+					&DropFunction{}:                QueryTypeDDL,
+					&TruncateTable{}:               QueryTypeDDL,
 					// DML
 					&Insert{}: QueryTypeDML,
 					&Update{}: QueryTypeDML,
@@ -70,6 +71,7 @@ func TestQueryType(t *testing.T) {
 					&Revoke{}:            QueryTypeDCL,
 					&RevokePrivilege{}:   QueryTypeDCL,
 					&RevokeRole{}:        QueryTypeDCL,
+					&MergeRole{}:         QueryTypeDCL,
 					&AlterAccount{}:      QueryTypeDCL,
 					&AlterUser{}:         QueryTypeDCL,
 					&DropAccount{}:       QueryTypeDCL,
@@ -123,8 +125,11 @@ func TestQueryType(t *testing.T) {
 					&ShowWarnings{}:                  QueryTypeOth,
 					&ShowColumns{}:                   QueryTypeOth,
 					&ShowCreatePublications{}:        QueryTypeOth,
+					&ShowCreateFunction{}:            QueryTypeOth,
+					&ShowCreateProcedure{}:           QueryTypeOth,
 					&ShowPublications{}:              QueryTypeOth,
 					&ShowSubscriptions{}:             QueryTypeOth,
+					&ShowSubscribers{}:               QueryTypeOth,
 					&ShowBackendServers{}:            QueryTypeOth,
 				},
 			},
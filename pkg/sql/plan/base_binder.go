@@ -1160,7 +1160,8 @@ func bindFuncExprImplUdf(b *baseBinder, name string, udf *function.Udf, args []t
 			}
 		}
 		return expr, nil
-	case string(tree.PYTHON):
+	case string(tree.PYTHON), string(tree.WASM):
+		// the external function is run by the udf service of its language
 		expr, err := b.bindPythonUdf(udf, args, depth)
 		if err != nil {
 			return nil, err
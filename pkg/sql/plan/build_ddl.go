@@ -2538,10 +2538,14 @@ func buildDropDatabase(stmt *tree.DropDatabase, ctx CompilerContext) (*Plan, err
 		IfExists: stmt.IfExists,
 		Database: string(stmt.Name),
 	}
-	if publishing, err := ctx.IsPublishing(dropDB.Database); err != nil {
+	if pubs, err := ctx.GetPublicationsOfDatabase(dropDB.Database); err != nil {
 		return nil, err
-	} else if publishing {
-		return nil, moerr.NewInternalError(ctx.GetContext(), "can not drop database '%v' which is publishing", dropDB.Database)
+	} else if len(pubs) != 0 {
+		if !stmt.Force {
+			return nil, moerr.NewInternalError(ctx.GetContext(), "can not drop database '%v' which is publishing by %s", dropDB.Database, strings.Join(pubs, ","))
+		}
+		// the publications are dropped with the database
+		dropDB.DeletePubSql = getSqlForDeletePubsOfDB(dropDB.Database)
 	}
 
 	if ctx.DatabaseExists(string(stmt.Name), Snapshot{TS: &timestamp.Timestamp{}}) {
@@ -4057,6 +4057,14 @@ func getSqlForCheckHasDBRefersTo(db string) string {
 	return sb.String()
 }
 
+// getSqlForDeletePubsOfDB returns the sql that deletes the publications of the database
+func getSqlForDeletePubsOfDB(db string) string {
+	sb := strings.Builder{}
+	sb.WriteString("delete from `mo_catalog`.`mo_pubs` where ")
+	sb.WriteString(fmt.Sprintf("database_name = '%s'", db))
+	return sb.String()
+}
+
 // fkBannedDatabase denotes the databases that forbid the foreign keys
 // you can not define fk in these databases or
 // define fk refers to these databases.
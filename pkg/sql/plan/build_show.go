@@ -715,7 +715,14 @@ func buildShowFunctionOrProcedureStatus(stmt *tree.ShowFunctionOrProcedureStatus
 		return nil, moerr.NewSyntaxError(ctx.GetContext(), "like clause and where clause cannot exist at the same time")
 	}
 
-	if stmt.IsFunction {
+	if stmt.Owner {
+		//the owner of the stored procedure is kept in the column creator
+		if stmt.IsFunction {
+			sql = fmt.Sprintf("SELECT f.db as `Db`, f.name as `Name`, r.role_name as `Owner` FROM %s.mo_user_defined_function f left join %s.mo_role r on f.owner = r.role_id", MO_CATALOG_DB_NAME, MO_CATALOG_DB_NAME)
+		} else {
+			sql = fmt.Sprintf("SELECT p.db as `Db`, p.name as `Name`, r.role_name as `Owner` FROM %s.mo_stored_procedure p left join %s.mo_role r on p.creator = r.role_id", MO_CATALOG_DB_NAME, MO_CATALOG_DB_NAME)
+		}
+	} else if stmt.IsFunction {
 		sql = fmt.Sprintf("SELECT db as `Db`, name as `Name`, type as `Type`, definer as `Definer`, modified_time as `Modified`, created_time as `Created`, security_type as `Security_type`, comment as `Comment`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.mo_user_defined_function", MO_CATALOG_DB_NAME)
 	} else {
 		sql = fmt.Sprintf("SELECT db as `Db`, name as `Name`, type as `Type`, definer as `Definer`, modified_time as `Modified`, created_time as `Created`, security_type as `Security_type`, comment as `Comment`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.mo_stored_procedure", MO_CATALOG_DB_NAME)
@@ -867,7 +874,7 @@ func buildShowGrants(stmt *tree.ShowGrants, ctx CompilerContext) (*Plan, error)
 
 func buildShowRoles(stmt *tree.ShowRolesStmt, ctx CompilerContext) (*Plan, error) {
 	ddlType := plan.DataDefinition_SHOW_TARGET
-	sql := fmt.Sprintf("SELECT role_name as `ROLE_NAME`, creator as `CREATOR`, created_time as `CREATED_TIME`, comments as `COMMENTS` FROM %s.mo_role;", MO_CATALOG_DB_NAME)
+	sql := fmt.Sprintf("SELECT role_name as `ROLE_NAME`, creator as `CREATOR`, created_time as `CREATED_TIME`, comments as `COMMENTS`, ifnull(tags, '') as `TAGS` FROM %s.mo_role;", MO_CATALOG_DB_NAME)
 
 	if stmt.Like != nil {
 		// append filter [AND mo_role.role_name like stmt.Like] to WHERE clause
@@ -876,6 +883,23 @@ func buildShowRoles(stmt *tree.ShowRolesStmt, ctx CompilerContext) (*Plan, error
 		return returnByLikeAndSQL(ctx, sql, likeExpr, ddlType)
 	}
 
+	if len(stmt.Tag) != 0 {
+		// append filter [AND find_in_set(stmt.Tag, mo_role.tags) > 0] to WHERE clause.
+		// the tags are kept in lower case.
+		tag := strings.ToLower(strings.TrimSpace(stmt.Tag))
+		tagExpr := tree.NewComparisonExpr(tree.GREAT_THAN,
+			&tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(tree.NewUnresolvedColName("find_in_set")),
+				Type: tree.FUNC_TYPE_DEFAULT,
+				Exprs: tree.Exprs{
+					tree.NewNumValWithType(constant.MakeString(tag), tag, false, tree.P_char),
+					tree.NewUnresolvedColName("tags"),
+				},
+			},
+			tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64))
+		return returnByLikeAndSQL(ctx, sql, tagExpr, ddlType)
+	}
+
 	return returnByRewriteSQL(ctx, sql, ddlType)
 }
 
@@ -880,6 +880,7 @@ func TestShow(t *testing.T) {
 		// "show procedure status like '%ff'",
 		"show roles",
 		"show roles like '%ff'",
+		"show roles with tag 'pii'",
 		"show stages",
 		"show stages like 'my_stage%'",
 		// "show grants",
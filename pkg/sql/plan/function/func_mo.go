@@ -576,6 +576,10 @@ var (
 		"mo_pubs":                     0,
 		"mo_stages":                   0,
 		"mo_snapshots":                0,
+		"mo_password_history":         0,
+		"mo_column_privs":             0,
+		"mo_user_variables":           0,
+		"mo_variable_audit":           0,
 	}
 )
 
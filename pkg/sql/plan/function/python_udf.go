@@ -91,7 +91,7 @@ func runPythonUdf(parameters []*vector.Vector, result vector.FunctionResultWrapp
 			IsImport:     body.Import,
 			Body:         body.Body,
 			RetType:      t2DataType[u.GetRetType().Oid],
-			Language:     udf.LanguagePython,
+			Language:     u.Language,
 			Db:           u.Db,
 			ModifiedTime: u.ModifiedTime,
 		},
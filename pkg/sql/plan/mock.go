@@ -438,6 +438,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 			{"owner", types.T_int64, false, 50, 0},
 			{"created_time", types.T_timestamp, false, 0, 0},
 			{"comments", types.T_varchar, false, 2048, 0},
+			{"tags", types.T_varchar, false, 1024, 0},
 		},
 		pks: []int{0},
 	}
@@ -961,8 +962,8 @@ func (m *MockCompilerContext) SetQueryingSubscription(*SubscriptionMeta) {
 func (m *MockCompilerContext) GetQueryingSubscription() *SubscriptionMeta {
 	return nil
 }
-func (m *MockCompilerContext) IsPublishing(dbName string) (bool, error) {
-	return false, nil
+func (m *MockCompilerContext) GetPublicationsOfDatabase(dbName string) ([]string, error) {
+	return nil, nil
 }
 
 func (m *MockCompilerContext) ResolveSnapshotWithSnapshotName(snapshotName string) (*Snapshot, error) {
@@ -114,7 +114,7 @@ type CompilerContext interface {
 	CheckSubscriptionValid(subName, accName string, pubName string) error
 	SetQueryingSubscription(meta *SubscriptionMeta)
 	GetQueryingSubscription() *SubscriptionMeta
-	IsPublishing(dbName string) (bool, error)
+	GetPublicationsOfDatabase(dbName string) ([]string, error)
 	ResolveSubscriptionTableById(tableId uint64, pubmeta *SubscriptionMeta) (*ObjectRef, *TableDef)
 
 	ResolveSnapshotWithSnapshotName(snapshotName string) (*Snapshot, error)
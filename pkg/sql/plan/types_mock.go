@@ -327,19 +327,19 @@ func (mr *MockCompilerContext2MockRecorder) GetViews() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViews", reflect.TypeOf((*MockCompilerContext2)(nil).GetViews))
 }
 
-// IsPublishing mocks base method.
-func (m *MockCompilerContext2) IsPublishing(dbName string) (bool, error) {
+// GetPublicationsOfDatabase mocks base method.
+func (m *MockCompilerContext2) GetPublicationsOfDatabase(dbName string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsPublishing", dbName)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetPublicationsOfDatabase", dbName)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsPublishing indicates an expected call of IsPublishing.
-func (mr *MockCompilerContext2MockRecorder) IsPublishing(dbName interface{}) *gomock.Call {
+// GetPublicationsOfDatabase indicates an expected call of GetPublicationsOfDatabase.
+func (mr *MockCompilerContext2MockRecorder) GetPublicationsOfDatabase(dbName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPublishing", reflect.TypeOf((*MockCompilerContext2)(nil).IsPublishing), dbName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicationsOfDatabase", reflect.TypeOf((*MockCompilerContext2)(nil).GetPublicationsOfDatabase), dbName)
 }
 
 // ReplacePlan mocks base method.
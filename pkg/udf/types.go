@@ -21,6 +21,7 @@ import (
 
 const (
 	LanguagePython = "python"
+	LanguageWasm   = "wasm"
 )
 
 // Service handle non-sql udf in cn
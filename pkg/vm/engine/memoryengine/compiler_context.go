@@ -77,7 +77,7 @@ func (c *CompilerContext) ResolveSubscriptionTableById(tableId uint64, pubmeta *
 	panic("implement me")
 }
 
-func (c *CompilerContext) IsPublishing(dbName string) (bool, error) {
+func (c *CompilerContext) GetPublicationsOfDatabase(dbName string) ([]string, error) {
 	//TODO implement me
 	panic("implement me")
 }
@@ -0,0 +1,45 @@
+set global enable_privilege_cache = off;
+drop account if exists p1;
+drop account if exists c1;
+drop account if exists c2;
+create account p1 admin_name 'admin1' identified by '111';
+create account c1 admin_name 'admin2' identified by '111';
+create account c2 admin_name 'admin3' identified by '111';
+
+--no error
+alter account c1 set parent p1;
+
+--error, the parent can not be a child
+alter account p1 set parent c1;
+
+--error, account sys can not be the parent
+alter account c2 set parent sys;
+
+-- @session:id=1&user=p1:admin1&password=111
+--error, only the sys account builds the account tree
+alter account c1 set parent p1;
+
+--no error, the child of p1
+create user u1 identified by '111' in account c1;
+create role r1 in account c1;
+alter account c1 suspend;
+alter account c1 open;
+
+--error, the sibling of p1
+create user u2 identified by '111' in account c2;
+create role r2 in account c2;
+alter account c2 suspend;
+-- @session
+
+-- @session:id=2&user=c1:admin2&password=111
+select user_name from mo_catalog.mo_user where user_name = 'u1';
+select role_name from mo_catalog.mo_role where role_name = 'r1';
+
+--error, the child can not manage the parent
+create user u3 identified by '111' in account p1;
+-- @session
+
+drop account if exists c1;
+drop account if exists c2;
+drop account if exists p1;
+set global enable_privilege_cache = on;
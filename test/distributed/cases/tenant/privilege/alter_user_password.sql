@@ -14,6 +14,14 @@ alter user 'root' identified by '111' LOCK;
 alter user 'root' identified by '111' PASSWORD HISTORY DEFAULT;
 alter user 'root' identified by '111' comment 'alter user test';
 alter user 'root' identified by '111' attribute 'test';
+alter user 'root' identified by '111' attribute '{"team": "dev"}';
+select user_name,comment,attribute from mo_catalog.mo_user where user_name="root";
+alter user 'admin_1' password expire never;
+alter user 'admin_1' identified by '654321';
+select user_name,password_lifetime,expired_time from mo_catalog.mo_user where user_name="admin_1";
+alter user 'admin_1' password expire interval 0 day;
+alter user 'admin_1' password expire default;
+select user_name,password_lifetime from mo_catalog.mo_user where user_name="admin_1";
 -- @session
 drop account acc_idx;
 alter user root identified by 'UI235_ace';